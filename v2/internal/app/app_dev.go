@@ -17,6 +17,7 @@ import (
 	"github.com/wailsapp/wails/v2/pkg/assetserver"
 
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreport"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop"
 	"github.com/wailsapp/wails/v2/internal/frontend/devserver"
 	"github.com/wailsapp/wails/v2/internal/frontend/dispatcher"
@@ -24,6 +25,8 @@ import (
 	"github.com/wailsapp/wails/v2/internal/fs"
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/internal/menumanager"
+	"github.com/wailsapp/wails/v2/internal/system/process"
+	"github.com/wailsapp/wails/v2/internal/system/sidecar"
 	pkglogger "github.com/wailsapp/wails/v2/pkg/logger"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
@@ -35,6 +38,8 @@ func (a *App) Run() error {
 	if a.shutdownCallback != nil {
 		a.shutdownCallback(a.ctx)
 	}
+	process.TerminateAll()
+	crashreport.Close()
 	return err
 }
 
@@ -195,9 +200,16 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		return nil, err
 	}
 
+	// Install the crash handlers before the frontend is created
+	if err := crashreport.Install(appoptions.CrashReporting); err != nil {
+		myLogger.Error("Unable to install the crash reporting: %s", err.Error())
+	}
+
 	// Merge default options
 	options.MergeDefaults(appoptions)
 
+	sidecar.SetEmbedded(appoptions.Sidecars)
+
 	var menuManager *menumanager.Manager
 
 	// Process the application menu
@@ -6,11 +6,20 @@ import (
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/internal/wv2installer"
 	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
 
 func PreflightChecks(options *options.App, logger *logger.Logger) error {
 
-	_ = options
+	// Fail before WebView2 creates a folder with the literal name of an undefined environment variable
+	if opts := options.Windows; opts != nil {
+		if _, err := windows.ExpandPath(opts.WebviewUserDataPath); err != nil {
+			return &windows.OptionError{Option: "WebviewUserDataPath", Err: err}
+		}
+		if _, err := windows.ExpandPath(opts.WebviewBrowserPath); err != nil {
+			return &windows.OptionError{Option: "WebviewBrowserPath", Err: err}
+		}
+	}
 
 	// Process the webview2 runtime situation. We can pass a strategy in via the `webview2` flag for `wails build`.
 	// This will determine how wv2runtime.Process will handle a lack of valid runtime.
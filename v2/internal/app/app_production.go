@@ -6,11 +6,14 @@ import (
 	"context"
 
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreport"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop"
 	"github.com/wailsapp/wails/v2/internal/frontend/dispatcher"
 	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"github.com/wailsapp/wails/v2/internal/logger"
 	"github.com/wailsapp/wails/v2/internal/menumanager"
+	"github.com/wailsapp/wails/v2/internal/system/process"
+	"github.com/wailsapp/wails/v2/internal/system/sidecar"
 	"github.com/wailsapp/wails/v2/pkg/options"
 )
 
@@ -21,6 +24,8 @@ func (a *App) Run() error {
 	if a.shutdownCallback != nil {
 		a.shutdownCallback(a.ctx)
 	}
+	process.TerminateAll()
+	crashreport.Close()
 	return err
 }
 
@@ -54,6 +59,13 @@ func CreateApp(appoptions *options.App) (*App, error) {
 		return nil, err
 	}
 
+	// Install the crash handlers before the frontend is created
+	if err := crashreport.Install(appoptions.CrashReporting); err != nil {
+		myLogger.Error("Unable to install the crash reporting: %s", err.Error())
+	}
+
+	sidecar.SetEmbedded(appoptions.Sidecars)
+
 	// Create the menu manager
 	menuManager := menumanager.NewManager()
 
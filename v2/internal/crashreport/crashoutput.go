@@ -0,0 +1,14 @@
+//go:build go1.23
+// +build go1.23
+
+package crashreport
+
+import (
+	"os"
+	"runtime/debug"
+)
+
+// setCrashOutput writes the output of fatal errors of the Go runtime to the file as well as to stderr
+func setCrashOutput(file *os.File) error {
+	return debug.SetCrashOutput(file, debug.CrashOptions{})
+}
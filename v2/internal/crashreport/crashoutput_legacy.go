@@ -0,0 +1,17 @@
+//go:build !go1.23
+// +build !go1.23
+
+package crashreport
+
+import (
+	"errors"
+	"os"
+)
+
+// setCrashOutput requires Go 1.23
+func setCrashOutput(file *os.File) error {
+	if file == nil {
+		return nil
+	}
+	return errors.New("the crash output of Go requires Go 1.23")
+}
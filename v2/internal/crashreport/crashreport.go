@@ -0,0 +1,169 @@
+package crashreport
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// goCrashPrefix is the prefix of the files receiving the crash output of the Go runtime. A file is created for every
+// run and removed if the application exits cleanly, so an empty file is left by a run that has been killed.
+const goCrashPrefix = "go-crash-"
+
+var (
+	lock    sync.Mutex
+	active  *options.CrashReporting
+	dir     string
+	goCrash *os.File
+)
+
+// Install prepares the directory, uploads the artifacts of previous runs and installs the crash handlers
+func Install(crashOptions *options.CrashReporting) error {
+	if crashOptions == nil {
+		return nil
+	}
+	lock.Lock()
+	defer lock.Unlock()
+	if active != nil {
+		return nil
+	}
+
+	directory := crashOptions.Directory
+	if directory == "" {
+		config, err := os.UserConfigDir()
+		if err != nil {
+			return err
+		}
+		executable, _ := os.Executable()
+		name := strings.TrimSuffix(filepath.Base(executable), filepath.Ext(executable))
+		directory = filepath.Join(config, name, "crashes")
+	}
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return err
+	}
+	previous := pendingReports(directory)
+
+	file, err := os.Create(filepath.Join(directory, fmt.Sprintf("%s%s-%d.log", goCrashPrefix, timestamp(), os.Getpid())))
+	if err != nil {
+		return err
+	}
+	if err := setCrashOutput(file); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		file = nil
+	}
+	active = crashOptions
+	dir = directory
+	goCrash = file
+	platformInstall(crashOptions, directory)
+
+	if crashOptions.UploadURL != "" && len(previous) > 0 {
+		go upload(crashOptions.UploadURL, previous)
+	}
+	return nil
+}
+
+// Close removes the crash output file of the run, it must be called when the application exits cleanly
+func Close() {
+	lock.Lock()
+	defer lock.Unlock()
+	if goCrash == nil {
+		return
+	}
+	_ = setCrashOutput(nil)
+	goCrash.Close()
+	os.Remove(goCrash.Name())
+	goCrash = nil
+}
+
+// Write writes a crash report with the text and returns its path, or an empty path if crash reporting isn't enabled
+func Write(name string, text string) (string, error) {
+	lock.Lock()
+	defer lock.Unlock()
+	if active == nil {
+		return "", nil
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s-%s.log", name, timestamp()))
+	return path, os.WriteFile(path, []byte(text), 0o644)
+}
+
+func timestamp() string {
+	return time.Now().Format("20060102-150405")
+}
+
+// pendingReports returns the artifacts of previous runs, empty crash output files are removed
+func pendingReports(directory string) []string {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil
+	}
+	var result []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(directory, entry.Name())
+		info, err := entry.Info()
+		if err != nil {
+			continue
+		}
+		if info.Size() == 0 {
+			if strings.HasPrefix(entry.Name(), goCrashPrefix) {
+				os.Remove(path)
+			}
+			continue
+		}
+		result = append(result, path)
+	}
+	return result
+}
+
+// upload posts the artifacts to the URL and removes the ones which have been accepted
+func upload(url string, paths []string) {
+	client := &http.Client{Timeout: time.Minute}
+	for _, path := range paths {
+		if err := uploadFile(client, url, path); err == nil {
+			os.Remove(path)
+		}
+	}
+}
+
+func uploadFile(client *http.Client, url string, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	response, err := client.Post(url, writer.FormDataContentType(), &body)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("upload of %s failed with status %s", path, response.Status)
+	}
+	return nil
+}
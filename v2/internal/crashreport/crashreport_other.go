@@ -0,0 +1,9 @@
+//go:build !windows
+// +build !windows
+
+package crashreport
+
+import "github.com/wailsapp/wails/v2/pkg/options"
+
+// platformInstall has nothing to install, the signals are reported in the crash output of the Go runtime
+func platformInstall(crashOptions *options.CrashReporting, directory string) {}
@@ -0,0 +1,91 @@
+//go:build windows
+// +build windows
+
+package crashreport
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	procSetUnhandledExceptionFilter = syscall.NewLazyDLL("kernel32.dll").NewProc("SetUnhandledExceptionFilter")
+	procMiniDumpWriteDump           = syscall.NewLazyDLL("dbghelp.dll").NewProc("MiniDumpWriteDump")
+)
+
+const (
+	exceptionExecuteHandler = 1
+
+	miniDumpWithUnloadedModules = 0x00000020
+	miniDumpWithThreadInfo      = 0x00001000
+)
+
+var exceptionFilter struct {
+	options   *options.CrashReporting
+	directory string
+	callback  uintptr
+}
+
+// platformInstall installs an unhandled exception filter writing a minidump for native exceptions
+func platformInstall(crashOptions *options.CrashReporting, directory string) {
+	exceptionFilter.options = crashOptions
+	exceptionFilter.directory = directory
+	exceptionFilter.callback = syscall.NewCallback(unhandledExceptionFilter)
+	_, _, _ = procSetUnhandledExceptionFilter.Call(exceptionFilter.callback)
+}
+
+func unhandledExceptionFilter(exceptionPointers uintptr) uintptr {
+	path := filepath.Join(exceptionFilter.directory, fmt.Sprintf("crash-%s-%d.dmp", timestamp(), os.Getpid()))
+	err := writeMiniDump(path, exceptionPointers)
+	if exceptionFilter.options.ShowMessage {
+		message := "The application has crashed."
+		if err == nil {
+			message += "\n\nA crash report has been written to " + path
+		}
+		title, _ := windows.UTF16PtrFromString("Error")
+		text, _ := windows.UTF16PtrFromString(message)
+		_, _ = windows.MessageBox(0, text, title, windows.MB_OK|windows.MB_ICONERROR|windows.MB_TASKMODAL)
+	}
+	// The process is terminated without showing the Windows error reporting dialog
+	return exceptionExecuteHandler
+}
+
+// writeMiniDump writes the minidump of the process with the exception
+func writeMiniDump(path string, exceptionPointers uintptr) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// MINIDUMP_EXCEPTION_INFORMATION is packed to 4 bytes, which can't be expressed with a Go struct on 64-bit Windows
+	pointerSize := int(unsafe.Sizeof(exceptionPointers))
+	info := make([]byte, 8+pointerSize)
+	binary.LittleEndian.PutUint32(info[0:], windows.GetCurrentThreadId())
+	if pointerSize == 8 {
+		binary.LittleEndian.PutUint64(info[4:], uint64(exceptionPointers))
+	} else {
+		binary.LittleEndian.PutUint32(info[4:], uint32(exceptionPointers))
+	}
+	binary.LittleEndian.PutUint32(info[4+pointerSize:], 0)
+
+	ok, _, err := procMiniDumpWriteDump.Call(
+		uintptr(windows.CurrentProcess()),
+		uintptr(windows.GetCurrentProcessId()),
+		file.Fd(),
+		miniDumpWithThreadInfo|miniDumpWithUnloadedModules,
+		uintptr(unsafe.Pointer(&info[0])),
+		0,
+		0)
+	if ok == 0 {
+		return err
+	}
+	return nil
+}
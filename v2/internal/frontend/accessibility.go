@@ -0,0 +1,23 @@
+package frontend
+
+import "context"
+
+// AccessibilityChangeEvent is emitted with the new AccessibilitySettings when the user changes the reduced motion or
+// the high contrast setting of the OS
+const AccessibilityChangeEvent = "wails:accessibility:change"
+
+// AccessibilitySettings contains the accessibility settings of the OS the frontend may follow, EG by disabling its
+// animations
+type AccessibilitySettings struct {
+	// ReducedMotion is true if the user has asked for less animations
+	ReducedMotion bool `json:"reducedMotion"`
+	// HighContrast is true if a high contrast theme is active
+	HighContrast bool `json:"highContrast"`
+}
+
+// AccessibilityChanged emits the AccessibilityChangeEvent
+func AccessibilityChanged(ctx context.Context, settings AccessibilitySettings) {
+	if events, ok := ctx.Value("events").(Events); ok {
+		events.Emit(AccessibilityChangeEvent, settings)
+	}
+}
@@ -0,0 +1,36 @@
+package frontend
+
+import (
+	"errors"
+	"time"
+)
+
+// CacheKind selects the browsing data cleared by WebviewClearCache, the kinds can be combined
+type CacheKind int
+
+const (
+	// CacheCookies are the cookies, including the session cookies
+	CacheCookies CacheKind = 1 << iota
+	// CacheDiskCache is the HTTP cache
+	CacheDiskCache
+	// CacheDOMStorage is the local storage and the WebSQL databases
+	CacheDOMStorage
+	// CacheIndexedDB are the IndexedDB databases
+	CacheIndexedDB
+	// CacheOther is the other data of the sites, EG the cache storage of the service workers
+	CacheOther
+
+	// CacheAll is all the browsing data of the sites
+	CacheAll = CacheCookies | CacheDiskCache | CacheDOMStorage | CacheIndexedDB | CacheOther
+)
+
+// ValidateClearCache checks the arguments of WebviewClearCache. A zero start or end leaves the range open.
+func ValidateClearCache(kinds CacheKind, start, end time.Time) error {
+	if kinds&CacheAll == 0 {
+		return errors.New("no browsing data to clear")
+	}
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return errors.New("the end of the time range is before its start")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package frontend
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+)
+
+// ImageFormat is the encoding of the images captured by WebviewCapturePreview and WindowCapture
+type ImageFormat string
+
+const (
+	ImagePNG  ImageFormat = "png"
+	ImageJPEG ImageFormat = "jpeg"
+)
+
+// jpegQuality is the quality of the captured JPEG images, which keeps the text of the pages readable
+const jpegQuality = 90
+
+// Validate returns an error if the format is neither PNG nor JPEG
+func (f ImageFormat) Validate() error {
+	if f != ImagePNG && f != ImageJPEG {
+		return fmt.Errorf("unsupported image format %q, expected png or jpeg", string(f))
+	}
+	return nil
+}
+
+// EncodeImage encodes the image in the format
+func EncodeImage(img image.Image, format ImageFormat) ([]byte, error) {
+	var result bytes.Buffer
+	var err error
+	switch format {
+	case ImagePNG:
+		err = png.Encode(&result, img)
+	case ImageJPEG:
+		err = jpeg.Encode(&result, img, &jpeg.Options{Quality: jpegQuality})
+	default:
+		err = format.Validate()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.Bytes(), nil
+}
+
+// ConvertPNG returns the PNG data in the format, the data is returned as is for PNG
+func ConvertPNG(data []byte, format ImageFormat) ([]byte, error) {
+	if format == ImagePNG {
+		return data, nil
+	}
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	return EncodeImage(img, format)
+}
@@ -0,0 +1,63 @@
+package frontend
+
+import (
+	"encoding/json"
+	"math"
+)
+
+// ContentSize is the rendered size of the document, measured by the ContentSizeScript
+type ContentSize struct {
+	// Width and Height are in CSS pixels
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	// PixelRatio is the devicePixelRatio of the page, which is the scale of the display multiplied by the zoom
+	PixelRatio float64 `json:"pixelRatio"`
+}
+
+// Physical returns the size in device pixels, rounded up so the content isn't clipped
+func (s ContentSize) Physical() (int, int) {
+	ratio := s.PixelRatio
+	if ratio <= 0 {
+		ratio = 1
+	}
+	return int(math.Ceil(s.Width * ratio)), int(math.Ceil(s.Height * ratio))
+}
+
+// ContentSizeScript measures the document and evaluates to the ContentSize as JSON. The result is read from the
+// webview rather than sent as a message, which the scripts of the page could forge. The document is measured at its
+// max-content size, the scroll size of the document is never smaller than the window, so the window can shrink to
+// the content as well as grow.
+const ContentSizeScript = `(function() {
+	var root = document.documentElement;
+	var style = root.style.cssText;
+	root.style.width = "max-content";
+	root.style.height = "max-content";
+	root.style.minWidth = "0";
+	root.style.minHeight = "0";
+	var rect = root.getBoundingClientRect();
+	var width = Math.max(rect.width, document.body ? document.body.scrollWidth : 0);
+	var height = Math.max(rect.height, document.body ? document.body.scrollHeight : 0);
+	root.style.cssText = style;
+	return JSON.stringify({width: width, height: height, pixelRatio: window.devicePixelRatio});
+})();`
+
+// ParseContentSize returns the ContentSize the ContentSizeScript evaluated to
+func ParseContentSize(result string) (ContentSize, error) {
+	var size ContentSize
+	err := json.Unmarshal([]byte(result), &size)
+	return size, err
+}
+
+// MeasureContent evaluates the ContentSizeScript with eval and returns the size of the content in device pixels
+func MeasureContent(eval func(script string) (string, error)) (int, int, error) {
+	result, err := eval(ContentSizeScript)
+	if err != nil {
+		return 0, 0, err
+	}
+	size, err := ParseContentSize(result)
+	if err != nil {
+		return 0, 0, err
+	}
+	width, height := size.Physical()
+	return width, height, nil
+}
@@ -0,0 +1,52 @@
+package frontend
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Cursors for WindowSetCursor, the names are the CSS cursor names
+const (
+	CursorDefault    = "default"
+	CursorPointer    = "pointer"
+	CursorText       = "text"
+	CursorCrosshair  = "crosshair"
+	CursorWait       = "wait"
+	CursorProgress   = "progress"
+	CursorMove       = "move"
+	CursorNotAllowed = "not-allowed"
+	CursorGrab       = "grab"
+	CursorGrabbing   = "grabbing"
+	CursorHelp       = "help"
+	CursorResizeEW   = "ew-resize"
+	CursorResizeNS   = "ns-resize"
+	CursorResizeNESW = "nesw-resize"
+	CursorResizeNWSE = "nwse-resize"
+)
+
+// CursorScript returns the script which overrides the cursor of all elements of the page with the CSS cursor value.
+// An empty value removes the override.
+func CursorScript(cursor string) string {
+	encoded, _ := json.Marshal(cursor)
+	return fmt.Sprintf(`(function(cursor) {
+	var style = document.getElementById("wails-cursor");
+	if (!cursor) {
+		if (style) {
+			style.remove();
+		}
+		return;
+	}
+	if (!style) {
+		style = document.createElement("style");
+		style.id = "wails-cursor";
+		document.head.appendChild(style);
+	}
+	style.textContent = "*, *::before, *::after { cursor: " + cursor + " !important; }";
+})(%s);`, encoded)
+}
+
+// CursorImageCSS returns the CSS cursor value of a PNG cursor image
+func CursorImageCSS(image []byte, hotspotX int, hotspotY int) string {
+	return fmt.Sprintf(`url("data:image/png;base64,%s") %d %d, auto`, base64.StdEncoding.EncodeToString(image), hotspotX, hotspotY)
+}
@@ -18,6 +18,7 @@
 @property (retain) NSString* singleInstanceUniqueId;
 @property bool singleInstanceLockEnabled;
 @property bool startFullscreen;
+@property bool startActivated;
 @property (retain) WailsWindow* mainWindow;
 
 @end
@@ -40,6 +40,12 @@
 
 - (void)applicationDidFinishLaunching:(NSNotification *)aNotification {
     [NSApp activateIgnoringOtherApps:YES];
+    if ( self.startActivated && !self.startHidden ) {
+        // The activation is refused when the application has been launched by a background process, ordering the
+        // window in front regardless of the active application makes it visible
+        [self.mainWindow makeKeyAndOrderFront:self];
+        [self.mainWindow orderFrontRegardless];
+    }
     if ( self.startFullscreen ) {
         NSWindowCollectionBehavior behaviour = [self.mainWindow collectionBehavior];
         behaviour |= NSWindowCollectionBehaviorFullScreenPrimary;
@@ -47,6 +53,21 @@
         [self.mainWindow toggleFullScreen:nil];
     }
 
+    [[NSNotificationCenter defaultCenter] addObserver:self
+        selector:@selector(handleLocaleChangedNotification:) name:NSCurrentLocaleDidChangeNotification object:nil];
+
+    // The lock of the screen and the switches of the user are sent to Go as session changes
+    NSDistributedNotificationCenter *distributedCenter = [NSDistributedNotificationCenter defaultCenter];
+    [distributedCenter addObserver:self selector:@selector(handleScreenLockedNotification:) name:@"com.apple.screenIsLocked" object:nil];
+    [distributedCenter addObserver:self selector:@selector(handleScreenUnlockedNotification:) name:@"com.apple.screenIsUnlocked" object:nil];
+    NSNotificationCenter *workspaceCenter = [[NSWorkspace sharedWorkspace] notificationCenter];
+    [workspaceCenter addObserver:self selector:@selector(handleSessionResignActiveNotification:) name:NSWorkspaceSessionDidResignActiveNotification object:nil];
+    [workspaceCenter addObserver:self selector:@selector(handleAccessibilityChangedNotification:) name:NSWorkspaceAccessibilityDisplayOptionsDidChangeNotification object:nil];
+    [workspaceCenter addObserver:self selector:@selector(handleSessionBecomeActiveNotification:) name:NSWorkspaceSessionDidBecomeActiveNotification object:nil];
+
+    // The services declared with the NSMessage "wailsService" in the NSServices of the Info.plist are sent to Go
+    [NSApp setServicesProvider:self];
+
     if ( self.singleInstanceLockEnabled ) {
       [[NSDistributedNotificationCenter defaultCenter] addObserver:self
           selector:@selector(handleSecondInstanceNotification:) name:self.singleInstanceUniqueId object:nil];
@@ -70,6 +91,48 @@ char* GetMacOsNativeTempDir() {
     return copy;
 }
 
+- (void)wailsService:(NSPasteboard *)pboard userData:(NSString *)userData error:(NSString **)error {
+    NSMutableArray *files = [NSMutableArray new];
+    NSArray<NSURL*> *urls = [pboard readObjectsForClasses:@[[NSURL class]] options:@{NSPasteboardURLReadingFileURLsOnlyKey: @YES}];
+    for (NSURL *url in urls) {
+        [files addObject:url.path];
+    }
+    NSDictionary *invocation = @{
+        @"name": userData ?: @"",
+        @"text": [pboard stringForType:NSPasteboardTypeString] ?: @"",
+        @"files": files,
+    };
+    [files release];
+    NSData *data = [NSJSONSerialization dataWithJSONObject:invocation options:0 error:nil];
+    NSString *json = [[NSString alloc] initWithData:data encoding:NSUTF8StringEncoding];
+    processServiceInvocation([json UTF8String]);
+    [json release];
+}
+
+- (void)handleLocaleChangedNotification:(NSNotification *)note {
+    processLocaleChanged();
+}
+
+- (void)handleAccessibilityChangedNotification:(NSNotification *)note {
+    processAccessibilityChanged();
+}
+
+- (void)handleScreenLockedNotification:(NSNotification *)note {
+    processSessionChange("lock");
+}
+
+- (void)handleScreenUnlockedNotification:(NSNotification *)note {
+    processSessionChange("unlock");
+}
+
+- (void)handleSessionResignActiveNotification:(NSNotification *)note {
+    processSessionChange("disconnect");
+}
+
+- (void)handleSessionBecomeActiveNotification:(NSNotification *)note {
+    processSessionChange("reconnect");
+}
+
 - (void)handleSecondInstanceNotification:(NSNotification *)note;
 {
     if (note.object != nil) {
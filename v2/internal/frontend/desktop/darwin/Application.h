@@ -17,18 +17,30 @@
 #define WindowStartsMinimised 2
 #define WindowStartsFullscreen 3
 
-WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int zoomable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int devtoolsEnabled, int defaultContextMenuEnabled, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight, bool fraudulentWebsiteWarningEnabled, struct Preferences preferences, int singleInstanceEnabled, const char* singleInstanceUniqueId, bool enableDragAndDrop, bool disableWebViewDragAndDrop);
+WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int zoomable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int devtoolsEnabled, int defaultContextMenuEnabled, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight, bool fraudulentWebsiteWarningEnabled, struct Preferences preferences, int singleInstanceEnabled, const char* singleInstanceUniqueId, bool enableDragAndDrop, bool disableWebViewDragAndDrop, bool disableBackgroundThrottling, const char* customSchemes);
 void Run(void*, const char* url);
 
 void SetTitle(void* ctx, const char *title);
 void Center(void* ctx);
 void SetSize(void* ctx, int width, int height);
+void SizeToContent(void* ctx, int width, int height);
 void SetAlwaysOnTop(void* ctx, int onTop);
+void SetWindowLevel(void* ctx, int level);
+void SetWindowOpacity(void* ctx, double opacity);
+void SetUserAgent(void* ctx, const char* userAgent);
+void SetActivation(void* ctx, bool startActivated, bool focusOnShow);
+void SetTextSelection(void* ctx, const char* script, bool disableCopy);
+void AddPreloadScript(void* ctx, const char* identifier, const char* script);
+void RemovePreloadScript(void* ctx, const char* identifier);
+void ShowNavigationProgress(void* ctx);
+void Focus(void* ctx);
+void SetKeyboardNavigation(void* ctx, bool contained, bool disableFocusRing);
 void SetMinSize(void* ctx, int width, int height);
 void SetMaxSize(void* ctx, int width, int height);
 void SetPosition(void* ctx, int x, int y);
 void Fullscreen(void* ctx);
 void UnFullscreen(void* ctx);
+void BorderlessFullscreen(void* ctx, int screen);
 void Minimise(void* ctx);
 void UnMinimise(void* ctx);
 void ToggleMaximise(void* ctx);
@@ -42,6 +54,7 @@ void SetBackgroundColour(void* ctx, int r, int g, int b, int a);
 void ExecJS(void* ctx, const char*);
 void Quit(void*);
 void WindowPrint(void* ctx);
+void StartDrag(void* ctx);
 
 const char* GetSize(void *ctx);
 const char* GetPosition(void *ctx);
@@ -14,7 +14,7 @@
 #import "WailsMenu.h"
 #import "WailsMenuItem.h"
 
-WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int zoomable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int devtoolsEnabled, int defaultContextMenuEnabled, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight, bool fraudulentWebsiteWarningEnabled, struct Preferences preferences, int singleInstanceLockEnabled, const char* singleInstanceUniqueId, bool enableDragAndDrop, bool disableWebViewDragAndDrop) {
+WailsContext* Create(const char* title, int width, int height, int frameless, int resizable, int zoomable, int fullscreen, int fullSizeContent, int hideTitleBar, int titlebarAppearsTransparent, int hideTitle, int useToolbar, int hideToolbarSeparator, int webviewIsTransparent, int alwaysOnTop, int hideWindowOnClose, const char *appearance, int windowIsTranslucent, int devtoolsEnabled, int defaultContextMenuEnabled, int windowStartState, int startsHidden, int minWidth, int minHeight, int maxWidth, int maxHeight, bool fraudulentWebsiteWarningEnabled, struct Preferences preferences, int singleInstanceLockEnabled, const char* singleInstanceUniqueId, bool enableDragAndDrop, bool disableWebViewDragAndDrop, bool disableBackgroundThrottling, const char* customSchemes) {
 
     [NSApplication sharedApplication];
 
@@ -22,6 +22,12 @@ WailsContext* Create(const char* title, int width, int height, int frameless, in
 
     result.devtoolsEnabled = devtoolsEnabled;
     result.defaultContextMenuEnabled = defaultContextMenuEnabled;
+    result.backgroundThrottlingDisabled = disableBackgroundThrottling;
+    // The custom schemes are separated by new lines
+    NSString *_customSchemes = safeInit(customSchemes);
+    if ([_customSchemes length] > 0) {
+        result.customSchemes = [_customSchemes componentsSeparatedByString:@"\n"];
+    }
 
     if ( windowStartState == WindowStartsFullscreen ) {
         fullscreen = 1;
@@ -91,6 +97,13 @@ void SetSize(void* inctx, int width, int height) {
     );
 }
 
+void SizeToContent(void* inctx, int width, int height) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SizeToContent:width :height];
+    );
+}
+
 void SetAlwaysOnTop(void* inctx, int onTop) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -98,6 +111,80 @@ void SetAlwaysOnTop(void* inctx, int onTop) {
     );
 }
 
+void SetWindowLevel(void* inctx, int level) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetLevel:level];
+    );
+}
+
+void SetWindowOpacity(void* inctx, double opacity) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx.mainWindow setAlphaValue:opacity];
+    );
+}
+
+void SetUserAgent(void* inctx, const char* userAgent) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_userAgent = safeInit(userAgent);
+    ON_MAIN_THREAD(
+       // A nil custom user agent restores the default, which ends with the applicationNameForUserAgent
+       [ctx.webview setCustomUserAgent:([_userAgent length] == 0 ? nil : _userAgent)];
+    );
+}
+
+void AddPreloadScript(void* inctx, const char* identifier, const char* script) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_identifier = safeInit(identifier);
+    NSString *_script = safeInit(script);
+    ON_MAIN_THREAD(
+       [ctx AddPreloadScript:_identifier :_script];
+    );
+}
+
+void RemovePreloadScript(void* inctx, const char* identifier) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSString *_identifier = safeInit(identifier);
+    ON_MAIN_THREAD(
+       [ctx RemovePreloadScript:_identifier];
+    );
+}
+
+void SetTextSelection(void* inctx, const char* script, bool disableCopy) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    // This is called before Run, so the script is added before the page is loaded
+    NSString *_script = safeInit(script);
+    [ctx SetTextSelection:_script :disableCopy];
+}
+
+void ShowNavigationProgress(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    // This is called before Run, so the bar is shown for the first load
+    [ctx ShowNavigationProgress];
+}
+
+void SetActivation(void* inctx, bool startActivated, bool focusOnShow) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    // This is called before Run, which passes startActivated to the app delegate
+    ctx.startActivated = startActivated;
+    ctx.focusOnShow = focusOnShow;
+}
+
+void Focus(void *inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx Focus];
+    );
+}
+
+void SetKeyboardNavigation(void* inctx, bool contained, bool disableFocusRing) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx SetKeyboardNavigation:contained :disableFocusRing];
+    );
+}
+
 void SetMinSize(void* inctx, int width, int height) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -126,6 +213,13 @@ void Center(void* inctx) {
     );
 }
 
+void StartDrag(void* inctx) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx StartDrag];
+    );
+}
+
 void Fullscreen(void* inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -140,6 +234,13 @@ void UnFullscreen(void* inctx) {
     );
 }
 
+void BorderlessFullscreen(void* inctx, int screen) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    ON_MAIN_THREAD(
+       [ctx BorderlessFullscreen:screen];
+    );
+}
+
 void Minimise(void* inctx) {
     WailsContext *ctx = (__bridge WailsContext*) inctx;
     ON_MAIN_THREAD(
@@ -375,6 +476,7 @@ void Run(void *inctx, const char* url) {
     delegate.singleInstanceLockEnabled = ctx.singleInstanceLockEnabled;
     delegate.singleInstanceUniqueId = ctx.singleInstanceUniqueId;
     delegate.startFullscreen = ctx.startFullscreen;
+    delegate.startActivated = ctx.startActivated;
 
     NSString *_url = safeInit(url);
     [ctx loadRequest:_url];
@@ -40,6 +40,8 @@
 @property bool shuttingDown;
 @property bool startHidden;
 @property bool startFullscreen;
+@property bool startActivated;
+@property bool focusOnShow;
 
 @property bool singleInstanceLockEnabled;
 @property (retain) NSString* singleInstanceUniqueId;
@@ -50,8 +52,21 @@
 
 @property bool devtoolsEnabled;
 @property bool defaultContextMenuEnabled;
+@property bool backgroundThrottlingDisabled;
+@property (retain) id<NSObject> backgroundActivity;
 
 @property (retain) WKUserContentController* userContentController;
+@property (retain) NSArray<NSString*>* customSchemes;
+@property (retain) NSMutableDictionary<NSString*, WKUserScript*>* preloadScripts;
+
+@property (retain) NSView* navigationProgressBar;
+
+@property bool borderlessFullscreen;
+@property (retain) NSNumber* pendingBorderlessScreen;
+@property NSRect borderlessRestoreFrame;
+@property NSWindowStyleMask borderlessRestoreStyleMask;
+@property NSWindowLevel borderlessRestoreLevel;
+@property NSApplicationPresentationOptions borderlessRestorePresentationOptions;
 
 @property (retain) NSMenu* applicationMenu;
 
@@ -67,15 +82,25 @@ struct Preferences {
 
 - (void) CreateWindow:(int)width :(int)height :(bool)frameless :(bool)resizable :(bool)zoomable :(bool)fullscreen :(bool)fullSizeContent :(bool)hideTitleBar :(bool)titlebarAppearsTransparent  :(bool)hideTitle :(bool)useToolbar :(bool)hideToolbarSeparator :(bool)webviewIsTransparent :(bool)hideWindowOnClose :(NSString *)appearance :(bool)windowIsTranslucent :(int)minWidth :(int)minHeight :(int)maxWidth :(int)maxHeight :(bool)fraudulentWebsiteWarningEnabled :(struct Preferences)preferences :(bool)enableDragAndDrop :(bool)disableWebViewDragAndDrop;
 - (void) SetSize:(int)width :(int)height;
+- (void) SizeToContent:(int)width :(int)height;
 - (void) SetPosition:(int)x :(int) y;
 - (void) SetMinSize:(int)minWidth :(int)minHeight;
 - (void) SetMaxSize:(int)maxWidth :(int)maxHeight;
 - (void) SetTitle:(NSString*)title;
 - (void) SetAlwaysOnTop:(int)onTop;
+- (void) SetLevel:(int)level;
+- (void) SetKeyboardNavigation:(bool)contained :(bool)disableFocusRing;
+- (void) SetTextSelection:(NSString*)script :(bool)disableCopy;
+- (void) AddPreloadScript:(NSString*)identifier :(NSString*)script;
+- (void) RemovePreloadScript:(NSString*)identifier;
+- (void) ShowNavigationProgress;
+- (void) UpdateNavigationProgress:(double)progress;
 - (void) Center;
 - (void) Fullscreen;
 - (void) UnFullscreen;
+- (void) BorderlessFullscreen:(int)screenIndex;
 - (bool) IsFullScreen;
+- (void) StartDrag;
 - (void) Minimise;
 - (void) UnMinimise;
 - (bool) IsMinimised;
@@ -88,6 +113,7 @@ struct Preferences {
 - (void) ShowMouse;
 - (void) Hide;
 - (void) Show;
+- (void) Focus;
 - (void) HideApplication;
 - (void) ShowApplication;
 - (void) Quit;
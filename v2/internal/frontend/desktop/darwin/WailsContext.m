@@ -49,6 +49,28 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     [self.mainWindow setFrame:frame display:TRUE animate:FALSE];
 }
 
+// SizeToContent resizes the window so the webview has the size in device pixels, keeping the top left corner. The
+// window is clamped to the visible frame of its screen and moved back into it if needed.
+- (void) SizeToContent:(int)width :(int)height {
+
+    if (self.shuttingDown || [self IsFullScreen] || [self IsMaximised]) return;
+
+    CGFloat scale = [self.mainWindow backingScaleFactor];
+    NSRect frame = [self.mainWindow frame];
+    NSSize webviewSize = [self.webview frame].size;
+    CGFloat newWidth = ceil(width / scale) + frame.size.width - webviewSize.width;
+    CGFloat newHeight = ceil(height / scale) + frame.size.height - webviewSize.height;
+
+    NSRect visible = [[self getCurrentScreen] visibleFrame];
+    newWidth = MIN(newWidth, visible.size.width);
+    newHeight = MIN(newHeight, visible.size.height);
+    frame.origin.y += frame.size.height - newHeight;
+    frame.size = NSMakeSize(newWidth, newHeight);
+    frame.origin.x = MAX(NSMinX(visible), MIN(frame.origin.x, NSMaxX(visible) - newWidth));
+    frame.origin.y = MAX(NSMinY(visible), MIN(frame.origin.y, NSMaxY(visible) - newHeight));
+    [self.mainWindow setFrame:frame display:TRUE animate:FALSE];
+}
+
 - (void) SetPosition:(int)x :(int)y {
 
     if (self.shuttingDown) return;
@@ -108,7 +130,11 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     [self.mainWindow release];
     [self.mouseEvent release];
     [self.userContentController release];
+    [self.preloadScripts release];
+    [self.customSchemes release];
     [self.applicationMenu release];
+    [self.navigationProgressBar release];
+    [self.pendingBorderlessScreen release];
     [super dealloc];
 }
 
@@ -218,6 +244,9 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     config.suppressesIncrementalRendering = true;
     config.applicationNameForUserAgent = @"wails.io";
     [config setURLSchemeHandler:self forURLScheme:@"wails"];
+    for (NSString *scheme in self.customSchemes) {
+        [config setURLSchemeHandler:self forURLScheme:scheme];
+    }
 
     if (preferences.tabFocusesLinks != NULL) {
         config.preferences.tabFocusesLinks = *preferences.tabFocusesLinks;
@@ -254,6 +283,20 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
         [config.preferences setValue:@YES forKey:@"developerExtrasEnabled"];
     }
 
+    if (self.backgroundThrottlingDisabled) {
+        // There is no public API to keep the timers of hidden or occluded pages running, the private preferences
+        // might not exist in every WebKit version.
+        @try {
+            [config.preferences setValue:@NO forKey:@"hiddenPageDOMTimerThrottlingEnabled"];
+            [config.preferences setValue:@NO forKey:@"pageVisibilityBasedProcessSuppressionEnabled"];
+        } @catch (NSException *exception) {
+            NSLog(@"Unable to disable the background throttling of the webview: %@", exception.reason);
+        }
+        // Opt out of App Nap, which throttles the timers of the app when its windows are occluded
+        self.backgroundActivity = [[NSProcessInfo processInfo] beginActivityWithOptions:NSActivityUserInitiatedAllowingIdleSystemSleep
+                                                                                 reason:@"DisableBackgroundThrottling"];
+    }
+
     if (!self.defaultContextMenuEnabled) {
         // Disable default context menus
         WKUserScript *initScript = [WKUserScript new];
@@ -281,6 +324,11 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     [self.webview setNavigationDelegate:self];
     self.webview.UIDelegate = self;
 
+    if (@available(macOS 13.0, *)) {
+        [self.webview addObserver:self forKeyPath:@"fullscreenState" options:NSKeyValueObservingOptionNew context:nil];
+    }
+    [self.webview addObserver:self forKeyPath:@"estimatedProgress" options:NSKeyValueObservingOptionNew context:nil];
+
     NSUserDefaults *defaults = [NSUserDefaults standardUserDefaults];
     [defaults setBool:FALSE forKey:@"NSAutomaticQuoteSubstitutionEnabled"];
 
@@ -354,20 +402,69 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
 }
 
 - (bool) IsFullScreen {
+    if( self.borderlessFullscreen ) {
+        return true;
+    }
     long mask = [self.mainWindow styleMask];
     return (mask & NSWindowStyleMaskFullScreen) == NSWindowStyleMaskFullScreen;
 }
 
 // Fullscreen sets the main window to be fullscreen
 - (void) Fullscreen {
+    [self UnBorderlessFullscreen];
     if( ! [self IsFullScreen] ) {
         [self.mainWindow disableWindowConstraints];
         [self.mainWindow toggleFullScreen:nil];
     }
 }
 
+// BorderlessFullscreen covers the screen with the main window above the menu bar and the dock. Unlike the native
+// fullscreen it isn't animated and doesn't create a space.
+- (void) BorderlessFullscreen:(int)screenIndex {
+    NSArray<NSScreen *> *screens = [NSScreen screens];
+    if( screenIndex < 0 || screenIndex >= [screens count] ) {
+        return;
+    }
+    if( [self isFullscreen] ) {
+        // The native fullscreen has to be left first, see windowDidExitFullScreen
+        self.pendingBorderlessScreen = [NSNumber numberWithInt:screenIndex];
+        [self.mainWindow toggleFullScreen:nil];
+        return;
+    }
+    if( ! self.borderlessFullscreen ) {
+        self.borderlessRestoreFrame = [self.mainWindow frame];
+        self.borderlessRestoreStyleMask = [self.mainWindow styleMask];
+        self.borderlessRestoreLevel = [self.mainWindow level];
+        self.borderlessRestorePresentationOptions = [NSApp presentationOptions];
+        self.borderlessFullscreen = true;
+        [self.mainWindow disableWindowConstraints];
+        [self.mainWindow setStyleMask:NSWindowStyleMaskBorderless];
+        [self.mainWindow setLevel:NSMainMenuWindowLevel + 1];
+        [NSApp setPresentationOptions:NSApplicationPresentationHideDock | NSApplicationPresentationHideMenuBar];
+    }
+    [self.mainWindow setFrame:[[screens objectAtIndex:screenIndex] frame] display:YES animate:NO];
+    [self.mainWindow makeKeyAndOrderFront:nil];
+}
+
+- (void) UnBorderlessFullscreen {
+    if( ! self.borderlessFullscreen ) {
+        return;
+    }
+    self.borderlessFullscreen = false;
+    [NSApp setPresentationOptions:self.borderlessRestorePresentationOptions];
+    [self.mainWindow setStyleMask:self.borderlessRestoreStyleMask];
+    [self.mainWindow setLevel:self.borderlessRestoreLevel];
+    [self.mainWindow setFrame:self.borderlessRestoreFrame display:YES animate:NO];
+    [self.mainWindow applyWindowConstraints];
+}
+
 // UnFullscreen resets the main window after a fullscreen
 - (void) UnFullscreen {
+    self.pendingBorderlessScreen = nil;
+    if( self.borderlessFullscreen ) {
+        [self UnBorderlessFullscreen];
+        return;
+    }
     if( [self IsFullScreen] ) {
         [self.mainWindow applyWindowConstraints];
         [self.mainWindow toggleFullScreen:nil];
@@ -391,10 +488,25 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
 }
 
 - (void) Show {
+    if (self.focusOnShow) {
+        [self Focus];
+        return;
+    }
     [self.mainWindow makeKeyAndOrderFront:nil];
     [NSApp activateIgnoringOtherApps:YES];
 }
 
+// Focus brings the window in front of the windows of other applications, even if the application isn't active
+- (void) Focus {
+    [NSApp unhide:nil];
+    if ([self.mainWindow isMiniaturized]) {
+        [self.mainWindow deminiaturize:nil];
+    }
+    [NSApp activateIgnoringOtherApps:YES];
+    [self.mainWindow makeKeyAndOrderFront:nil];
+    [self.mainWindow orderFrontRegardless];
+}
+
 - (void) HideApplication {
     [[NSApplication sharedApplication] hide:self];
 }
@@ -429,6 +541,97 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     }
 }
 
+- (void) SetLevel:(int)level {
+    [self.mainWindow setLevel:level];
+}
+
+- (void) SetKeyboardNavigation:(bool)contained :(bool)disableFocusRing {
+    if (contained) {
+        // WebKit moves the focus to the next key view of the window when tabbing out of the content. Closing the key
+        // view loop on the webview lets the focus wrap around to the first focusable element instead.
+        [self.mainWindow setAutorecalculatesKeyViewLoop:NO];
+        [self.mainWindow setInitialFirstResponder:self.webview];
+        [self.webview setNextKeyView:self.webview];
+    }
+    if (disableFocusRing) {
+        [self.webview setFocusRingType:NSFocusRingTypeNone];
+    }
+}
+
+- (void) SetTextSelection:(NSString*)script :(bool)disableCopy {
+    WKUserScript *userScript = [[WKUserScript alloc] initWithSource:script
+                                                     injectionTime:WKUserScriptInjectionTimeAtDocumentStart
+                                                  forMainFrameOnly:false];
+    [self.userContentController addUserScript:userScript];
+    [userScript release];
+    self.webview.disableCopy = disableCopy;
+}
+
+- (void) AddPreloadScript:(NSString*)identifier :(NSString*)script {
+    WKUserScript *userScript = [[WKUserScript alloc] initWithSource:script
+                                                     injectionTime:WKUserScriptInjectionTimeAtDocumentStart
+                                                  forMainFrameOnly:false];
+    [self.userContentController addUserScript:userScript];
+    if (self.preloadScripts == nil) {
+        self.preloadScripts = [NSMutableDictionary dictionary];
+    }
+    self.preloadScripts[identifier] = userScript;
+    [userScript release];
+}
+
+- (void) RemovePreloadScript:(NSString*)identifier {
+    WKUserScript *userScript = self.preloadScripts[identifier];
+    if (userScript == nil) {
+        return;
+    }
+    // WebKit only removes all the user scripts, the others are added back in their order
+    NSArray<WKUserScript*> *userScripts = [self.userContentController.userScripts copy];
+    [self.userContentController removeAllUserScripts];
+    for (WKUserScript *script in userScripts) {
+        if (script != userScript) {
+            [self.userContentController addUserScript:script];
+        }
+    }
+    [userScripts release];
+    [self.preloadScripts removeObjectForKey:identifier];
+}
+
+- (void) ShowNavigationProgress {
+    NSView *bar = [[NSView alloc] initWithFrame:NSZeroRect];
+    [bar setWantsLayer:YES];
+    NSColor *colour = [NSColor selectedControlColor];
+    if (@available(macOS 10.14, *)) {
+        colour = [NSColor controlAccentColor];
+    }
+    bar.layer.backgroundColor = colour.CGColor;
+    // The bar stays at the top of the webview and keeps its proportion of the width when the window is resized
+    [bar setAutoresizingMask:NSViewWidthSizable|NSViewMaxXMargin|NSViewMinYMargin];
+    [bar setHidden:YES];
+    [self.mainWindow.contentView addSubview:bar positioned:NSWindowAbove relativeTo:self.webview];
+    self.navigationProgressBar = bar;
+    [bar release];
+}
+
+- (void) UpdateNavigationProgress:(double)progress {
+    NSView *bar = self.navigationProgressBar;
+    if (bar == nil) {
+        return;
+    }
+    NSRect frame = [self.webview frame];
+    CGFloat height = 3;
+    [bar setFrame:NSMakeRect(NSMinX(frame), NSMaxY(frame) - height, NSWidth(frame) * progress, height)];
+    if (progress < 1.0) {
+        [bar setHidden:NO];
+        return;
+    }
+    // The full bar is shown for a moment once the page has loaded
+    dispatch_after(dispatch_time(DISPATCH_TIME_NOW, (int64_t)(0.2 * NSEC_PER_SEC)), dispatch_get_main_queue(), ^{
+        if (self.webview.estimatedProgress >= 1.0) {
+            [bar setHidden:YES];
+        }
+    });
+}
+
 - (bool) IsMaximised {
     return [self.mainWindow isZoomed];
 }
@@ -476,17 +679,41 @@ typedef void (^schemeTaskCaller)(id<WKURLSchemeTask>);
     processMessage("DomReady");
 }
 
+- (void)observeValueForKeyPath:(NSString *)keyPath ofObject:(id)object change:(NSDictionary *)change context:(void *)context {
+    if (object == self.webview && [keyPath isEqualToString:@"fullscreenState"]) {
+        if (@available(macOS 13.0, *)) {
+            if (self.webview.fullscreenState == WKFullscreenStateInFullscreen) {
+                processFullscreenChange(1);
+            } else if (self.webview.fullscreenState == WKFullscreenStateNotInFullscreen) {
+                processFullscreenChange(0);
+            }
+        }
+        return;
+    }
+    if (object == self.webview && [keyPath isEqualToString:@"estimatedProgress"]) {
+        double progress = self.webview.estimatedProgress;
+        processNavigationProgress(progress);
+        [self UpdateNavigationProgress:progress];
+        return;
+    }
+    [super observeValueForKeyPath:keyPath ofObject:object change:change context:context];
+}
+
+- (void) StartDrag {
+    if( [self IsFullScreen] ) {
+        return;
+    }
+    if( self.mouseEvent != nil ) {
+       [self.mainWindow performWindowDragWithEvent:self.mouseEvent];
+    }
+}
+
 - (void)userContentController:(nonnull WKUserContentController *)userContentController didReceiveScriptMessage:(nonnull WKScriptMessage *)message {
     NSString *m = message.body;
 
     // Check for drag
     if ( [m isEqualToString:@"drag"] ) {
-        if( [self IsFullScreen] ) {
-            return;
-        }
-        if( self.mouseEvent != nil ) {
-           [self.mainWindow performWindowDragWithEvent:self.mouseEvent];
-        }
+        [self StartDrag];
         return;
     }
 
@@ -74,6 +74,15 @@
                 [appMenu addItem:[NSMenuItem separatorItem]];
             }
 
+            // The Services menu lists the services of other applications for the selection of the webview
+            NSMenu *servicesMenu = [[[NSMenu alloc] initWithTitle:@"Services"] autorelease];
+            NSMenuItem *servicesMenuItem = [[NSMenuItem new] autorelease];
+            [servicesMenuItem setTitle:@"Services"];
+            [servicesMenuItem setSubmenu:servicesMenu];
+            [NSApp setServicesMenu:servicesMenu];
+            [appMenu addItem:servicesMenuItem];
+            [appMenu addItem:[NSMenuItem separatorItem]];
+
             [appMenu addItem:[self newMenuItem:[@"Hide " stringByAppendingString:appName] :@selector(hide:) :@"h" :NSEventModifierFlagCommand]];
             [appMenu addItem:[self newMenuItem:@"Hide Others" :@selector(hideOtherApplications:) :@"h" :(NSEventModifierFlagOption | NSEventModifierFlagCommand)]];
             [appMenu addItem:[self newMenuItem:@"Show All" :@selector(unhideAllApplications:) :@""]];
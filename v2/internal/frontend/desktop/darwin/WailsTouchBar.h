@@ -0,0 +1,23 @@
+//
+//  WailsTouchBar.h
+//
+
+#ifndef WailsTouchBar_h
+#define WailsTouchBar_h
+
+#import <Cocoa/Cocoa.h>
+
+// WailsTouchBar builds the Touch Bar from the items set with SetTouchBar and reports the interactions with
+// processTouchBarEvent
+@interface WailsTouchBar : NSObject <NSTouchBarDelegate, NSScrubberDataSource, NSScrubberDelegate>
+
+@property (retain) NSArray<NSDictionary*>* items;
+@property int generation;
+
+- (NSTouchBar*) makeTouchBar;
+
+@end
+
+void SetTouchBar(void *inctx, const char *items, int generation);
+
+#endif /* WailsTouchBar_h */
@@ -0,0 +1,148 @@
+//go:build darwin
+//
+//  WailsTouchBar.m
+//
+
+#import <Foundation/Foundation.h>
+
+#import "WailsContext.h"
+#import "WailsTouchBar.h"
+#include "message.h"
+
+static NSString *const itemPrefix = @"wails.touchbar.";
+
+@implementation WailsTouchBar
+
+- (NSTouchBar*) makeTouchBar {
+    NSTouchBar *touchBar = [[NSTouchBar new] autorelease];
+    touchBar.delegate = self;
+    NSMutableArray<NSTouchBarItemIdentifier> *identifiers = [NSMutableArray new];
+    for (NSUInteger index = 0; index < self.items.count; index++) {
+        [identifiers addObject:[NSString stringWithFormat:@"%@%lu", itemPrefix, (unsigned long)index]];
+    }
+    touchBar.defaultItemIdentifiers = identifiers;
+    [identifiers release];
+    return touchBar;
+}
+
+- (void) send:(NSInteger)index :(NSString*)value {
+    processTouchBarEvent(self.generation, (long)index, [value UTF8String]);
+}
+
+// optionsForTag returns the entries of a scrubber, a nil slice is marshalled as null
+- (NSArray*) optionsForTag:(NSInteger)tag {
+    NSArray *options = [self itemForTag:tag][@"options"];
+    return [options isKindOfClass:[NSArray class]] ? options : @[];
+}
+
+- (NSDictionary*) itemForTag:(NSInteger)tag {
+    if (tag < 0 || tag >= (NSInteger)self.items.count) {
+        return nil;
+    }
+    return self.items[tag];
+}
+
+- (NSTouchBarItem *) touchBar:(NSTouchBar *)touchBar makeItemForIdentifier:(NSTouchBarItemIdentifier)identifier {
+    if (![identifier hasPrefix:itemPrefix]) {
+        return nil;
+    }
+    NSInteger index = [[identifier substringFromIndex:itemPrefix.length] integerValue];
+    NSDictionary *item = [self itemForTag:index];
+    if (item == nil) {
+        return nil;
+    }
+    NSString *type = item[@"type"];
+    NSString *label = item[@"label"] ?: @"";
+
+    if ([type isEqualToString:@"slider"]) {
+        NSSliderTouchBarItem *slider = [[[NSSliderTouchBarItem alloc] initWithIdentifier:identifier] autorelease];
+        slider.label = label;
+        slider.slider.minValue = [item[@"min"] doubleValue];
+        slider.slider.maxValue = [item[@"max"] doubleValue];
+        slider.slider.doubleValue = [item[@"value"] doubleValue];
+        slider.slider.tag = index;
+        slider.target = self;
+        slider.action = @selector(sliderChanged:);
+        return slider;
+    }
+
+    NSCustomTouchBarItem *custom = [[[NSCustomTouchBarItem alloc] initWithIdentifier:identifier] autorelease];
+    if ([type isEqualToString:@"scrubber"]) {
+        NSScrubber *scrubber = [[[NSScrubber alloc] initWithFrame:NSMakeRect(0, 0, 320, 30)] autorelease];
+        scrubber.tag = index;
+        scrubber.dataSource = self;
+        scrubber.delegate = self;
+        scrubber.mode = NSScrubberModeFree;
+        scrubber.selectionBackgroundStyle = [NSScrubberSelectionStyle roundedBackgroundStyle];
+        scrubber.scrubberLayout = [[NSScrubberFlowLayout new] autorelease];
+        [scrubber registerClass:[NSScrubberTextItemView class] forItemIdentifier:@"text"];
+        [scrubber reloadData];
+        NSNumber *selected = item[@"selected"];
+        scrubber.selectedIndex = [selected isKindOfClass:[NSNumber class]] ? selected.integerValue : -1;
+        custom.view = scrubber;
+        return custom;
+    }
+
+    NSButton *button = [NSButton buttonWithTitle:label target:self action:@selector(buttonClicked:)];
+    button.tag = index;
+    custom.view = button;
+    return custom;
+}
+
+- (void) buttonClicked:(NSButton*)button {
+    [self send:button.tag :@""];
+}
+
+- (void) sliderChanged:(NSSliderTouchBarItem*)slider {
+    [self send:slider.slider.tag :[NSString stringWithFormat:@"%f", slider.slider.doubleValue]];
+}
+
+- (NSInteger) numberOfItemsForScrubber:(NSScrubber *)scrubber {
+    return [self optionsForTag:scrubber.tag].count;
+}
+
+- (NSScrubberItemView *) scrubber:(NSScrubber *)scrubber viewForItemAtIndex:(NSInteger)index {
+    NSScrubberTextItemView *view = [scrubber makeItemWithIdentifier:@"text" owner:nil];
+    NSArray *options = [self optionsForTag:scrubber.tag];
+    if (index < (NSInteger)options.count && [options[index] isKindOfClass:[NSString class]]) {
+        view.textField.stringValue = options[index];
+    }
+    return view;
+}
+
+- (void) scrubber:(NSScrubber *)scrubber didSelectItemAtIndex:(NSInteger)selectedIndex {
+    [self send:scrubber.tag :[NSString stringWithFormat:@"%ld", (long)selectedIndex]];
+}
+
+- (void) dealloc {
+    [_items release];
+    [super dealloc];
+}
+
+@end
+
+static WailsTouchBar *activeTouchBar = nil;
+
+// SetTouchBar replaces the Touch Bar of the window with the items given as JSON. An empty list restores the default
+// Touch Bar of the webview.
+void SetTouchBar(void *inctx, const char *items, int generation) {
+    WailsContext *ctx = (__bridge WailsContext*) inctx;
+    NSData *data = [NSData dataWithBytes:items length:strlen(items)];
+    NSArray *parsed = [NSJSONSerialization JSONObjectWithData:data options:0 error:nil];
+    [parsed retain];
+    ON_MAIN_THREAD(
+        NSTouchBar *touchBar = nil;
+        if (parsed.count > 0) {
+            if (activeTouchBar == nil) {
+                activeTouchBar = [WailsTouchBar new];
+            }
+            activeTouchBar.items = parsed;
+            activeTouchBar.generation = generation;
+            touchBar = [activeTouchBar makeTouchBar];
+        }
+        [parsed release];
+        // The webview is the first responder and provides its own Touch Bar, which would replace the one of the window
+        ctx.webview.touchBar = touchBar;
+        ctx.mainWindow.touchBar = touchBar;
+    );
+}
@@ -9,6 +9,7 @@
 @interface WailsWebView : WKWebView
 @property bool disableWebViewDragAndDrop;
 @property bool enableDragAndDrop;
+@property bool disableCopy;
 @end
 
 #endif /* WailsWebView_h */
@@ -5,6 +5,30 @@
 @implementation WailsWebView
 @synthesize disableWebViewDragAndDrop;
 @synthesize enableDragAndDrop;
+@synthesize disableCopy;
+
+- (void)copy:(id)sender
+{
+  if ( !disableCopy ) {
+    [super copy:sender];
+  }
+}
+
+- (void)cut:(id)sender
+{
+  if ( !disableCopy ) {
+    [super cut:sender];
+  }
+}
+
+// Disables the Copy and Cut items of the Edit menu and the context menu
+- (BOOL)validateUserInterfaceItem:(id<NSValidatedUserInterfaceItem>)item
+{
+  if ( disableCopy && ([item action] == @selector(copy:) || [item action] == @selector(cut:)) ) {
+    return NO;
+  }
+  return [super validateUserInterfaceItem:item];
+}
 
 - (BOOL)prepareForDragOperation:(id<NSDraggingInfo>)sender
 {
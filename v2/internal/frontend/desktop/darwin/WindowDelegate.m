@@ -24,6 +24,11 @@
 
 - (void)windowDidExitFullScreen:(NSNotification *)notification {
     [self.ctx.mainWindow applyWindowConstraints];
+    if( self.ctx.pendingBorderlessScreen != nil ) {
+        int screenIndex = [self.ctx.pendingBorderlessScreen intValue];
+        self.ctx.pendingBorderlessScreen = nil;
+        [self.ctx BorderlessFullscreen:screenIndex];
+    }
 }
 
 - (void)windowWillEnterFullScreen:(NSNotification *)notification {
@@ -0,0 +1,38 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework AppKit
+#import <AppKit/AppKit.h>
+
+bool PrefersReducedMotion() {
+	return [[NSWorkspace sharedWorkspace] accessibilityDisplayShouldReduceMotion];
+}
+
+bool HighContrastActive() {
+	return [[NSWorkspace sharedWorkspace] accessibilityDisplayShouldIncreaseContrast];
+}
+*/
+import "C"
+
+import "github.com/wailsapp/wails/v2/internal/frontend"
+
+// SystemPrefersReducedMotion returns true if "Reduce motion" is turned on in the display accessibility settings
+func (f *Frontend) SystemPrefersReducedMotion() bool {
+	return bool(C.PrefersReducedMotion())
+}
+
+// SystemHighContrastActive returns true if "Increase contrast" is turned on in the display accessibility settings
+func (f *Frontend) SystemHighContrastActive() bool {
+	return bool(C.HighContrastActive())
+}
+
+func (f *Frontend) notifyAccessibilityChanged() {
+	frontend.AccessibilityChanged(f.ctx, frontend.AccessibilitySettings{
+		ReducedMotion: f.SystemPrefersReducedMotion(),
+		HighContrast:  f.SystemHighContrastActive(),
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"errors"
 	"strconv"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 )
 
@@ -44,6 +45,9 @@ func (f *Frontend) handleCallback(menuItemID uint) error {
 			C.UpdateMenuItem(menuItem.nsmenuitem, C.int(1))
 		}
 	}
+	if wailsMenuItem.Type == menu.CheckboxType || wailsMenuItem.Type == menu.RadioType {
+		frontend.MenuChanged(f.ctx, f.mainWindow.applicationMenu)
+	}
 	if wailsMenuItem.Click != nil {
 		go wailsMenuItem.Click(&menu.CallbackData{MenuItem: wailsMenuItem})
 	}
@@ -0,0 +1,86 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Cocoa -framework WebKit
+#import "WailsContext.h"
+#include <stdlib.h>
+#include <string.h>
+
+static bool IsMainThread() {
+	return [NSThread isMainThread];
+}
+
+// CaptureSnapshot takes a snapshot of the visible content of the webview, encoded as PNG or as JPEG. It waits for the
+// main thread, it must not be called on the main thread. The caller must free the result.
+void* CaptureSnapshot(void *inctx, bool jpeg, int *length, char **error) {
+	WailsContext *ctx = (__bridge WailsContext*) inctx;
+	__block NSData *result = nil;
+	__block NSString *message = nil;
+	dispatch_semaphore_t done = dispatch_semaphore_create(0);
+	dispatch_async(dispatch_get_main_queue(), ^{
+		[ctx.webview takeSnapshotWithConfiguration:nil completionHandler:^(NSImage *image, NSError *snapshotError) {
+			if (image == nil) {
+				message = [[snapshotError localizedDescription] retain];
+			} else {
+				CGImageRef cgImage = [image CGImageForProposedRect:NULL context:nil hints:nil];
+				NSBitmapImageRep *bitmap = [[[NSBitmapImageRep alloc] initWithCGImage:cgImage] autorelease];
+				result = [[bitmap representationUsingType:(jpeg ? NSBitmapImageFileTypeJPEG : NSBitmapImageFileTypePNG) properties:(jpeg ? @{NSImageCompressionFactor: @0.9} : @{})] retain];
+			}
+			dispatch_semaphore_signal(done);
+		}];
+	});
+	dispatch_semaphore_wait(done, DISPATCH_TIME_FOREVER);
+	dispatch_release(done);
+
+	if (result == nil) {
+		*error = strdup(message != nil ? [message UTF8String] : "unable to capture the webview");
+		[message release];
+		return NULL;
+	}
+	*length = (int)[result length];
+	void *bytes = malloc([result length]);
+	memcpy(bytes, [result bytes], [result length]);
+	[result release];
+	return bytes;
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// errWaitOnMainThread is returned by the calls which wait for a completion handler of the webview, which is called on
+// the main thread, when they are made on the main thread
+var errWaitOnMainThread = errors.New("this call waits for the webview and can't be made on the main thread")
+
+// WebviewCapturePreview captures the visible content of the webview at the scale of the display
+func (f *Frontend) WebviewCapturePreview(format frontend.ImageFormat) ([]byte, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	if C.IsMainThread() {
+		return nil, errWaitOnMainThread
+	}
+	var length C.int
+	var message *C.char
+	data := C.CaptureSnapshot(f.mainWindow.context, C.bool(format == frontend.ImageJPEG), &length, &message)
+	if data == nil {
+		defer C.free(unsafe.Pointer(message))
+		return nil, errors.New(C.GoString(message))
+	}
+	defer C.free(data)
+	return C.GoBytes(data, length), nil
+}
+
+// WindowCapture is not supported on macOS, capturing a window requires the screen recording permission
+func (f *Frontend) WindowCapture(format frontend.ImageFormat) ([]byte, error) {
+	return nil, errors.New("capturing the window is not supported on macOS")
+}
@@ -0,0 +1,83 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework AppKit
+#import <AppKit/AppKit.h>
+#include <stdlib.h>
+
+static NSCursor *overrideCursor = nil;
+
+static void setOverrideCursor(NSCursor *cursor) {
+	[overrideCursor release];
+	overrideCursor = [cursor retain];
+	[cursor ?: [NSCursor arrowCursor] set];
+}
+
+static NSCursor* cursorForName(NSString *name) {
+	NSDictionary<NSString*, NSCursor*> *cursors = @{
+		@"default": [NSCursor arrowCursor],
+		@"pointer": [NSCursor pointingHandCursor],
+		@"text": [NSCursor IBeamCursor],
+		@"crosshair": [NSCursor crosshairCursor],
+		@"move": [NSCursor openHandCursor],
+		@"not-allowed": [NSCursor operationNotAllowedCursor],
+		@"grab": [NSCursor openHandCursor],
+		@"grabbing": [NSCursor closedHandCursor],
+		@"ew-resize": [NSCursor resizeLeftRightCursor],
+		@"ns-resize": [NSCursor resizeUpDownCursor],
+	};
+	return cursors[name] ?: [NSCursor arrowCursor];
+}
+
+// SetCursor overrides the cursor with the named cursor, NULL removes the override
+void SetCursor(const char *name) {
+	NSString *cursorName = name == NULL ? nil : [[NSString alloc] initWithUTF8String:name];
+	dispatch_async(dispatch_get_main_queue(), ^{
+		setOverrideCursor(cursorName == nil ? nil : cursorForName(cursorName));
+		[cursorName release];
+	});
+}
+
+void SetCursorImage(const void *data, int length, int hotspotX, int hotspotY) {
+	NSData *imageData = [[NSData alloc] initWithBytes:data length:length];
+	dispatch_async(dispatch_get_main_queue(), ^{
+		NSImage *image = [[[NSImage alloc] initWithData:imageData] autorelease];
+		[imageData release];
+		if (image == nil) {
+			return;
+		}
+		NSCursor *cursor = [[[NSCursor alloc] initWithImage:image hotSpot:NSMakePoint(hotspotX, hotspotY)] autorelease];
+		setOverrideCursor(cursor);
+	});
+}
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// WindowSetCursor overrides the cursor. AppKit has no public cursors for wait, progress, help and the diagonal resize
+// cursors, the arrow is used instead. The cursor of the page is overridden with CSS, as WKWebView resets the cursor
+// when the mouse moves.
+func (f *Frontend) WindowSetCursor(cursor string) {
+	if cursor == "" {
+		C.SetCursor(nil)
+	} else {
+		name := C.CString(cursor)
+		defer C.free(unsafe.Pointer(name))
+		C.SetCursor(name)
+	}
+	f.ExecJS(frontend.CursorScript(cursor))
+}
+
+func (f *Frontend) WindowSetCursorImage(image []byte, hotspotX int, hotspotY int) {
+	C.SetCursorImage(unsafe.Pointer(&image[0]), C.int(len(image)), C.int(hotspotX), C.int(hotspotY))
+	f.ExecJS(frontend.CursorScript(frontend.CursorImageCSS(image, hotspotX, hotspotY)))
+}
@@ -0,0 +1,78 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework AppKit
+#import <AppKit/AppKit.h>
+#include <stdlib.h>
+
+// DateTimePickerDialog runs an alert with an NSDatePicker and stores the selected date, in seconds since 1970, in value
+bool DateTimePickerDialog(const char *title, bool showDate, bool showTime, double *value, bool hasMin, double min, bool hasMax, double max) {
+	__block bool ok = false;
+	NSString *titleString = [[NSString alloc] initWithUTF8String:title];
+	dispatch_sync(dispatch_get_main_queue(), ^{
+		NSDatePicker *picker = [[[NSDatePicker alloc] initWithFrame:NSZeroRect] autorelease];
+		[picker setDatePickerStyle:NSDatePickerStyleTextFieldAndStepper];
+		NSDatePickerElementFlags elements = 0;
+		if (showDate) {
+			elements |= NSDatePickerElementFlagYearMonthDay;
+		}
+		if (showTime) {
+			elements |= NSDatePickerElementFlagHourMinute;
+		}
+		[picker setDatePickerElements:elements];
+		if (hasMin) {
+			[picker setMinDate:[NSDate dateWithTimeIntervalSince1970:min]];
+		}
+		if (hasMax) {
+			[picker setMaxDate:[NSDate dateWithTimeIntervalSince1970:max]];
+		}
+		[picker setDateValue:[NSDate dateWithTimeIntervalSince1970:*value]];
+		[picker sizeToFit];
+
+		NSAlert *alert = [[[NSAlert alloc] init] autorelease];
+		[alert setMessageText:titleString];
+		[alert setAccessoryView:picker];
+		[alert addButtonWithTitle:@"OK"];
+		[alert addButtonWithTitle:@"Cancel"];
+		[[alert window] setInitialFirstResponder:picker];
+		if ([alert runModal] == NSAlertFirstButtonReturn) {
+			*value = [[picker dateValue] timeIntervalSince1970];
+			ok = true;
+		}
+	});
+	[titleString release];
+	return ok;
+}
+*/
+import "C"
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// DateTimePickerDialog shows an alert with an NSDatePicker for the date and the time
+func (f *Frontend) DateTimePickerDialog(options frontend.DateTimePickerOptions) (time.Time, bool) {
+	dialogLock.Lock()
+	defer dialogLock.Unlock()
+
+	title := C.CString(options.Title)
+	defer C.free(unsafe.Pointer(title))
+
+	value := C.double(float64(options.Default.UnixMilli()) / 1000)
+	ok := C.DateTimePickerDialog(title,
+		C.bool(options.Mode != frontend.DateTimePickerTime), C.bool(options.Mode != frontend.DateTimePickerDate),
+		&value,
+		C.bool(!options.Min.IsZero()), C.double(float64(options.Min.UnixMilli())/1000),
+		C.bool(!options.Max.IsZero()), C.double(float64(options.Max.UnixMilli())/1000))
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(float64(value) * 1000)).Truncate(time.Minute), true
+}
@@ -18,12 +18,15 @@ import "C"
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
 	"log"
 	"net"
 	"net/url"
 	"os"
+	"strings"
+	"time"
 	"unsafe"
 
 	"github.com/wailsapp/wails/v2/pkg/assetserver"
@@ -33,7 +36,9 @@ import (
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
 	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
 
 const startURL = "wails://wails/"
@@ -45,6 +50,12 @@ var (
 	openFilepathBuffer   = make(chan string, 100)
 	openUrlBuffer        = make(chan string, 100)
 	secondInstanceBuffer = make(chan options.SecondInstanceData, 1)
+	sessionChangeBuffer  = make(chan frontend.SessionChange, 10)
+	localeChangedBuffer  = make(chan struct{}, 1)
+	accessibilityBuffer  = make(chan struct{}, 1)
+	navigationBuffer     = make(chan float64, 100)
+	fullscreenBuffer     = make(chan bool, 10)
+	serviceBuffer        = make(chan string, 10)
 )
 
 type Frontend struct {
@@ -67,6 +78,11 @@ type Frontend struct {
 	mainWindow *Window
 	bindings   *binding.Bindings
 	dispatcher frontend.Dispatcher
+
+	imeComposition frontend.IMECompositionState
+
+	// launch buffers the URLs and the files to open for the frontend
+	launch *frontend.LaunchBuffer
 }
 
 func (f *Frontend) RunMainLoop() {
@@ -84,6 +100,7 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		bindings:        appBindings,
 		dispatcher:      dispatcher,
 		ctx:             ctx,
+		launch:          frontend.NewLaunchBuffer(frontend.ParseLaunchArgs(os.Args[1:], "")),
 	}
 	result.startURL, _ = url.Parse(startURL)
 
@@ -113,16 +130,24 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 			log.Fatal(err)
 		}
 		assets.ExpectedWebViewHost = result.startURL.Host
+		assets.UseLaunchScript(result.launch.Script)
 		result.assets = assets
-
-		go result.startRequestProcessor()
 	}
 
+	// The requests of the custom schemes are also received in the development mode
+	go result.startRequestProcessor()
+
 	go result.startMessageProcessor()
 	go result.startCallbackProcessor()
 	go result.startFileOpenProcessor()
 	go result.startUrlOpenProcessor()
 	go result.startSecondInstanceProcessor()
+	go result.startSessionChangeProcessor()
+	go result.startLocaleChangedProcessor()
+	go result.startAccessibilityChangedProcessor()
+	go result.startNavigationProgressProcessor()
+	go result.startFullscreenProcessor()
+	go result.startServiceProcessor()
 
 	return result
 }
@@ -141,6 +166,7 @@ func (f *Frontend) startUrlOpenProcessor() {
 
 func (f *Frontend) startSecondInstanceProcessor() {
 	for secondInstanceData := range secondInstanceBuffer {
+		f.launch.Launched(f.ctx, frontend.ParseLaunchArgs(secondInstanceData.Args, secondInstanceData.WorkingDirectory), f.ExecJS)
 		if f.frontendOptions.SingleInstanceLock != nil &&
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch != nil {
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch(secondInstanceData)
@@ -148,6 +174,47 @@ func (f *Frontend) startSecondInstanceProcessor() {
 	}
 }
 
+// startSessionChangeProcessor emits the session changes. They are received from the native code rather than as
+// messages, which could be sent by the scripts of the page.
+func (f *Frontend) startSessionChangeProcessor() {
+	for change := range sessionChangeBuffer {
+		frontend.SessionChanged(f.ctx, change)
+	}
+}
+
+func (f *Frontend) startLocaleChangedProcessor() {
+	for range localeChangedBuffer {
+		f.notifyLocaleChanged()
+	}
+}
+
+func (f *Frontend) startAccessibilityChangedProcessor() {
+	for range accessibilityBuffer {
+		f.notifyAccessibilityChanged()
+	}
+}
+
+// startNavigationProgressProcessor emits the progress of the loads, which is received from the native code
+func (f *Frontend) startNavigationProgressProcessor() {
+	for progress := range navigationBuffer {
+		frontend.NavigationProgressChanged(f.ctx, progress)
+	}
+}
+
+// startFullscreenProcessor emits the fullscreen changes of the elements of the page, which are received from the
+// native code
+func (f *Frontend) startFullscreenProcessor() {
+	for fullscreen := range fullscreenBuffer {
+		frontend.FullscreenChanged(f.ctx, fullscreen)
+	}
+}
+
+func (f *Frontend) startServiceProcessor() {
+	for invocation := range serviceBuffer {
+		f.processServiceInvocation(invocation)
+	}
+}
+
 func (f *Frontend) startMessageProcessor() {
 	for message := range messageBuffer {
 		f.processMessage(message)
@@ -156,7 +223,14 @@ func (f *Frontend) startMessageProcessor() {
 
 func (f *Frontend) startRequestProcessor() {
 	for request := range requestBuffer {
-		f.assets.ServeWebViewRequest(request)
+		uri, _ := request.URL()
+		if handler, ok := assetserver.CustomSchemeHandler(f.frontendOptions.CustomSchemes, uri); ok {
+			assetserver.ServeCustomScheme(request, handler, f.logger.Error)
+		} else if f.assets != nil {
+			f.assets.ServeWebViewRequest(request)
+		} else {
+			_ = request.Close()
+		}
 	}
 }
 
@@ -207,6 +281,12 @@ func (f *Frontend) Run(ctx context.Context) error {
 	f.mainWindow = mainWindow
 	f.mainWindow.Center()
 
+	go func() {
+		if err := frontend.WatchNetworkStatus(f.ctx); err != nil {
+			f.logger.Debug("Unable to watch the network status: %s", err.Error())
+		}
+	}()
+
 	go func() {
 		if f.frontendOptions.OnStartup != nil {
 			f.frontendOptions.OnStartup(f.ctx)
@@ -216,16 +296,62 @@ func (f *Frontend) Run(ctx context.Context) error {
 	return nil
 }
 
-func (f *Frontend) WindowCenter() {
+func (f *Frontend) WindowCenter() error {
 	f.mainWindow.Center()
+	return nil
 }
 
-func (f *Frontend) WindowSetAlwaysOnTop(onTop bool) {
+func (f *Frontend) WindowSetLevel(level options.WindowLevel) error {
+	f.mainWindow.SetLevel(int(level))
+	return nil
+}
+
+func (f *Frontend) WindowSetAlwaysOnTop(onTop bool) error {
 	f.mainWindow.SetAlwaysOnTop(onTop)
+	return nil
+}
+
+func (f *Frontend) WindowSetOpacity(opacity float64) float64 {
+	return f.mainWindow.SetOpacity(min(max(opacity, 0), 1))
+}
+
+func (f *Frontend) WindowOpenDevTools() error {
+	return errors.New("the dev tools can't be opened programmatically on macOS, use the context menu of the webview")
 }
 
-func (f *Frontend) WindowSetPosition(x, y int) {
+func (f *Frontend) WindowCloseDevTools() error {
+	return errors.New("the dev tools can't be closed programmatically on macOS")
+}
+
+func (f *Frontend) WindowSetUserAgent(userAgent string) {
+	f.mainWindow.SetUserAgent(userAgent)
+}
+
+func (f *Frontend) WebviewAddPreloadScript(script string) (string, error) {
+	return f.mainWindow.AddPreloadScript(script), nil
+}
+
+func (f *Frontend) WebviewRemovePreloadScript(id string) error {
+	f.mainWindow.RemovePreloadScript(id)
+	return nil
+}
+
+// WindowSetSkipTaskbar is not supported on macOS, the Dock shows the application rather than its windows
+func (f *Frontend) WindowSetSkipTaskbar(skip bool) {}
+
+// WindowSetAlwaysOnBottom puts the window on a level below the normal windows
+func (f *Frontend) WindowSetAlwaysOnBottom(onBottom bool) error {
+	level := options.WindowLevelNormal
+	if onBottom {
+		level--
+	}
+	f.mainWindow.SetLevel(int(level))
+	return nil
+}
+
+func (f *Frontend) WindowSetPosition(x, y int) error {
 	f.mainWindow.SetPosition(x, y)
+	return nil
 }
 
 func (f *Frontend) WindowGetPosition() (int, int) {
@@ -236,6 +362,18 @@ func (f *Frontend) WindowSetSize(width, height int) {
 	f.mainWindow.SetSize(width, height)
 }
 
+// WindowSetSizeToContent measures the content in a goroutine, as the script is evaluated on the main thread
+func (f *Frontend) WindowSetSizeToContent() {
+	go func() {
+		width, height, err := frontend.MeasureContent(f.evalScript)
+		if err != nil {
+			f.logger.Error("WindowSetSizeToContent: %s", err)
+			return
+		}
+		f.mainWindow.SizeToContent(width, height)
+	}()
+}
+
 func (f *Frontend) WindowGetSize() (int, int) {
 	return f.mainWindow.Size()
 }
@@ -252,6 +390,18 @@ func (f *Frontend) WindowUnfullscreen() {
 	f.mainWindow.UnFullscreen()
 }
 
+func (f *Frontend) WindowSetBorderlessFullscreen(screen int) error {
+	screens, err := f.ScreenGetAll()
+	if err != nil {
+		return err
+	}
+	if screen < 0 || screen >= len(screens) {
+		return fmt.Errorf("unknown screen: %d", screen)
+	}
+	f.mainWindow.BorderlessFullscreen(screen)
+	return nil
+}
+
 func (f *Frontend) WindowShow() {
 	f.mainWindow.Show()
 }
@@ -260,6 +410,10 @@ func (f *Frontend) WindowHide() {
 	f.mainWindow.Hide()
 }
 
+func (f *Frontend) WindowFocus() {
+	f.mainWindow.Focus()
+}
+
 func (f *Frontend) Show() {
 	f.mainWindow.ShowApplication()
 }
@@ -307,6 +461,16 @@ func (f *Frontend) ScreenGetAll() ([]frontend.Screen, error) {
 	return GetAllScreens(f.mainWindow.context)
 }
 
+func (f *Frontend) GetDisplayServer() string {
+	return ""
+}
+
+// IsRemoteSession always returns false, Screen Sharing shares the session of the console rather than creating a
+// remote session
+func (f *Frontend) IsRemoteSession() bool {
+	return false
+}
+
 func (f *Frontend) WindowIsMaximised() bool {
 	return f.mainWindow.IsMaximised()
 }
@@ -339,6 +503,137 @@ func (f *Frontend) WindowPrint() {
 	f.mainWindow.Print()
 }
 
+// FindInPage selects the next match of the text in the page. The find API of WKWebView doesn't count the matches, so
+// the page is searched with window.find
+// FindInPage searches the page in a goroutine, as the script is evaluated on the main thread
+func (f *Frontend) FindInPage(text string, options frontend.FindOptions) {
+	go func() {
+		if err := frontend.FindWithScript(f.ctx, f.evalScript, text, options); err != nil {
+			f.logger.Error("FindInPage: %s", err)
+		}
+	}()
+}
+
+func (f *Frontend) StopFind() {
+	f.ExecJS(frontend.StopFindScript)
+}
+
+func (f *Frontend) WebviewClearCache(kinds frontend.CacheKind, start, end time.Time) error {
+	return errors.New("clearing the browsing data is not supported on macOS")
+}
+
+func (f *Frontend) WebviewPrintToPDF(path string, settings frontend.PrintSettings) error {
+	return errors.New("printing to PDF is not supported on macOS")
+}
+
+func (f *Frontend) WebviewPrintToPDFData(settings frontend.PrintSettings) ([]byte, error) {
+	return nil, errors.New("printing to PDF is not supported on macOS")
+}
+
+// IMEIsComposing returns true while an input method composition is in progress in the page
+func (f *Frontend) IMEIsComposing() bool {
+	return f.imeComposition.IsComposing()
+}
+
+func (f *Frontend) WindowSetBlurRegion(rects []frontend.Rect) {
+}
+
+func (f *Frontend) WindowSetBackdropType(backdrop windows.BackdropType) error {
+	return errors.New("backdrop types are only supported on Windows")
+}
+
+// WindowSetCustomTheme is not supported on macOS
+func (f *Frontend) WindowSetCustomTheme(theme *windows.ThemeSettings) {}
+
+// WindowGetState returns the position, the size and the maximised state of the window, the screen isn't reported on
+// macOS
+func (f *Frontend) WindowGetState() frontend.WindowState {
+	x, y := f.WindowGetPosition()
+	width, height := f.WindowGetSize()
+	return frontend.WindowState{X: x, Y: y, Width: width, Height: height, Maximised: f.WindowIsMaximised()}
+}
+
+// WindowSetState restores the position, the size and the maximised state of the window, the position isn't checked
+// against the screens on macOS
+func (f *Frontend) WindowSetState(state frontend.WindowState) {
+	if state.Width > 0 && state.Height > 0 {
+		f.WindowUnmaximise()
+		f.WindowSetSize(state.Width, state.Height)
+		_ = f.WindowSetPosition(state.X, state.Y)
+	}
+	if state.Maximised {
+		f.WindowMaximise()
+	}
+}
+
+// WindowSetAnimationsEnabled is not supported on macOS
+func (f *Frontend) WindowSetAnimationsEnabled(enabled bool) {}
+
+// WindowSetMinimiseButtonEnabled is not supported on macOS
+func (f *Frontend) WindowSetMinimiseButtonEnabled(enabled bool) {}
+
+// WindowSetMaximiseButtonEnabled is not supported on macOS
+func (f *Frontend) WindowSetMaximiseButtonEnabled(enabled bool) {}
+
+// WindowSetCloseButtonEnabled is not supported on macOS
+func (f *Frontend) WindowSetCloseButtonEnabled(enabled bool) {}
+
+// WindowSetIgnoreMouseEvents is not supported on macOS
+func (f *Frontend) WindowSetIgnoreMouseEvents(ignore bool, forward bool) {}
+
+// WindowSetContentProtection is not supported on macOS
+func (f *Frontend) WindowSetContentProtection(enabled bool) error {
+	return errors.New("content protection is only supported on Windows")
+}
+
+// WindowFlash is not supported on macOS
+func (f *Frontend) WindowFlash(untilFocused bool) {}
+
+// WindowSetTaskbarProgress is not supported on macOS
+func (f *Frontend) WindowSetTaskbarProgress(state frontend.ProgressState, completed, total uint64) {}
+
+// RegisterGlobalHotkey is not supported on macOS
+func (f *Frontend) RegisterGlobalHotkey(accelerator *keys.Accelerator, handler func()) (int, error) {
+	return 0, errors.New("global hotkeys are only supported on Windows")
+}
+
+// UnregisterGlobalHotkey is not supported on macOS
+func (f *Frontend) UnregisterGlobalHotkey(id int) {}
+
+// WindowSetOverlayIcon is not supported on macOS
+func (f *Frontend) WindowSetOverlayIcon(image []byte, description string) error {
+	return errors.New("overlay icons are not supported on macOS")
+}
+
+// WindowClearOverlayIcon is not supported on macOS
+func (f *Frontend) WindowClearOverlayIcon() {}
+
+// WindowSetThumbbarButtons is not supported on macOS
+func (f *Frontend) WindowSetThumbbarButtons(buttons []frontend.ThumbbarButton) error {
+	return errors.New("thumbnail toolbar buttons are not supported on macOS")
+}
+
+// WindowSetZoomForOrigin is not supported on macOS
+func (f *Frontend) WindowSetZoomForOrigin(origin string, zoom float64) {}
+
+func (f *Frontend) WindowSetZoomFactor(factor float64) error {
+	return errors.New("the zoom factor can only be changed on Windows")
+}
+
+// WindowGetZoomFactor is not supported on macOS, the page is never zoomed by the runtime
+func (f *Frontend) WindowGetZoomFactor() float64 {
+	return 1.0
+}
+
+func (f *Frontend) WindowGetDPI() (uint, error) {
+	return 0, errors.New("the DPI of the window is only available on Windows")
+}
+
+func (f *Frontend) WindowStartConstrainedDrag(constraints frontend.DragConstraints) {
+	// Drag constraints are not supported yet, perform a normal drag
+	f.mainWindow.StartDrag()
+}
+
 type EventNotify struct {
 	Name string        `json:"name"`
 	Data []interface{} `json:"data"`
@@ -368,6 +663,10 @@ func (f *Frontend) processMessage(message string) {
 	if message == "runtime:ready" {
 		cmd := fmt.Sprintf("window.wails.setCSSDragProperties('%s', '%s');", f.frontendOptions.CSSDragProperty, f.frontendOptions.CSSDragValue)
 		f.ExecJS(cmd)
+		f.ExecJS(frontend.IMECompositionScript)
+		if !f.frontendOptions.DisableDoubleClickMaximise {
+			f.ExecJS(frontend.DragRegionDoubleClickScript)
+		}
 
 		if f.frontendOptions.DragAndDrop != nil && f.frontendOptions.DragAndDrop.EnableFileDrop {
 			f.ExecJS("window.wails.flags.enableWailsDragAndDrop = true;")
@@ -381,6 +680,16 @@ func (f *Frontend) processMessage(message string) {
 		return
 	}
 
+	if strings.HasPrefix(message, frontend.IMECompositionMessage) {
+		composition, err := frontend.ParseIMECompositionMessage(message)
+		if err != nil {
+			f.logger.Error(err.Error())
+			return
+		}
+		f.imeComposition.Update(f.ctx, composition)
+		return
+	}
+
 	//if strings.HasPrefix(message, "systemevent:") {
 	//	f.processSystemEvent(message)
 	//	return
@@ -408,12 +717,14 @@ func (f *Frontend) processMessage(message string) {
 }
 
 func (f *Frontend) ProcessOpenFileEvent(filePath string) {
+	f.launch.Launched(f.ctx, frontend.LaunchData{Files: []string{filePath}}, f.runningExecJS())
 	if f.frontendOptions.Mac != nil && f.frontendOptions.Mac.OnFileOpen != nil {
 		f.frontendOptions.Mac.OnFileOpen(filePath)
 	}
 }
 
 func (f *Frontend) ProcessOpenUrlEvent(url string) {
+	f.launch.Launched(f.ctx, frontend.LaunchData{URLs: []string{url}}, f.runningExecJS())
 	if f.frontendOptions.Mac != nil && f.frontendOptions.Mac.OnUrlOpen != nil {
 		f.frontendOptions.Mac.OnUrlOpen(url)
 	}
@@ -431,6 +742,15 @@ func (f *Frontend) ExecJS(js string) {
 	f.mainWindow.ExecJS(js)
 }
 
+// runningExecJS returns ExecJS if the window has been created, or nil. The files and the URLs the application is
+// launched with are opened before, they are buffered until the page is loaded.
+func (f *Frontend) runningExecJS() func(string) {
+	if f.mainWindow == nil {
+		return nil
+	}
+	return f.ExecJS
+}
+
 //func (f *Frontend) processSystemEvent(message string) {
 //	sl := strings.Split(message, ":")
 //	if len(sl) != 2 {
@@ -453,6 +773,43 @@ func processMessage(message *C.char) {
 	messageBuffer <- goMessage
 }
 
+//export processSessionChange
+func processSessionChange(change *C.char) {
+	sessionChangeBuffer <- frontend.SessionChange(C.GoString(change))
+}
+
+//export processLocaleChanged
+func processLocaleChanged() {
+	// The changes which are received while one is being emitted are coalesced
+	select {
+	case localeChangedBuffer <- struct{}{}:
+	default:
+	}
+}
+
+//export processAccessibilityChanged
+func processAccessibilityChanged() {
+	select {
+	case accessibilityBuffer <- struct{}{}:
+	default:
+	}
+}
+
+//export processNavigationProgress
+func processNavigationProgress(progress C.double) {
+	navigationBuffer <- float64(progress)
+}
+
+//export processFullscreenChange
+func processFullscreenChange(fullscreen C.int) {
+	fullscreenBuffer <- fullscreen != 0
+}
+
+//export processServiceInvocation
+func processServiceInvocation(invocation *C.char) {
+	serviceBuffer <- C.GoString(invocation)
+}
+
 //export processCallback
 func processCallback(callbackID uint) {
 	callbackBuffer <- callbackID
@@ -0,0 +1,39 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework AppKit
+#import <AppKit/AppKit.h>
+
+void PerformHaptic(int pattern) {
+	dispatch_async(dispatch_get_main_queue(), ^{
+		[[NSHapticFeedbackManager defaultPerformer] performFeedbackPattern:(NSHapticFeedbackPattern)pattern
+		                                                   performanceTime:NSHapticFeedbackPerformanceTimeDefault];
+	});
+}
+*/
+import "C"
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+var hapticPatterns = map[string]C.int{
+	frontend.HapticGeneric:     C.NSHapticFeedbackPatternGeneric,
+	frontend.HapticAlignment:   C.NSHapticFeedbackPatternAlignment,
+	frontend.HapticLevelChange: C.NSHapticFeedbackPatternLevelChange,
+}
+
+// PerformHaptic performs the haptic feedback pattern on the Force Touch trackpad. The feedback is only felt if the
+// user is touching the trackpad.
+func (f *Frontend) PerformHaptic(pattern string) {
+	hapticPattern, ok := hapticPatterns[pattern]
+	if !ok {
+		f.logger.Warning("Unknown haptic pattern '%s'", pattern)
+		return
+	}
+	C.PerformHaptic(hapticPattern)
+}
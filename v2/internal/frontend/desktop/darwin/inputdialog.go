@@ -0,0 +1,73 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework AppKit
+#import <AppKit/AppKit.h>
+#include <stdlib.h>
+#include <string.h>
+
+// InputDialog runs an alert with a text field and returns its value, which must be freed, or NULL if it is cancelled
+char *InputDialog(const char *title, const char *message, const char *defaultValue, const char *placeholder, bool password) {
+	__block char *result = NULL;
+	NSString *titleString = [[NSString alloc] initWithUTF8String:title];
+	NSString *messageString = [[NSString alloc] initWithUTF8String:message];
+	NSString *defaultString = [[NSString alloc] initWithUTF8String:defaultValue];
+	NSString *placeholderString = [[NSString alloc] initWithUTF8String:placeholder];
+	dispatch_sync(dispatch_get_main_queue(), ^{
+		NSRect frame = NSMakeRect(0, 0, 300, 24);
+		NSTextField *field = password ? [[[NSSecureTextField alloc] initWithFrame:frame] autorelease]
+		                              : [[[NSTextField alloc] initWithFrame:frame] autorelease];
+		[field setStringValue:defaultString];
+		[field setPlaceholderString:placeholderString];
+
+		NSAlert *alert = [[[NSAlert alloc] init] autorelease];
+		[alert setMessageText:titleString];
+		[alert setInformativeText:messageString];
+		[alert setAccessoryView:field];
+		[alert addButtonWithTitle:@"OK"];
+		[alert addButtonWithTitle:@"Cancel"];
+		[[alert window] setInitialFirstResponder:field];
+		if ([alert runModal] == NSAlertFirstButtonReturn) {
+			result = strdup([[field stringValue] UTF8String]);
+		}
+	});
+	[titleString release];
+	[messageString release];
+	[defaultString release];
+	[placeholderString release];
+	return result;
+}
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// InputDialog shows an alert with a text field, or a secure text field for a password
+func (f *Frontend) InputDialog(options frontend.InputDialogOptions) (string, bool) {
+	dialogLock.Lock()
+	defer dialogLock.Unlock()
+
+	title := C.CString(options.Title)
+	defer C.free(unsafe.Pointer(title))
+	message := C.CString(options.Message)
+	defer C.free(unsafe.Pointer(message))
+	defaultValue := C.CString(options.Default)
+	defer C.free(unsafe.Pointer(defaultValue))
+	placeholder := C.CString(options.Placeholder)
+	defer C.free(unsafe.Pointer(placeholder))
+
+	value := C.InputDialog(title, message, defaultValue, placeholder, C.bool(options.Password))
+	if value == nil {
+		return "", false
+	}
+	defer C.free(unsafe.Pointer(value))
+	return C.GoString(value), true
+}
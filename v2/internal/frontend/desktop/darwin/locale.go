@@ -0,0 +1,79 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Foundation
+#import <Foundation/Foundation.h>
+#include <stdlib.h>
+
+static NSString* dateFormat(NSLocale *locale, NSDateFormatterStyle dateStyle, NSDateFormatterStyle timeStyle) {
+	NSDateFormatter *formatter = [[NSDateFormatter new] autorelease];
+	[formatter setLocale:locale];
+	[formatter setDateStyle:dateStyle];
+	[formatter setTimeStyle:timeStyle];
+	return [formatter dateFormat] ?: @"";
+}
+
+// GetLocaleInfo returns the locale info as JSON, the caller must free the result
+char* GetLocaleInfo() {
+	@autoreleasepool {
+		NSLocale *locale = [NSLocale autoupdatingCurrentLocale];
+		NSString *identifier = [[locale localeIdentifier] stringByReplacingOccurrencesOfString:@"_" withString:@"-"];
+		// NSCalendar starts the week with 1 for Sunday
+		NSInteger firstDayOfWeek = [[NSCalendar autoupdatingCurrentCalendar] firstWeekday] - 1;
+
+		NSDictionary *info = @{
+			@"locale": identifier ?: @"",
+			@"decimalSeparator": [locale decimalSeparator] ?: @"",
+			@"thousandsSeparator": [locale groupingSeparator] ?: @"",
+			@"shortDateFormat": dateFormat(locale, NSDateFormatterShortStyle, NSDateFormatterNoStyle),
+			@"longDateFormat": dateFormat(locale, NSDateFormatterLongStyle, NSDateFormatterNoStyle),
+			@"timeFormat": dateFormat(locale, NSDateFormatterNoStyle, NSDateFormatterMediumStyle),
+			@"firstDayOfWeek": @(firstDayOfWeek),
+			@"currencySymbol": [locale currencySymbol] ?: @"",
+			@"currencyCode": [locale currencyCode] ?: @"",
+		};
+
+		NSData *json = [NSJSONSerialization dataWithJSONObject:info options:0 error:nil];
+		if (json == nil) {
+			return NULL;
+		}
+		NSString *result = [[[NSString alloc] initWithData:json encoding:NSUTF8StringEncoding] autorelease];
+		return strdup([result UTF8String]);
+	}
+}
+*/
+import "C"
+import (
+	"encoding/json"
+	"errors"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+func (f *Frontend) GetLocaleInfo() (frontend.LocaleInfo, error) {
+	var result frontend.LocaleInfo
+	info := C.GetLocaleInfo()
+	if info == nil {
+		return result, errors.New("unable to get locale info")
+	}
+	defer C.free(unsafe.Pointer(info))
+
+	err := json.Unmarshal([]byte(C.GoString(info)), &result)
+	return result, err
+}
+
+func (f *Frontend) notifyLocaleChanged() {
+	info, err := f.GetLocaleInfo()
+	if err != nil {
+		f.logger.Error(err.Error())
+		return
+	}
+	if events, ok := f.ctx.Value("events").(frontend.Events); ok {
+		events.Emit(frontend.LocaleChangeEvent, info)
+	}
+}
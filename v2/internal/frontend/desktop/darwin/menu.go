@@ -132,3 +132,7 @@ func (f *Frontend) MenuSetApplicationMenu(menu *menu.Menu) {
 func (f *Frontend) MenuUpdateApplicationMenu() {
 	f.mainWindow.UpdateApplicationMenu()
 }
+
+func (f *Frontend) MenuGetApplicationMenu() *menu.Menu {
+	return f.mainWindow.applicationMenu
+}
@@ -20,6 +20,13 @@ void processMessageDialogResponse(int);
 void processOpenFileDialogResponse(const char*);
 void processSaveFileDialogResponse(const char*);
 void processCallback(int);
+void processSessionChange(const char *);
+void processLocaleChanged(void);
+void processAccessibilityChanged(void);
+void processNavigationProgress(double);
+void processFullscreenChange(int);
+void processTouchBarEvent(int, long, const char *);
+void processServiceInvocation(const char *);
 
 #ifdef __cplusplus
 }
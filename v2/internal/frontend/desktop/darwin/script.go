@@ -0,0 +1,68 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Cocoa -framework WebKit
+#import "WailsContext.h"
+#include <stdlib.h>
+#include <string.h>
+
+// EvaluateScript evaluates a script which results in a string and returns the string. It waits for the main thread,
+// it must not be called on the main thread. The caller must free the result.
+char* EvaluateScript(void *inctx, const char *script, char **error) {
+	WailsContext *ctx = (__bridge WailsContext*) inctx;
+	NSString *source = [[NSString alloc] initWithUTF8String:script];
+	__block NSString *result = nil;
+	__block NSString *message = nil;
+	dispatch_semaphore_t done = dispatch_semaphore_create(0);
+	dispatch_async(dispatch_get_main_queue(), ^{
+		[ctx.webview evaluateJavaScript:source completionHandler:^(id value, NSError *scriptError) {
+			if (scriptError != nil) {
+				message = [[scriptError localizedDescription] retain];
+			} else if ([value isKindOfClass:[NSString class]]) {
+				result = [value retain];
+			} else {
+				message = [@"the script didn't result in a string" retain];
+			}
+			dispatch_semaphore_signal(done);
+		}];
+	});
+	dispatch_semaphore_wait(done, DISPATCH_TIME_FOREVER);
+	dispatch_release(done);
+	[source release];
+
+	if (result == nil) {
+		*error = strdup([message UTF8String]);
+		[message release];
+		return NULL;
+	}
+	char *value = strdup([result UTF8String]);
+	[result release];
+	return value;
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// evalScript evaluates a script which results in a string in the webview and returns the string. The result is read
+// from the completion handler of the webview, unlike the messages it can't be sent by the scripts of the page. It
+// waits for the main thread, it is called from a goroutine.
+func (f *Frontend) evalScript(script string) (string, error) {
+	source := C.CString(script)
+	defer C.free(unsafe.Pointer(source))
+	var message *C.char
+	value := C.EvaluateScript(f.mainWindow.context, source, &message)
+	if value == nil {
+		defer C.free(unsafe.Pointer(message))
+		return "", errors.New(C.GoString(message))
+	}
+	defer C.free(unsafe.Pointer(value))
+	return C.GoString(value), nil
+}
@@ -0,0 +1,124 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework AppKit
+#import <AppKit/AppKit.h>
+#include <stdlib.h>
+
+#define SelectRowHeight 22
+#define SelectMaxHeight 220
+
+// SelectDialog runs an alert with a popup button of the items, or a check box per item in multiple selection mode.
+// The selected flags are the initial selection and are updated with the selection of the user.
+bool SelectDialog(const char *title, const char *message, const char **items, int *selected, int count, bool multiple) {
+	__block bool result = false;
+	NSString *titleString = [[NSString alloc] initWithUTF8String:title];
+	NSString *messageString = [[NSString alloc] initWithUTF8String:message];
+	NSMutableArray *itemStrings = [[NSMutableArray alloc] initWithCapacity:count];
+	for (int i = 0; i < count; i++) {
+		[itemStrings addObject:[NSString stringWithUTF8String:items[i]]];
+	}
+	dispatch_sync(dispatch_get_main_queue(), ^{
+		NSAlert *alert = [[[NSAlert alloc] init] autorelease];
+		[alert setMessageText:titleString];
+		[alert setInformativeText:messageString];
+		[alert addButtonWithTitle:@"OK"];
+		[alert addButtonWithTitle:@"Cancel"];
+
+		NSPopUpButton *popup = nil;
+		NSMutableArray<NSButton *> *checkboxes = [NSMutableArray arrayWithCapacity:count];
+		if (multiple) {
+			CGFloat height = count * SelectRowHeight;
+			NSView *document = [[[NSView alloc] initWithFrame:NSMakeRect(0, 0, 280, height)] autorelease];
+			for (int i = 0; i < count; i++) {
+				// The origin of the view is at the bottom, the first item is at the top
+				NSButton *checkbox = [[[NSButton alloc] initWithFrame:NSMakeRect(0, height - (i + 1) * SelectRowHeight, 280, SelectRowHeight)] autorelease];
+				[checkbox setButtonType:NSButtonTypeSwitch];
+				[checkbox setTitle:itemStrings[i]];
+				[checkbox setState:(selected[i] ? NSControlStateValueOn : NSControlStateValueOff)];
+				[document addSubview:checkbox];
+				[checkboxes addObject:checkbox];
+			}
+			CGFloat visibleHeight = MIN(height, SelectMaxHeight);
+			NSScrollView *scroll = [[[NSScrollView alloc] initWithFrame:NSMakeRect(0, 0, 300, visibleHeight)] autorelease];
+			[scroll setHasVerticalScroller:(height > visibleHeight)];
+			[scroll setDrawsBackground:NO];
+			[scroll setDocumentView:document];
+			[[scroll contentView] scrollToPoint:NSMakePoint(0, height - visibleHeight)];
+			[scroll reflectScrolledClipView:[scroll contentView]];
+			[alert setAccessoryView:scroll];
+		} else {
+			popup = [[[NSPopUpButton alloc] initWithFrame:NSMakeRect(0, 0, 300, 26) pullsDown:NO] autorelease];
+			for (int i = 0; i < count; i++) {
+				// addItemWithTitle would merge the items with the same title
+				[[popup menu] addItemWithTitle:itemStrings[i] action:nil keyEquivalent:@""];
+				if (selected[i]) {
+					[popup selectItemAtIndex:i];
+				}
+			}
+			[alert setAccessoryView:popup];
+		}
+
+		if ([alert runModal] == NSAlertFirstButtonReturn) {
+			for (int i = 0; i < count; i++) {
+				selected[i] = multiple ? [checkboxes[i] state] == NSControlStateValueOn : [popup indexOfSelectedItem] == i;
+			}
+			result = true;
+		}
+	});
+	[titleString release];
+	[messageString release];
+	[itemStrings release];
+	return result;
+}
+*/
+import "C"
+
+import (
+	"slices"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// SelectDialog shows an alert with a popup button of the items, or check boxes to select multiple items
+func (f *Frontend) SelectDialog(options frontend.SelectDialogOptions) ([]string, bool) {
+	dialogLock.Lock()
+	defer dialogLock.Unlock()
+
+	title := C.CString(options.Title)
+	defer C.free(unsafe.Pointer(title))
+	message := C.CString(options.Message)
+	defer C.free(unsafe.Pointer(message))
+
+	count := len(options.Items)
+	items := (**C.char)(C.malloc(C.size_t(count) * C.size_t(unsafe.Sizeof(uintptr(0)))))
+	defer C.free(unsafe.Pointer(items))
+	selected := (*C.int)(C.malloc(C.size_t(count) * C.size_t(unsafe.Sizeof(C.int(0)))))
+	defer C.free(unsafe.Pointer(selected))
+	itemSlice := unsafe.Slice(items, count)
+	selectedSlice := unsafe.Slice(selected, count)
+	for index, item := range options.Items {
+		itemSlice[index] = C.CString(item)
+		defer C.free(unsafe.Pointer(itemSlice[index]))
+		selectedSlice[index] = 0
+		if slices.Contains(options.Default, item) {
+			selectedSlice[index] = 1
+		}
+	}
+
+	if !C.SelectDialog(title, message, items, selected, C.int(count), C.bool(options.Multiple)) {
+		return nil, false
+	}
+	values := []string{}
+	for index, item := range options.Items {
+		if selectedSlice[index] != 0 {
+			values = append(values, item)
+		}
+	}
+	return values, true
+}
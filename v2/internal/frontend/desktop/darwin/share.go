@@ -0,0 +1,81 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Cocoa
+#import "WailsContext.h"
+#include <stdlib.h>
+
+static NSSharingServicePicker *sharePicker = nil;
+
+static NSArray* arrayValue(id value) {
+	return [value isKindOfClass:[NSArray class]] ? value : @[];
+}
+
+// ShowShareMenu shows an NSSharingServicePicker with the items given as JSON, relative to the rect of the webview
+void ShowShareMenu(void *inctx, const char *items, int x, int y, int width, int height) {
+	WailsContext *ctx = (__bridge WailsContext*) inctx;
+	NSData *data = [NSData dataWithBytes:items length:strlen(items)];
+	NSDictionary *parsed = [[NSJSONSerialization JSONObjectWithData:data options:0 error:nil] retain];
+	dispatch_async(dispatch_get_main_queue(), ^{
+		NSMutableArray *shareItems = [NSMutableArray new];
+		id text = parsed[@"text"];
+		if ([text isKindOfClass:[NSString class]] && [text length] > 0) {
+			[shareItems addObject:text];
+		}
+		for (NSString *url in arrayValue(parsed[@"urls"])) {
+			NSURL *shareURL = [NSURL URLWithString:url];
+			if (shareURL != nil) {
+				[shareItems addObject:shareURL];
+			}
+		}
+		for (NSString *file in arrayValue(parsed[@"files"])) {
+			[shareItems addObject:[NSURL fileURLWithPath:file]];
+		}
+		[parsed release];
+
+		// The picker is kept until the next one is shown, as it isn't retained while its menu is open
+		[sharePicker release];
+		sharePicker = [[NSSharingServicePicker alloc] initWithItems:shareItems];
+		[shareItems release];
+		// The webview is flipped, so the picker is shown below the anchor
+		[sharePicker showRelativeToRect:NSMakeRect(x, y, width, height) ofView:ctx.webview preferredEdge:NSRectEdgeMaxY];
+	});
+}
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// ShowShareMenu shows the share menu of macOS with Mail, Messages, AirDrop etc. at the anchor
+func (f *Frontend) ShowShareMenu(items frontend.ShareItems, anchor frontend.Rect) error {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	cItems := C.CString(string(data))
+	defer C.free(unsafe.Pointer(cItems))
+	C.ShowShareMenu(f.mainWindow.context, cItems, C.int(anchor.X), C.int(anchor.Y), C.int(anchor.Width), C.int(anchor.Height))
+	return nil
+}
+
+// processServiceInvocation emits the ServiceInvokeEvent for the invocation of a service, given as JSON by the
+// AppDelegate
+func (f *Frontend) processServiceInvocation(invocationJSON string) {
+	var invocation frontend.ServiceInvocation
+	if err := json.Unmarshal([]byte(invocationJSON), &invocation); err != nil {
+		f.logger.Error("Invalid service message: %s", err.Error())
+		return
+	}
+	if events, ok := f.ctx.Value("events").(frontend.Events); ok {
+		events.Emit(frontend.ServiceInvokeEvent, invocation)
+	}
+}
@@ -0,0 +1,77 @@
+//go:build darwin
+// +build darwin
+
+package darwin
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Cocoa
+#import "WailsTouchBar.h"
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+var (
+	touchBarLock       sync.Mutex
+	touchBarItems      []frontend.TouchBarItem
+	touchBarGeneration int
+)
+
+// TouchBarSet replaces the items of the Touch Bar. The generation identifies the items, so interactions with items
+// which have been replaced in the meantime are ignored.
+func (f *Frontend) TouchBarSet(items []frontend.TouchBarItem) {
+	// The items are copied, so they can't be changed by the caller while they are shown
+	items = append([]frontend.TouchBarItem{}, items...)
+	touchBarLock.Lock()
+	touchBarItems = items
+	touchBarGeneration++
+	generation := touchBarGeneration
+	touchBarLock.Unlock()
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		f.logger.Error("Unable to set the Touch Bar: %s", err.Error())
+		return
+	}
+	cItems := C.CString(string(data))
+	defer C.free(unsafe.Pointer(cItems))
+	C.SetTouchBar(f.mainWindow.context, cItems, C.int(generation))
+}
+
+// processTouchBarEvent calls the callback of the item which has been interacted with. The value is the value of a
+// slider or the index of the selected entry of a scrubber.
+//
+//export processTouchBarEvent
+func processTouchBarEvent(cGeneration C.int, cIndex C.long, cValue *C.char) {
+	generation, index, value := int(cGeneration), int(cIndex), C.GoString(cValue)
+
+	touchBarLock.Lock()
+	defer touchBarLock.Unlock()
+	if generation != touchBarGeneration || index < 0 || index >= len(touchBarItems) {
+		return
+	}
+	item := touchBarItems[index]
+	switch item.Type {
+	case frontend.TouchBarSlider:
+		if value, err := strconv.ParseFloat(value, 64); err == nil && item.OnChange != nil {
+			go item.OnChange(value)
+		}
+	case frontend.TouchBarScrubber:
+		if selected, err := strconv.Atoi(value); err == nil && item.OnSelect != nil {
+			go item.OnSelect(selected)
+		}
+	default:
+		if item.OnClick != nil {
+			go item.OnClick()
+		}
+	}
+}
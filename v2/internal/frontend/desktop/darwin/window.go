@@ -19,8 +19,11 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"unsafe"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -34,6 +37,12 @@ type Window struct {
 	context unsafe.Pointer
 
 	applicationMenu *menu.Menu
+
+	// opacity is the alpha value of the window, it is only changed by SetOpacity
+	opacity     float64
+	opacityLock sync.Mutex
+
+	preloadScriptID atomic.Uint64
 }
 
 func bool2Cint(value bool) C.int {
@@ -87,6 +96,13 @@ func NewWindow(frontendOptions *options.App, debug bool, devtools bool) *Window
 
 	enableDragAndDrop := C.bool(frontendOptions.DragAndDrop != nil && frontendOptions.DragAndDrop.EnableFileDrop)
 	disableWebViewDragAndDrop := C.bool(frontendOptions.DragAndDrop != nil && frontendOptions.DragAndDrop.DisableWebViewDrop)
+	disableBackgroundThrottling := C.bool(frontendOptions.DisableBackgroundThrottling)
+
+	var schemes []string
+	for scheme := range frontendOptions.CustomSchemes {
+		schemes = append(schemes, scheme)
+	}
+	customSchemes := c.String(strings.Join(schemes, "\n"))
 
 	if frontendOptions.Mac != nil {
 		mac := frontendOptions.Mac
@@ -120,16 +136,22 @@ func NewWindow(frontendOptions *options.App, debug bool, devtools bool) *Window
 
 		appearance = c.String(string(mac.Appearance))
 	}
+	if frontendOptions.DisableWebviewTextSelection && preferences.textInteractionEnabled == nil {
+		// Disabling the text interaction of WebKit stops the selection natively on macOS 11.3 and later
+		preferences.textInteractionEnabled = bool2CboolPtr(false)
+	}
 	var context *C.WailsContext = C.Create(title, width, height, frameless, resizable, zoomable, fullscreen, fullSizeContent,
 		hideTitleBar, titlebarAppearsTransparent, hideTitle, useToolbar, hideToolbarSeparator, webviewIsTransparent,
 		alwaysOnTop, hideWindowOnClose, appearance, windowIsTranslucent, devtoolsEnabled, defaultContextMenuEnabled,
 		windowStartState, startsHidden, minWidth, minHeight, maxWidth, maxHeight, enableFraudulentWebsiteWarnings,
 		preferences, singleInstanceEnabled, singleInstanceUniqueId, enableDragAndDrop, disableWebViewDragAndDrop,
+		disableBackgroundThrottling, customSchemes,
 	)
 
 	// Create menu
 	result := &Window{
 		context: unsafe.Pointer(context),
+		opacity: 1,
 	}
 
 	if frontendOptions.BackgroundColour != nil {
@@ -148,6 +170,27 @@ func NewWindow(frontendOptions *options.App, debug bool, devtools bool) *Window
 		C.SetAbout(result.context, title, description, icon, length)
 	}
 
+	if frontendOptions.WindowLevel != options.WindowLevelNormal {
+		result.SetLevel(int(frontendOptions.WindowLevel))
+	}
+
+	if frontendOptions.DisableWebviewTextSelection || frontendOptions.DisableWebviewCopy {
+		script := c.String(frontend.TextSelectionScript(frontendOptions.DisableWebviewTextSelection, frontendOptions.DisableWebviewCopy))
+		C.SetTextSelection(result.context, script, C.bool(frontendOptions.DisableWebviewCopy))
+	}
+
+	if frontendOptions.ShowNavigationProgress {
+		C.ShowNavigationProgress(result.context)
+	}
+
+	if frontendOptions.StartActivated || frontendOptions.FocusOnShow {
+		C.SetActivation(result.context, C.bool(frontendOptions.StartActivated), C.bool(frontendOptions.FocusOnShow))
+	}
+
+	if mac := frontendOptions.Mac; mac != nil && (mac.WebviewKeyboardNavigationContained || mac.DisableWebviewFocusRing) {
+		C.SetKeyboardNavigation(result.context, C.bool(mac.WebviewKeyboardNavigationContained), C.bool(mac.DisableWebviewFocusRing))
+	}
+
 	if frontendOptions.Menu != nil {
 		result.SetApplicationMenu(frontendOptions.Menu)
 	}
@@ -190,10 +233,58 @@ func (w *Window) SetSize(width int, height int) {
 	C.SetSize(w.context, C.int(width), C.int(height))
 }
 
+// SizeToContent resizes the window so the webview has the size in device pixels
+func (w *Window) SizeToContent(width int, height int) {
+	C.SizeToContent(w.context, C.int(width), C.int(height))
+}
+
 func (w *Window) SetAlwaysOnTop(onTop bool) {
 	C.SetAlwaysOnTop(w.context, bool2Cint(onTop))
 }
 
+// SetOpacity sets the alpha value of the window and returns the previous opacity
+func (w *Window) SetOpacity(opacity float64) float64 {
+	w.opacityLock.Lock()
+	defer w.opacityLock.Unlock()
+	previous := w.opacity
+	w.opacity = opacity
+	C.SetWindowOpacity(w.context, C.double(opacity))
+	return previous
+}
+
+// SetUserAgent sets the user agent of the webview, wails.io is appended as it is to the default user agent
+func (w *Window) SetUserAgent(userAgent string) {
+	if userAgent != "" {
+		userAgent += " wails.io"
+	}
+	u := C.CString(userAgent)
+	C.SetUserAgent(w.context, u)
+	C.free(unsafe.Pointer(u))
+}
+
+// AddPreloadScript adds a script which is run before the scripts of every document, it returns the id of the script to
+// remove it
+func (w *Window) AddPreloadScript(script string) string {
+	id := strconv.FormatUint(w.preloadScriptID.Add(1), 10)
+	i := C.CString(id)
+	s := C.CString(script)
+	C.AddPreloadScript(w.context, i, s)
+	C.free(unsafe.Pointer(i))
+	C.free(unsafe.Pointer(s))
+	return id
+}
+
+// RemovePreloadScript removes a script added by AddPreloadScript, an unknown id is ignored
+func (w *Window) RemovePreloadScript(id string) {
+	i := C.CString(id)
+	C.RemovePreloadScript(w.context, i)
+	C.free(unsafe.Pointer(i))
+}
+
+func (w *Window) SetLevel(level int) {
+	C.SetWindowLevel(w.context, C.int(level))
+}
+
 func (w *Window) SetTitle(title string) {
 	t := C.CString(title)
 	C.SetTitle(w.context, t)
@@ -248,6 +339,10 @@ func (w *Window) UnFullscreen() {
 	C.UnFullscreen(w.context)
 }
 
+func (w *Window) BorderlessFullscreen(screen int) {
+	C.BorderlessFullscreen(w.context, C.int(screen))
+}
+
 func (w *Window) IsFullScreen() bool {
 	return (bool)(C.IsFullScreen(w.context))
 }
@@ -256,6 +351,10 @@ func (w *Window) Show() {
 	C.Show(w.context)
 }
 
+func (w *Window) Focus() {
+	C.Focus(w.context)
+}
+
 func (w *Window) Hide() {
 	C.Hide(w.context)
 }
@@ -310,3 +409,7 @@ func (w *Window) UpdateApplicationMenu() {
 func (w Window) Print() {
 	C.WindowPrint(w.context)
 }
+
+func (w *Window) StartDrag() {
+	C.StartDrag(w.context)
+}
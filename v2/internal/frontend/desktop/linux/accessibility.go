@@ -0,0 +1,112 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import (
+	"github.com/godbus/dbus/v5"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// The accessibility settings are read from the settings portal of the desktop, which GNOME and KDE provide
+const (
+	portalService           = "org.freedesktop.portal.Desktop"
+	portalPath              = dbus.ObjectPath("/org/freedesktop/portal/desktop")
+	portalSettingsInterface = "org.freedesktop.portal.Settings"
+
+	interfaceNamespace  = "org.gnome.desktop.interface"
+	appearanceNamespace = "org.freedesktop.appearance"
+
+	// highContrast is the "contrast" value of the appearance namespace for a high contrast theme
+	highContrast = 1
+)
+
+// readPortalSetting returns the value of a setting of the settings portal. The deprecated Read method is used as
+// it is available in every version of the portal, it wraps the value in a second variant.
+func readPortalSetting(conn *dbus.Conn, namespace string, key string) (interface{}, error) {
+	var value dbus.Variant
+	err := conn.Object(portalService, portalPath).Call(portalSettingsInterface+".Read", 0, namespace, key).Store(&value)
+	if err != nil {
+		return nil, err
+	}
+	if inner, ok := value.Value().(dbus.Variant); ok {
+		return inner.Value(), nil
+	}
+	return value.Value(), nil
+}
+
+func accessibilitySettings(conn *dbus.Conn) frontend.AccessibilitySettings {
+	var result frontend.AccessibilitySettings
+	if value, err := readPortalSetting(conn, interfaceNamespace, "enable-animations"); err == nil {
+		enabled, ok := value.(bool)
+		result.ReducedMotion = ok && !enabled
+	}
+	if value, err := readPortalSetting(conn, appearanceNamespace, "contrast"); err == nil {
+		contrast, _ := value.(uint32)
+		result.HighContrast = contrast == highContrast
+	}
+	return result
+}
+
+// SystemPrefersReducedMotion returns true if the animations of the desktop are turned off
+func (f *Frontend) SystemPrefersReducedMotion() bool {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return false
+	}
+	return accessibilitySettings(conn).ReducedMotion
+}
+
+// SystemHighContrastActive returns true if the desktop reports a high contrast appearance
+func (f *Frontend) SystemHighContrastActive() bool {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return false
+	}
+	return accessibilitySettings(conn).HighContrast
+}
+
+// watchAccessibility emits the AccessibilityChangeEvent when the settings portal reports a change of the animations
+// or the contrast, until the context is done
+func (f *Frontend) watchAccessibility() error {
+	conn, err := dbus.ConnectSessionBus()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	err = conn.AddMatchSignal(
+		dbus.WithMatchObjectPath(portalPath),
+		dbus.WithMatchInterface(portalSettingsInterface),
+		dbus.WithMatchMember("SettingChanged"),
+	)
+	if err != nil {
+		return err
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+	current := accessibilitySettings(conn)
+	for {
+		select {
+		case <-f.ctx.Done():
+			return nil
+		case signal, ok := <-signals:
+			if !ok {
+				return nil
+			}
+			if len(signal.Body) < 2 {
+				continue
+			}
+			namespace, _ := signal.Body[0].(string)
+			key, _ := signal.Body[1].(string)
+			if !(namespace == interfaceNamespace && key == "enable-animations") && !(namespace == appearanceNamespace && key == "contrast") {
+				continue
+			}
+			if settings := accessibilitySettings(conn); settings != current {
+				current = settings
+				frontend.AccessibilityChanged(f.ctx, settings)
+			}
+		}
+	}
+}
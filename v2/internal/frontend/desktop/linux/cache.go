@@ -0,0 +1,74 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0
+#cgo !webkit2_41 pkg-config: webkit2gtk-4.0
+#cgo webkit2_41 pkg-config: webkit2gtk-4.1
+
+#include <webkit2/webkit2.h>
+#include "window.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// websiteDataTypes are the WebKitWebsiteDataTypes of the cache kinds
+var websiteDataTypes = map[frontend.CacheKind]C.int{
+	frontend.CacheCookies:    C.WEBKIT_WEBSITE_DATA_COOKIES,
+	frontend.CacheDiskCache:  C.WEBKIT_WEBSITE_DATA_DISK_CACHE | C.WEBKIT_WEBSITE_DATA_MEMORY_CACHE,
+	frontend.CacheDOMStorage: C.WEBKIT_WEBSITE_DATA_LOCAL_STORAGE | C.WEBKIT_WEBSITE_DATA_SESSION_STORAGE | C.WEBKIT_WEBSITE_DATA_WEBSQL_DATABASES,
+	frontend.CacheIndexedDB:  C.WEBKIT_WEBSITE_DATA_INDEXEDDB_DATABASES,
+	frontend.CacheOther:      C.WEBKIT_WEBSITE_DATA_OFFLINE_APPLICATION_CACHE | C.WEBKIT_WEBSITE_DATA_DOM_CACHE,
+}
+
+// clearWebsiteData serialises the clearing of the website data, as the result is sent to a single channel
+var (
+	clearWebsiteDataLock   sync.Mutex
+	clearWebsiteDataResult = make(chan string, 1)
+)
+
+// WebviewClearCache clears the website data of the webview which has been modified since start, WebKitGTK only
+// clears the data until now
+func (f *Frontend) WebviewClearCache(kinds frontend.CacheKind, start, end time.Time) error {
+	if !end.IsZero() {
+		return errors.New("the end of the time range is not supported on Linux")
+	}
+	var types C.int
+	for kind, dataTypes := range websiteDataTypes {
+		if kinds&kind != 0 {
+			types |= dataTypes
+		}
+	}
+	// The timespan is in microseconds before now, zero clears all the data
+	var timespan C.gint64
+	if !start.IsZero() {
+		timespan = C.gint64(time.Since(start).Microseconds())
+	}
+
+	if isMainThread() {
+		return errWaitOnMainThread
+	}
+	clearWebsiteDataLock.Lock()
+	defer clearWebsiteDataLock.Unlock()
+	invokeOnMainThread(func() {
+		C.ClearWebsiteData(f.mainWindow.webview, types, timespan)
+	})
+	if message := <-clearWebsiteDataResult; message != "" {
+		return errors.New(message)
+	}
+	return nil
+}
+
+//export processClearWebsiteDataResult
+func processClearWebsiteDataResult(message *C.char) {
+	clearWebsiteDataResult <- C.GoString(message)
+}
@@ -0,0 +1,67 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0
+#cgo !webkit2_41 pkg-config: webkit2gtk-4.0
+#cgo webkit2_41 pkg-config: webkit2gtk-4.1
+
+#include "window.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// snapshotResult is the PNG of a snapshot of the webview, or the error of the snapshot
+type snapshotResult struct {
+	data []byte
+	err  error
+}
+
+// captureSnapshot serialises the snapshots, as the result is sent to a single channel
+var (
+	captureSnapshotLock   sync.Mutex
+	captureSnapshotResult = make(chan snapshotResult, 1)
+)
+
+// WebviewCapturePreview captures the visible content of the webview at the scale of the display
+func (f *Frontend) WebviewCapturePreview(format frontend.ImageFormat) ([]byte, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	if isMainThread() {
+		return nil, errWaitOnMainThread
+	}
+	captureSnapshotLock.Lock()
+	invokeOnMainThread(func() {
+		C.CaptureSnapshot(f.mainWindow.webview)
+	})
+	result := <-captureSnapshotResult
+	captureSnapshotLock.Unlock()
+	if result.err != nil {
+		return nil, result.err
+	}
+	return frontend.ConvertPNG(result.data, format)
+}
+
+// WindowCapture is not supported on Linux, the frame of the window is drawn by the compositor
+func (f *Frontend) WindowCapture(format frontend.ImageFormat) ([]byte, error) {
+	return nil, errors.New("capturing the window is not supported on Linux")
+}
+
+//export processSnapshotResult
+func processSnapshotResult(data unsafe.Pointer, length C.int, message *C.char) {
+	if data == nil {
+		captureSnapshotResult <- snapshotResult{err: errors.New(C.GoString(message))}
+		return
+	}
+	captureSnapshotResult <- snapshotResult{data: C.GoBytes(data, length)}
+}
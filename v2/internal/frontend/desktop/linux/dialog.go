@@ -4,8 +4,10 @@
 package linux
 
 import (
-	"github.com/wailsapp/wails/v2/internal/frontend"
+	"time"
 	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
 )
 
 /*
@@ -69,6 +71,18 @@ func (f *Frontend) MessageDialog(dialogOptions frontend.MessageDialogOptions) (s
 	return <-messageDialogResult, nil
 }
 
+func (f *Frontend) DateTimePickerDialog(dialogOptions frontend.DateTimePickerOptions) (time.Time, bool) {
+	return f.mainWindow.DateTimePickerDialog(dialogOptions)
+}
+
+func (f *Frontend) InputDialog(dialogOptions frontend.InputDialogOptions) (string, bool) {
+	return f.mainWindow.InputDialog(dialogOptions)
+}
+
+func (f *Frontend) SelectDialog(dialogOptions frontend.SelectDialogOptions) ([]string, bool) {
+	return f.mainWindow.SelectDialog(dialogOptions)
+}
+
 //export processOpenFileResult
 func processOpenFileResult(carray **C.char) {
 	// Create a Go slice from the C array
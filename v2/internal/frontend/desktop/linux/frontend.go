@@ -97,7 +97,9 @@ import (
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	wailsruntime "github.com/wailsapp/wails/v2/internal/frontend/runtime"
 	"github.com/wailsapp/wails/v2/internal/logger"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
 	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
 
 var initOnce = sync.Once{}
@@ -124,6 +126,14 @@ type Frontend struct {
 	mainWindow *Window
 	bindings   *binding.Bindings
 	dispatcher frontend.Dispatcher
+
+	// The display server GDK is connected to: "wayland" or "x11"
+	displayServer string
+
+	imeComposition frontend.IMECompositionState
+
+	// launch buffers the URLs and the files to open for the frontend
+	launch *frontend.LaunchBuffer
 }
 
 func (f *Frontend) RunMainLoop() {
@@ -143,6 +153,11 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 			_ = os.Setenv("GDK_BACKEND", "x11")
 		}
 
+		// Run through XWayland if requested
+		if os.Getenv("GDK_BACKEND") == "" && appoptions.Linux != nil && appoptions.Linux.PreferXWayland {
+			_ = os.Setenv("GDK_BACKEND", "x11")
+		}
+
 		if ok := C.gtk_init_check(nil, nil); ok != 1 {
 			panic(errors.New("failed to init GTK"))
 		}
@@ -154,6 +169,8 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		bindings:        appBindings,
 		dispatcher:      dispatcher,
 		ctx:             ctx,
+		displayServer:   C.GoString(C.GetDisplayServer()),
+		launch:          frontend.NewLaunchBuffer(frontend.ParseLaunchArgs(os.Args[1:], "")),
 	}
 	result.startURL, _ = url.Parse(startURL)
 
@@ -178,12 +195,17 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		if err != nil {
 			log.Fatal(err)
 		}
+		assets.UseLaunchScript(result.launch.Script)
 		result.assets = assets
-
-		go result.startRequestProcessor()
 	}
 
+	// The requests of the custom schemes are also received in the development mode
+	go result.startRequestProcessor()
+
 	go result.startMessageProcessor()
+	go result.startNavigationProgressProcessor()
+	go result.startFindResultProcessor()
+	go result.startFullscreenProcessor()
 
 	var _debug = ctx.Value("debug")
 	var _devtoolsEnabled = ctx.Value("devtoolsEnabled")
@@ -216,6 +238,29 @@ func (f *Frontend) startMessageProcessor() {
 	}
 }
 
+// startNavigationProgressProcessor emits the progress of the loads. It is received from the native code rather than
+// as a message, which could be sent by the scripts of the page.
+func (f *Frontend) startNavigationProgressProcessor() {
+	for progress := range navigationProgressBuffer {
+		frontend.NavigationProgressChanged(f.ctx, progress)
+	}
+}
+
+// startFindResultProcessor emits the results of the find controller, which are received from the native code
+func (f *Frontend) startFindResultProcessor() {
+	for result := range findResultBuffer {
+		frontend.FindCompleted(f.ctx, result)
+	}
+}
+
+// startFullscreenProcessor emits the fullscreen changes of the elements of the page, which are received from the
+// native code
+func (f *Frontend) startFullscreenProcessor() {
+	for fullscreen := range fullscreenBuffer {
+		frontend.FullscreenChanged(f.ctx, fullscreen)
+	}
+}
+
 func (f *Frontend) WindowReload() {
 	f.ExecJS("runtime.WindowReload();")
 }
@@ -234,6 +279,9 @@ func (f *Frontend) WindowSetDarkTheme() {
 
 func (f *Frontend) Run(ctx context.Context) error {
 	f.ctx = ctx
+	onMenuChanged = func() {
+		frontend.MenuChanged(f.ctx, f.mainWindow.applicationMenu)
+	}
 
 	go func() {
 		if f.frontendOptions.OnStartup != nil {
@@ -245,21 +293,108 @@ func (f *Frontend) Run(ctx context.Context) error {
 		SetupSingleInstance(f.frontendOptions.SingleInstanceLock.UniqueId)
 	}
 
+	go func() {
+		if err := frontend.WatchNetworkStatus(f.ctx); err != nil {
+			f.logger.Debug("Unable to watch the network status: %s", err.Error())
+		}
+	}()
+
+	go func() {
+		if err := f.watchSession(); err != nil {
+			f.logger.Debug("Unable to watch the session: %s", err.Error())
+		}
+	}()
+
+	go func() {
+		if err := f.watchAccessibility(); err != nil {
+			f.logger.Debug("Unable to watch the accessibility settings: %s", err.Error())
+		}
+	}()
+
+	if err := f.setParentWindow(); err != nil {
+		return err
+	}
+
 	f.mainWindow.Run(f.startURL.String())
 
 	return nil
 }
 
-func (f *Frontend) WindowCenter() {
+func (f *Frontend) WindowCenter() error {
+	if err := f.unsupportedByWayland("WindowCenter"); err != nil {
+		return err
+	}
 	f.mainWindow.Center()
+	return nil
+}
+
+func (f *Frontend) WindowSetLevel(level options.WindowLevel) error {
+	if err := f.unsupportedByWayland("WindowSetLevel"); err != nil {
+		return err
+	}
+	f.mainWindow.SetLevel(int(level))
+	return nil
 }
 
-func (f *Frontend) WindowSetAlwaysOnTop(b bool) {
+func (f *Frontend) WindowSetAlwaysOnTop(b bool) error {
+	if err := f.unsupportedByWayland("WindowSetAlwaysOnTop"); err != nil {
+		return err
+	}
 	f.mainWindow.SetKeepAbove(b)
+	return nil
 }
 
-func (f *Frontend) WindowSetPosition(x, y int) {
+func (f *Frontend) WindowSetAlwaysOnBottom(b bool) error {
+	if err := f.unsupportedByWayland("WindowSetAlwaysOnBottom"); err != nil {
+		return err
+	}
+	f.mainWindow.SetKeepBelow(b)
+	return nil
+}
+
+func (f *Frontend) WindowOpenDevTools() error {
+	if !f.devtoolsEnabled {
+		return errors.New("the dev tools are disabled, build with the devtools flag to enable them")
+	}
+	f.mainWindow.ShowInspector()
+	return nil
+}
+
+func (f *Frontend) WindowCloseDevTools() error {
+	if !f.devtoolsEnabled {
+		return errors.New("the dev tools are disabled, build with the devtools flag to enable them")
+	}
+	f.mainWindow.CloseInspector()
+	return nil
+}
+
+func (f *Frontend) WindowSetUserAgent(userAgent string) {
+	f.mainWindow.SetUserAgent(userAgent)
+}
+
+func (f *Frontend) WebviewAddPreloadScript(script string) (string, error) {
+	return f.mainWindow.AddPreloadScript(script), nil
+}
+
+func (f *Frontend) WebviewRemovePreloadScript(id string) error {
+	f.mainWindow.RemovePreloadScript(id)
+	return nil
+}
+
+func (f *Frontend) WindowSetSkipTaskbar(skip bool) {
+	f.mainWindow.SetSkipTaskbar(skip)
+}
+
+func (f *Frontend) WindowSetOpacity(opacity float64) float64 {
+	return f.mainWindow.SetOpacity(min(max(opacity, 0), 1))
+}
+
+func (f *Frontend) WindowSetPosition(x, y int) error {
+	if err := f.unsupportedByWayland("WindowSetPosition"); err != nil {
+		return err
+	}
 	f.mainWindow.SetPosition(x, y)
+	return nil
 }
 func (f *Frontend) WindowGetPosition() (int, int) {
 	return f.mainWindow.GetPosition()
@@ -269,6 +404,18 @@ func (f *Frontend) WindowSetSize(width, height int) {
 	f.mainWindow.SetSize(width, height)
 }
 
+// WindowSetSizeToContent measures the content in a goroutine, as the script is evaluated by the main loop
+func (f *Frontend) WindowSetSizeToContent() {
+	go func() {
+		width, height, err := frontend.MeasureContent(f.evalScript)
+		if err != nil {
+			f.logger.Error("WindowSetSizeToContent: %s", err)
+			return
+		}
+		f.mainWindow.SizeToContent(width, height)
+	}()
+}
+
 func (f *Frontend) WindowGetSize() (int, int) {
 	return f.mainWindow.Size()
 }
@@ -291,6 +438,21 @@ func (f *Frontend) WindowUnfullscreen() {
 	f.mainWindow.UnFullscreen()
 }
 
+func (f *Frontend) WindowSetBorderlessFullscreen(screen int) error {
+	screens, err := f.ScreenGetAll()
+	if err != nil {
+		return err
+	}
+	if screen < 0 || screen >= len(screens) {
+		return fmt.Errorf("unknown screen: %d", screen)
+	}
+	if f.frontendOptions.Frameless && f.frontendOptions.DisableResize == false {
+		f.ExecJS("window.wails.flags.enableResize = false;")
+	}
+	f.mainWindow.BorderlessFullscreen(screen)
+	return nil
+}
+
 func (f *Frontend) WindowReloadApp() {
 	f.ExecJS(fmt.Sprintf("window.location.href = '%s';", f.startURL))
 }
@@ -303,6 +465,10 @@ func (f *Frontend) WindowHide() {
 	f.mainWindow.Hide()
 }
 
+func (f *Frontend) WindowFocus() {
+	f.mainWindow.Focus()
+}
+
 func (f *Frontend) Show() {
 	f.mainWindow.Show()
 }
@@ -344,6 +510,19 @@ func (f *Frontend) ScreenGetAll() ([]Screen, error) {
 	return GetAllScreens(f.mainWindow.asGTKWindow())
 }
 
+func (f *Frontend) GetDisplayServer() string {
+	return f.displayServer
+}
+
+// unsupportedByWayland returns an error wrapping frontend.ErrNotSupportedByWayland if the given window operation is
+// called in a Wayland session, where the compositor doesn't allow clients to control it.
+func (f *Frontend) unsupportedByWayland(method string) error {
+	if f.displayServer != "wayland" {
+		return nil
+	}
+	return fmt.Errorf("%s is %w, use Linux.PreferXWayland to run through XWayland", method, frontend.ErrNotSupportedByWayland)
+}
+
 func (f *Frontend) WindowIsMaximised() bool {
 	return f.mainWindow.IsMaximised()
 }
@@ -372,10 +551,148 @@ func (f *Frontend) Quit() {
 	f.mainWindow.Quit()
 }
 
+// PerformHaptic is not supported on Linux
+func (f *Frontend) PerformHaptic(pattern string) {}
+
+// TouchBarSet is not supported on Linux
+func (f *Frontend) TouchBarSet(items []frontend.TouchBarItem) {}
+
+// ShowShareMenu is not supported on Linux
+func (f *Frontend) ShowShareMenu(items frontend.ShareItems, anchor frontend.Rect) error {
+	return errors.New("sharing is not supported on Linux")
+}
+
+// WindowSetCursor overrides the cursor of the window, the cursor of the page is overridden with CSS as the webview
+// sets its own cursor
+func (f *Frontend) WindowSetCursor(cursor string) {
+	f.mainWindow.SetCursor(cursor)
+	f.ExecJS(frontend.CursorScript(cursor))
+}
+
+func (f *Frontend) WindowSetCursorImage(image []byte, hotspotX int, hotspotY int) {
+	f.mainWindow.SetCursorImage(image, hotspotX, hotspotY)
+	f.ExecJS(frontend.CursorScript(frontend.CursorImageCSS(image, hotspotX, hotspotY)))
+}
+
+// FindInPage selects the next match of the text in the page with the find controller of WebKitGTK, which also
+// highlights the other matches
+func (f *Frontend) FindInPage(text string, options frontend.FindOptions) {
+	if text == "" {
+		f.StopFind()
+		frontend.FindCompleted(f.ctx, frontend.FindResult{})
+		return
+	}
+	f.mainWindow.FindInPage(text, options.CaseSensitive, options.Backwards)
+}
+
+func (f *Frontend) StopFind() {
+	f.mainWindow.StopFind()
+}
+
+// IMEIsComposing returns true while an input method composition is in progress in the page
+func (f *Frontend) IMEIsComposing() bool {
+	return f.imeComposition.IsComposing()
+}
+
 func (f *Frontend) WindowPrint() {
 	f.ExecJS("window.print();")
 }
 
+func (f *Frontend) WindowSetBlurRegion(rects []frontend.Rect) {
+}
+
+func (f *Frontend) WindowSetBackdropType(backdrop windows.BackdropType) error {
+	return errors.New("backdrop types are only supported on Windows")
+}
+
+// WindowSetCustomTheme is not supported on Linux
+func (f *Frontend) WindowSetCustomTheme(theme *windows.ThemeSettings) {}
+
+// WindowGetState returns the position, the size and the maximised state of the window, the screen isn't reported on
+// Linux
+func (f *Frontend) WindowGetState() frontend.WindowState {
+	x, y := f.WindowGetPosition()
+	width, height := f.WindowGetSize()
+	return frontend.WindowState{X: x, Y: y, Width: width, Height: height, Maximised: f.WindowIsMaximised()}
+}
+
+// WindowSetState restores the position, the size and the maximised state of the window, the position isn't checked
+// against the screens on Linux
+func (f *Frontend) WindowSetState(state frontend.WindowState) {
+	if state.Width > 0 && state.Height > 0 {
+		f.WindowUnmaximise()
+		f.WindowSetSize(state.Width, state.Height)
+		// The position is ignored by Wayland compositors
+		_ = f.WindowSetPosition(state.X, state.Y)
+	}
+	if state.Maximised {
+		f.WindowMaximise()
+	}
+}
+
+// WindowSetAnimationsEnabled is not supported on Linux
+func (f *Frontend) WindowSetAnimationsEnabled(enabled bool) {}
+
+// WindowSetMinimiseButtonEnabled is not supported on Linux
+func (f *Frontend) WindowSetMinimiseButtonEnabled(enabled bool) {}
+
+// WindowSetMaximiseButtonEnabled is not supported on Linux
+func (f *Frontend) WindowSetMaximiseButtonEnabled(enabled bool) {}
+
+// WindowSetCloseButtonEnabled is not supported on Linux
+func (f *Frontend) WindowSetCloseButtonEnabled(enabled bool) {}
+
+// WindowSetIgnoreMouseEvents is not supported on Linux
+func (f *Frontend) WindowSetIgnoreMouseEvents(ignore bool, forward bool) {}
+
+// WindowSetContentProtection is not supported on Linux
+func (f *Frontend) WindowSetContentProtection(enabled bool) error {
+	return errors.New("content protection is only supported on Windows")
+}
+
+// WindowFlash is not supported on Linux
+func (f *Frontend) WindowFlash(untilFocused bool) {}
+
+// WindowSetTaskbarProgress is not supported on Linux
+func (f *Frontend) WindowSetTaskbarProgress(state frontend.ProgressState, completed, total uint64) {}
+
+// RegisterGlobalHotkey is not supported on Linux
+func (f *Frontend) RegisterGlobalHotkey(accelerator *keys.Accelerator, handler func()) (int, error) {
+	return 0, errors.New("global hotkeys are only supported on Windows")
+}
+
+// UnregisterGlobalHotkey is not supported on Linux
+func (f *Frontend) UnregisterGlobalHotkey(id int) {}
+
+// WindowSetOverlayIcon is not supported on Linux
+func (f *Frontend) WindowSetOverlayIcon(image []byte, description string) error {
+	return errors.New("overlay icons are not supported on Linux")
+}
+
+// WindowClearOverlayIcon is not supported on Linux
+func (f *Frontend) WindowClearOverlayIcon() {}
+
+// WindowSetThumbbarButtons is not supported on Linux
+func (f *Frontend) WindowSetThumbbarButtons(buttons []frontend.ThumbbarButton) error {
+	return errors.New("thumbnail toolbar buttons are not supported on Linux")
+}
+
+// WindowSetZoomForOrigin is not supported on Linux
+func (f *Frontend) WindowSetZoomForOrigin(origin string, zoom float64) {}
+
+func (f *Frontend) WindowSetZoomFactor(factor float64) error {
+	return errors.New("the zoom factor can only be changed on Windows")
+}
+
+// WindowGetZoomFactor is not supported on Linux, the page is never zoomed by the runtime
+func (f *Frontend) WindowGetZoomFactor() float64 {
+	return 1.0
+}
+
+func (f *Frontend) WindowGetDPI() (uint, error) {
+	return 0, errors.New("the DPI of the window is only available on Windows")
+}
+
 type EventNotify struct {
 	Name string        `json:"name"`
 	Data []interface{} `json:"data"`
@@ -420,6 +737,16 @@ func (f *Frontend) processMessage(message string) {
 		return
 	}
 
+	if strings.HasPrefix(message, frontend.IMECompositionMessage) {
+		composition, err := frontend.ParseIMECompositionMessage(message)
+		if err != nil {
+			f.logger.Error(err.Error())
+			return
+		}
+		f.imeComposition.Update(f.ctx, composition)
+		return
+	}
+
 	if message == "wails:showInspector" {
 		f.mainWindow.ShowInspector()
 		return
@@ -453,6 +780,10 @@ func (f *Frontend) processMessage(message string) {
 		)
 
 		f.ExecJS(cmd)
+		f.ExecJS(frontend.IMECompositionScript)
+		if !f.frontendOptions.DisableDoubleClickMaximise {
+			f.ExecJS(frontend.DragRegionDoubleClickScript)
+		}
 
 		if f.frontendOptions.Frameless && f.frontendOptions.DisableResize == false {
 			f.ExecJS("window.wails.flags.enableResize = true;")
@@ -498,6 +829,14 @@ func (f *Frontend) startDrag() {
 	f.mainWindow.StartDrag()
 }
 
+func (f *Frontend) WindowStartConstrainedDrag(constraints frontend.DragConstraints) {
+	if f.mainWindow.IsFullScreen() {
+		return
+	}
+	// Drag constraints are not supported by GTK, perform a normal drag
+	f.startDrag()
+}
+
 func (f *Frontend) startResize(edge uintptr) error {
 	f.mainWindow.StartResize(edge)
 	return nil
@@ -515,11 +854,39 @@ func processMessage(message *C.char) {
 	messageBuffer <- goMessage
 }
 
+var navigationProgressBuffer = make(chan float64, 100)
+
+//export processNavigationProgress
+func processNavigationProgress(progress C.double) {
+	navigationProgressBuffer <- float64(progress)
+}
+
+var findResultBuffer = make(chan frontend.FindResult, 10)
+
+//export processFindResult
+func processFindResult(matches C.guint, text *C.char) {
+	findResultBuffer <- frontend.FindResult{Text: C.GoString(text), Matches: int(matches)}
+}
+
+var fullscreenBuffer = make(chan bool, 10)
+
+//export processFullscreenChange
+func processFullscreenChange(fullscreen C.gboolean) {
+	fullscreenBuffer <- fullscreen != 0
+}
+
 var requestBuffer = make(chan webview.Request, 100)
 
 func (f *Frontend) startRequestProcessor() {
 	for request := range requestBuffer {
-		f.assets.ServeWebViewRequest(request)
+		uri, _ := request.URL()
+		if handler, ok := assetserver.CustomSchemeHandler(f.frontendOptions.CustomSchemes, uri); ok {
+			assetserver.ServeCustomScheme(request, handler, f.logger.Error)
+		} else if f.assets != nil {
+			f.assets.ServeWebViewRequest(request)
+		} else {
+			_ = request.Close()
+		}
 	}
 }
 
@@ -530,6 +897,7 @@ func processURLRequest(request unsafe.Pointer) {
 
 func (f *Frontend) startSecondInstanceProcessor() {
 	for secondInstanceData := range secondInstanceBuffer {
+		f.launch.Launched(f.ctx, frontend.ParseLaunchArgs(secondInstanceData.Args, secondInstanceData.WorkingDirectory), f.ExecJS)
 		if f.frontendOptions.SingleInstanceLock != nil &&
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch != nil {
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch(secondInstanceData)
@@ -63,6 +63,9 @@ func handleMenuItemClick(gtkWidget unsafe.Pointer) {
 			C.gtk_check_menu_item_set_active(C.toGtkCheckMenuItem(unsafe.Pointer(gtkCheckbox)), checked)
 			C.unblockClick(gtkCheckbox, handler)
 		}
+		if onMenuChanged != nil {
+			onMenuChanged()
+		}
 		go item.Click(&menu.CallbackData{MenuItem: item})
 	case menu.RadioType:
 		gtkRadioItems := gtkRadioMenuCache[item]
@@ -75,6 +78,9 @@ func handleMenuItemClick(gtkWidget unsafe.Pointer) {
 				C.unblockClick(gtkRadioItem, handler)
 			}
 			item.Checked = true
+			if onMenuChanged != nil {
+				onMenuChanged()
+			}
 			go item.Click(&menu.CallbackData{MenuItem: item})
 		} else {
 			item.Checked = false
@@ -17,6 +17,7 @@ static inline void triggerInvokesOnMainThread() {
 */
 import "C"
 import (
+	"errors"
 	"runtime"
 	"sync"
 	"unsafe"
@@ -30,6 +31,10 @@ var (
 	dispatchq []func()
 )
 
+// errWaitOnMainThread is returned by the calls which wait for a callback of the webview, which is called by the main
+// loop, when they are made on the main thread
+var errWaitOnMainThread = errors.New("this call waits for the webview and can't be made on the main thread")
+
 func invokeOnMainThread(f func()) {
 	if tryInvokeOnCurrentGoRoutine(f) {
 		return
@@ -43,6 +48,15 @@ func invokeOnMainThread(f func()) {
 }
 
 func tryInvokeOnCurrentGoRoutine(f func()) bool {
+	if !isMainThread() {
+		return false
+	}
+	f()
+	return true
+}
+
+// isMainThread returns true if the current goroutine runs on the thread of the main loop
+func isMainThread() bool {
 	m.Lock()
 	mainThreadID := mainTid
 	m.Unlock()
@@ -50,11 +64,7 @@ func tryInvokeOnCurrentGoRoutine(f func()) bool {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	if mainThreadID != unix.Gettid() {
-		return false
-	}
-	f()
-	return true
+	return mainThreadID == unix.Gettid()
 }
 
 //export invokeCallbacks
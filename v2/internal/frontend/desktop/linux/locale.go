@@ -0,0 +1,65 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#include <langinfo.h>
+#include <locale.h>
+#include <stdlib.h>
+
+static int firstDayOfWeek() {
+#ifdef __GLIBC__
+	// _NL_TIME_FIRST_WEEKDAY is relative to _NL_TIME_WEEK_1STDAY, which is a Sunday (19971130) or a Monday (19971201)
+	union { unsigned int word; char *string; } week1stday;
+	week1stday.string = nl_langinfo(_NL_TIME_WEEK_1STDAY);
+	int base = week1stday.word == 19971201 ? 1 : 0;
+	int first = nl_langinfo(_NL_TIME_FIRST_WEEKDAY)[0];
+	return (base + first - 1 + 7) % 7;
+#else
+	return 0;
+#endif
+}
+
+static const char* currencyCode() {
+#ifdef __GLIBC__
+	return nl_langinfo(__INT_CURR_SYMBOL);
+#else
+	return "";
+#endif
+}
+*/
+import "C"
+import (
+	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// GetLocaleInfo returns the locale info of the C locale, which GTK initialises from the environment.
+// The date and time formats are strftime patterns. The locales have a single date format, D_T_FMT also contains the
+// time, so D_FMT is used for both date formats.
+func (f *Frontend) GetLocaleInfo() (frontend.LocaleInfo, error) {
+	locale := C.GoString(C.setlocale(C.LC_NUMERIC, nil))
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+
+	// CRNCYSTR is prefixed with the position of the symbol
+	currencySymbol := C.GoString(C.nl_langinfo(C.CRNCYSTR))
+	if len(currencySymbol) > 0 && strings.ContainsAny(currencySymbol[:1], "-+.") {
+		currencySymbol = currencySymbol[1:]
+	}
+
+	return frontend.LocaleInfo{
+		Locale:             strings.ReplaceAll(locale, "_", "-"),
+		DecimalSeparator:   C.GoString(C.nl_langinfo(C.RADIXCHAR)),
+		ThousandsSeparator: C.GoString(C.nl_langinfo(C.THOUSEP)),
+		ShortDateFormat:    C.GoString(C.nl_langinfo(C.D_FMT)),
+		LongDateFormat:     C.GoString(C.nl_langinfo(C.D_FMT)),
+		TimeFormat:         C.GoString(C.nl_langinfo(C.T_FMT)),
+		FirstDayOfWeek:     int(C.firstDayOfWeek()),
+		CurrencySymbol:     currencySymbol,
+		CurrencyCode:       strings.TrimSpace(C.GoString(C.currencyCode())),
+	}, nil
+}
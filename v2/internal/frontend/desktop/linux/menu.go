@@ -46,6 +46,9 @@ var gtkRadioMenuCache map[*menu.MenuItem][]*C.GtkWidget
 var gtkSignalHandlers map[*C.GtkWidget]C.gulong
 var gtkSignalToMenuItem map[*C.GtkWidget]*menu.MenuItem
 
+// onMenuChanged is called when a checkbox or radio item has been toggled
+var onMenuChanged func()
+
 func (f *Frontend) MenuSetApplicationMenu(menu *menu.Menu) {
 	f.mainWindow.SetApplicationMenu(menu)
 }
@@ -54,6 +57,10 @@ func (f *Frontend) MenuUpdateApplicationMenu() {
 	f.mainWindow.SetApplicationMenu(f.mainWindow.applicationMenu)
 }
 
+func (f *Frontend) MenuGetApplicationMenu() *menu.Menu {
+	return f.mainWindow.applicationMenu
+}
+
 func (w *Window) SetApplicationMenu(inmenu *menu.Menu) {
 	if inmenu == nil {
 		return
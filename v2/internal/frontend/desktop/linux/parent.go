@@ -0,0 +1,65 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0
+#cgo linux LDFLAGS: -ldl
+
+#define _GNU_SOURCE
+#include <dlfcn.h>
+#include "gtk/gtk.h"
+
+typedef GdkWindow *(*foreignWindowFunc)(GdkDisplay *display, unsigned long window);
+
+// setTransientFor makes the X11 window the parent of the window. gdk_x11_window_foreign_new_for_display is looked up
+// at runtime to avoid depending on gdkx.h, like GetDisplayServer.
+static int setTransientFor(GtkWindow *window, unsigned long parentID, int modal)
+{
+    foreignWindowFunc foreignWindow = (foreignWindowFunc)dlsym(RTLD_DEFAULT, "gdk_x11_window_foreign_new_for_display");
+    if (foreignWindow == NULL)
+    {
+        return 0;
+    }
+    GdkWindow *parent = foreignWindow(gdk_display_get_default(), parentID);
+    if (parent == NULL)
+    {
+        return 0;
+    }
+    gtk_widget_realize(GTK_WIDGET(window));
+    GdkWindow *gdkWindow = gtk_widget_get_window(GTK_WIDGET(window));
+    gdk_window_set_transient_for(gdkWindow, parent);
+    if (modal)
+    {
+        gtk_window_set_modal(window, TRUE);
+        gdk_window_set_modal_hint(gdkWindow, TRUE);
+    }
+    g_object_unref(parent);
+    return 1;
+}
+*/
+import "C"
+import (
+	"errors"
+	"fmt"
+)
+
+// setParentWindow sets the Linux.ParentWindow option as the parent of the main window. It must be called before the
+// window is shown.
+func (f *Frontend) setParentWindow() error {
+	linuxOptions := f.frontendOptions.Linux
+	if linuxOptions == nil || linuxOptions.ParentWindow == 0 {
+		return nil
+	}
+	if err := f.unsupportedByWayland("ParentWindow"); err != nil {
+		return err
+	}
+	if f.displayServer != "x11" {
+		return errors.New("ParentWindow is only supported on X11")
+	}
+	if C.setTransientFor(f.mainWindow.asGTKWindow(), C.ulong(linuxOptions.ParentWindow), bool2Cint(linuxOptions.Modal)) == 0 {
+		return fmt.Errorf("unable to find the parent window 0x%x", linuxOptions.ParentWindow)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0
+#cgo !webkit2_41 pkg-config: webkit2gtk-4.0
+#cgo webkit2_41 pkg-config: webkit2gtk-4.1
+
+#include <stdlib.h>
+#include "window.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// printToPDF serialises the printing, as the result is sent to a single channel
+var (
+	printToPDFLock   sync.Mutex
+	printToPDFResult = make(chan string, 1)
+)
+
+// WebviewPrintToPDF prints the page to a PDF file at path without showing the print dialog. WebKitGTK doesn't print
+// a header and a footer.
+func (f *Frontend) WebviewPrintToPDF(path string, settings frontend.PrintSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	if settings.HeaderAndFooter {
+		return errors.New("printing the header and the footer is not supported on Linux")
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+
+	cSettings := C.PrintToPDFSettings{
+		landscape:        bool2Cint(settings.Orientation == frontend.PrintLandscape),
+		scale:            C.double(settings.ScaleFactor),
+		pageWidth:        C.double(settings.PageWidth),
+		pageHeight:       C.double(settings.PageHeight),
+		printBackgrounds: bool2Cint(settings.PrintBackgrounds),
+	}
+	// The size of a letter is the default of the other platforms
+	if settings.PageWidth != 0 || settings.PageHeight != 0 {
+		if settings.PageWidth == 0 {
+			cSettings.pageWidth = 8.5
+		}
+		if settings.PageHeight == 0 {
+			cSettings.pageHeight = 11
+		}
+	}
+	if margins := settings.Margins; margins != nil {
+		cSettings.hasMargins = 1
+		cSettings.marginTop = C.double(margins.Top)
+		cSettings.marginBottom = C.double(margins.Bottom)
+		cSettings.marginLeft = C.double(margins.Left)
+		cSettings.marginRight = C.double(margins.Right)
+	}
+
+	if isMainThread() {
+		return errWaitOnMainThread
+	}
+	printToPDFLock.Lock()
+	defer printToPDFLock.Unlock()
+	invokeOnMainThread(func() {
+		cPath := C.CString(path)
+		defer C.free(unsafe.Pointer(cPath))
+		C.PrintToPDF(f.mainWindow.webview, cPath, &cSettings)
+	})
+	if message := <-printToPDFResult; message != "" {
+		return errors.New(message)
+	}
+	return nil
+}
+
+// WebviewPrintToPDFData prints the page to a temporary PDF file and returns its content
+func (f *Frontend) WebviewPrintToPDFData(settings frontend.PrintSettings) ([]byte, error) {
+	directory, err := os.MkdirTemp("", "wails-print-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(directory)
+
+	path := filepath.Join(directory, "page.pdf")
+	if err := f.WebviewPrintToPDF(path, settings); err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+//export processPrintToPDFResult
+func processPrintToPDFResult(message *C.char) {
+	printToPDFResult <- C.GoString(message)
+}
@@ -0,0 +1,58 @@
+//go:build linux
+// +build linux
+
+package linux
+
+/*
+#cgo linux pkg-config: gtk+-3.0
+#cgo !webkit2_41 pkg-config: webkit2gtk-4.0
+#cgo webkit2_41 pkg-config: webkit2gtk-4.1
+
+#include <stdlib.h>
+#include "window.h"
+*/
+import "C"
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+)
+
+// scriptResult is the string a script resulted in, or the error of the script
+type scriptResult struct {
+	value string
+	err   error
+}
+
+// evalScriptLock serialises the scripts, as the result is sent to a single channel
+var (
+	evalScriptLock   sync.Mutex
+	evalScriptResult = make(chan scriptResult, 1)
+)
+
+// evalScript evaluates a script which results in a string in the webview and returns the string. The result is read
+// from the callback of the webview, unlike the messages it can't be sent by the scripts of the page.
+func (f *Frontend) evalScript(script string) (string, error) {
+	if isMainThread() {
+		return "", errWaitOnMainThread
+	}
+	source := C.CString(script)
+	defer C.free(unsafe.Pointer(source))
+	evalScriptLock.Lock()
+	defer evalScriptLock.Unlock()
+	invokeOnMainThread(func() {
+		C.EvaluateScript(f.mainWindow.webview, source)
+	})
+	result := <-evalScriptResult
+	return result.value, result.err
+}
+
+//export processScriptResult
+func processScriptResult(value *C.char, message *C.char) {
+	if value == nil {
+		evalScriptResult <- scriptResult{err: errors.New(C.GoString(message))}
+		return
+	}
+	evalScriptResult <- scriptResult{value: C.GoString(value)}
+}
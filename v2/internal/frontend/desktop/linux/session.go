@@ -0,0 +1,119 @@
+//go:build linux
+// +build linux
+
+package linux
+
+import (
+	"github.com/godbus/dbus/v5"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+const (
+	logindService          = "org.freedesktop.login1"
+	logindPath             = dbus.ObjectPath("/org/freedesktop/login1")
+	logindManagerInterface = "org.freedesktop.login1.Manager"
+	logindSessionInterface = "org.freedesktop.login1.Session"
+)
+
+// logindSession returns the logind session of the process. The "auto" session is the session of the process, or the
+// graphical session of the user if the process isn't part of a session.
+func logindSession(conn *dbus.Conn) (dbus.BusObject, error) {
+	var path dbus.ObjectPath
+	err := conn.Object(logindService, logindPath).Call(logindManagerInterface+".GetSession", 0, "auto").Store(&path)
+	if err != nil {
+		return nil, err
+	}
+	return conn.Object(logindService, path), nil
+}
+
+// IsRemoteSession returns true if logind reports the session as remote, EG for an XRDP or SSH session
+func (f *Frontend) IsRemoteSession() bool {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return false
+	}
+	session, err := logindSession(conn)
+	if err != nil {
+		return false
+	}
+	remote, err := session.GetProperty(logindSessionInterface + ".Remote")
+	if err != nil {
+		return false
+	}
+	value, _ := remote.Value().(bool)
+	return value
+}
+
+// watchSession emits the SessionChangeEvent until the context is done. The session is locked when logind requests it,
+// or when the screen locker sets the LockedHint, and it is disconnected when it is no longer the active session of
+// its seat.
+func (f *Frontend) watchSession() error {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	session, err := logindSession(conn)
+	if err != nil {
+		return err
+	}
+	locked, active := false, true
+	if value, err := session.GetProperty(logindSessionInterface + ".LockedHint"); err == nil {
+		locked, _ = value.Value().(bool)
+	}
+	if value, err := session.GetProperty(logindSessionInterface + ".Active"); err == nil {
+		active, _ = value.Value().(bool)
+	}
+
+	matches := [][]dbus.MatchOption{
+		{dbus.WithMatchObjectPath(session.Path()), dbus.WithMatchInterface(logindSessionInterface)},
+		{dbus.WithMatchObjectPath(session.Path()), dbus.WithMatchInterface("org.freedesktop.DBus.Properties"), dbus.WithMatchMember("PropertiesChanged")},
+	}
+	for _, match := range matches {
+		if err := conn.AddMatchSignal(match...); err != nil {
+			return err
+		}
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+	update := func(state *bool, value bool, set frontend.SessionChange, unset frontend.SessionChange) {
+		if *state == value {
+			return
+		}
+		*state = value
+		if value {
+			frontend.SessionChanged(f.ctx, set)
+		} else {
+			frontend.SessionChanged(f.ctx, unset)
+		}
+	}
+	for {
+		select {
+		case <-f.ctx.Done():
+			return nil
+		case signal, ok := <-signals:
+			if !ok {
+				return nil
+			}
+			switch signal.Name {
+			case logindSessionInterface + ".Lock":
+				update(&locked, true, frontend.SessionLock, frontend.SessionUnlock)
+			case logindSessionInterface + ".Unlock":
+				update(&locked, false, frontend.SessionLock, frontend.SessionUnlock)
+			case "org.freedesktop.DBus.Properties.PropertiesChanged":
+				if len(signal.Body) < 2 {
+					continue
+				}
+				changed, _ := signal.Body[1].(map[string]dbus.Variant)
+				if value, ok := changed["LockedHint"].Value().(bool); ok {
+					update(&locked, value, frontend.SessionLock, frontend.SessionUnlock)
+				}
+				if value, ok := changed["Active"].Value().(bool); ok {
+					update(&active, value, frontend.SessionReconnect, frontend.SessionDisconnect)
+				}
+			}
+		}
+	}
+}
@@ -42,6 +42,9 @@ GtkBox *GTKBOX(void *pointer)
 }
 
 extern void processMessage(char *);
+extern void processNavigationProgress(double);
+extern void processFindResult(guint, char *);
+extern void processFullscreenChange(gboolean);
 
 static void sendMessageToBackend(WebKitUserContentManager *contentManager,
                                  WebKitJavascriptResult *result,
@@ -129,6 +132,47 @@ void SetWindowIcon(GtkWindow *window, const guchar *buf, gsize len)
     g_object_unref(loader);
 }
 
+// SetWindowCursor overrides the cursor of the window with the named cursor, NULL removes the override
+void SetWindowCursor(GtkWindow *window, char *name)
+{
+    GdkWindow *gdkWindow = gtk_widget_get_window(GTK_WIDGET(window));
+    if (gdkWindow == NULL)
+    {
+        return;
+    }
+    GdkCursor *cursor = NULL;
+    if (name != NULL)
+    {
+        cursor = gdk_cursor_new_from_name(gdk_window_get_display(gdkWindow), name);
+    }
+    gdk_window_set_cursor(gdkWindow, cursor);
+    if (cursor != NULL)
+    {
+        g_object_unref(cursor);
+    }
+}
+
+void SetWindowCursorImage(GtkWindow *window, const guchar *buf, gsize len, int hotspotX, int hotspotY)
+{
+    GdkWindow *gdkWindow = gtk_widget_get_window(GTK_WIDGET(window));
+    GdkPixbufLoader *loader = gdk_pixbuf_loader_new();
+    if (gdkWindow == NULL || !loader)
+    {
+        return;
+    }
+    if (gdk_pixbuf_loader_write(loader, buf, len, NULL) && gdk_pixbuf_loader_close(loader, NULL))
+    {
+        GdkPixbuf *pixbuf = gdk_pixbuf_loader_get_pixbuf(loader);
+        if (pixbuf)
+        {
+            GdkCursor *cursor = gdk_cursor_new_from_pixbuf(gdk_window_get_display(gdkWindow), pixbuf, hotspotX, hotspotY);
+            gdk_window_set_cursor(gdkWindow, cursor);
+            g_object_unref(cursor);
+        }
+    }
+    g_object_unref(loader);
+}
+
 void SetWindowTransparency(GtkWidget *widget)
 {
     GdkScreen *screen = gtk_widget_get_screen(widget);
@@ -246,6 +290,29 @@ void SetMinMaxSize(GtkWindow *window, int min_width, int min_height, int max_wid
     gtk_window_set_geometry_hints(window, NULL, &size, flags);
 }
 
+// SizeToContent resizes the window so the webview has the size in device pixels, clamped to the work area of the
+// current monitor. The window is larger than the webview by the menu bar.
+void SizeToContent(GtkWindow *window, void *webview, int width, int height)
+{
+    int scale = gtk_widget_get_scale_factor(GTK_WIDGET(webview));
+    GtkAllocation allocation;
+    gtk_widget_get_allocation(GTK_WIDGET(webview), &allocation);
+    int windowWidth, windowHeight;
+    gtk_window_get_size(window, &windowWidth, &windowHeight);
+    width = (width + scale - 1) / scale + windowWidth - allocation.width;
+    height = (height + scale - 1) / scale + windowHeight - allocation.height;
+
+    GdkMonitor *monitor = getCurrentMonitor(window);
+    if (monitor != NULL)
+    {
+        GdkRectangle workArea;
+        gdk_monitor_get_workarea(monitor, &workArea);
+        width = MIN(width, workArea.width);
+        height = MIN(height, workArea.height);
+    }
+    gtk_window_resize(window, width, height);
+}
+
 // function to disable the context menu but propagate the event
 static gboolean disableContextMenu(GtkWidget *widget, WebKitContextMenu *context_menu, GdkEvent *event, WebKitHitTestResult *hit_test_result, gpointer data)
 {
@@ -253,6 +320,376 @@ static gboolean disableContextMenu(GtkWidget *widget, WebKitContextMenu *context
     return TRUE;
 }
 
+static gboolean enterFullscreen(WebKitWebView *webview, gpointer disableAutoFullscreen)
+{
+    processFullscreenChange(TRUE);
+    // returning TRUE stops the webview from making the window fullscreen
+    return GPOINTER_TO_INT(disableAutoFullscreen);
+}
+
+static gboolean leaveFullscreen(WebKitWebView *webview, gpointer disableAutoFullscreen)
+{
+    processFullscreenChange(FALSE);
+    return GPOINTER_TO_INT(disableAutoFullscreen);
+}
+
+void ConnectFullscreen(void *webview, int disableAutoFullscreen)
+{
+    g_signal_connect(WEBKIT_WEB_VIEW(webview), "enter-fullscreen", G_CALLBACK(enterFullscreen), GINT_TO_POINTER(disableAutoFullscreen));
+    g_signal_connect(WEBKIT_WEB_VIEW(webview), "leave-fullscreen", G_CALLBACK(leaveFullscreen), GINT_TO_POINTER(disableAutoFullscreen));
+}
+
+static gboolean hideNavigationProgress(gpointer bar)
+{
+    // A new load may have started in the meantime
+    if (gtk_progress_bar_get_fraction(GTK_PROGRESS_BAR(bar)) >= 1.0)
+    {
+        gtk_widget_hide(GTK_WIDGET(bar));
+    }
+    return G_SOURCE_REMOVE;
+}
+
+static void navigationProgressChanged(WebKitWebView *webview, GParamSpec *pspec, gpointer bar)
+{
+    gdouble progress = webkit_web_view_get_estimated_load_progress(webview);
+    processNavigationProgress(progress);
+
+    if (bar == NULL)
+    {
+        return;
+    }
+    gtk_progress_bar_set_fraction(GTK_PROGRESS_BAR(bar), progress);
+    if (progress < 1.0)
+    {
+        gtk_widget_show(GTK_WIDGET(bar));
+    }
+    else
+    {
+        // The full bar is shown for a moment once the page has loaded
+        g_timeout_add(200, hideNavigationProgress, bar);
+    }
+}
+
+// ConnectNavigationProgress reports the estimated progress of the loads of the webview. If showBar is set, the
+// webview is placed in an overlay with a thin progress bar at its top and the overlay is returned, otherwise the
+// webview is returned.
+GtkWidget *ConnectNavigationProgress(void *webview, int showBar)
+{
+    if (!showBar)
+    {
+        g_signal_connect(WEBKIT_WEB_VIEW(webview), "notify::estimated-load-progress", G_CALLBACK(navigationProgressChanged), NULL);
+        return GTK_WIDGET(webview);
+    }
+
+    GtkWidget *bar = gtk_progress_bar_new();
+    gtk_widget_set_valign(bar, GTK_ALIGN_START);
+    // The bar is only shown while a page is loading
+    gtk_widget_set_no_show_all(bar, TRUE);
+    GtkCssProvider *provider = gtk_css_provider_new();
+    gtk_css_provider_load_from_data(provider,
+                                    "progressbar trough, progressbar progress { min-height: 3px; border: none; border-radius: 0; }"
+                                    "progressbar trough { background: transparent; }",
+                                    -1, NULL);
+    gtk_style_context_add_provider(gtk_widget_get_style_context(bar), GTK_STYLE_PROVIDER(provider), GTK_STYLE_PROVIDER_PRIORITY_USER);
+    g_object_unref(provider);
+
+    GtkWidget *overlay = gtk_overlay_new();
+    gtk_container_add(GTK_CONTAINER(overlay), GTK_WIDGET(webview));
+    gtk_overlay_add_overlay(GTK_OVERLAY(overlay), bar);
+    gtk_overlay_set_overlay_pass_through(GTK_OVERLAY(overlay), bar, TRUE);
+    g_signal_connect(WEBKIT_WEB_VIEW(webview), "notify::estimated-load-progress", G_CALLBACK(navigationProgressChanged), bar);
+    return overlay;
+}
+
+static void sendFindResult(WebKitFindController *controller, guint matchCount)
+{
+    const gchar *text = webkit_find_controller_get_search_text(controller);
+    processFindResult(matchCount, text == NULL ? "" : (char *)text);
+}
+
+static void foundText(WebKitFindController *controller, guint matchCount, gpointer data)
+{
+    sendFindResult(controller, matchCount);
+}
+
+static void failedToFindText(WebKitFindController *controller, gpointer data)
+{
+    sendFindResult(controller, 0);
+}
+
+void ConnectFind(void *webview)
+{
+    WebKitFindController *controller = webkit_web_view_get_find_controller(WEBKIT_WEB_VIEW(webview));
+    g_signal_connect(controller, "found-text", G_CALLBACK(foundText), NULL);
+    g_signal_connect(controller, "failed-to-find-text", G_CALLBACK(failedToFindText), NULL);
+}
+
+void FindInPage(void *webview, char *text, int caseSensitive, int backwards)
+{
+    WebKitFindController *controller = webkit_web_view_get_find_controller(WEBKIT_WEB_VIEW(webview));
+    guint32 options = WEBKIT_FIND_OPTIONS_WRAP_AROUND;
+    if (!caseSensitive)
+    {
+        options |= WEBKIT_FIND_OPTIONS_CASE_INSENSITIVE;
+    }
+
+    // Continue the current search to select the next match
+    guint32 currentOptions = webkit_find_controller_get_options(controller) & ~WEBKIT_FIND_OPTIONS_BACKWARDS;
+    if (g_strcmp0(webkit_find_controller_get_search_text(controller), text) == 0 && currentOptions == options)
+    {
+        if (backwards)
+        {
+            webkit_find_controller_search_previous(controller);
+        }
+        else
+        {
+            webkit_find_controller_search_next(controller);
+        }
+        return;
+    }
+
+    if (backwards)
+    {
+        options |= WEBKIT_FIND_OPTIONS_BACKWARDS;
+    }
+    webkit_find_controller_search(controller, text, options, G_MAXUINT);
+}
+
+void StopFind(void *webview)
+{
+    webkit_find_controller_search_finish(webkit_web_view_get_find_controller(WEBKIT_WEB_VIEW(webview)));
+}
+
+void extern processClearWebsiteDataResult(char *);
+
+static void clearWebsiteDataFinished(GObject *source, GAsyncResult *result, gpointer data)
+{
+    GError *error = NULL;
+    if (!webkit_website_data_manager_clear_finish(WEBKIT_WEBSITE_DATA_MANAGER(source), result, &error))
+    {
+        processClearWebsiteDataResult(error->message);
+        g_error_free(error);
+        return;
+    }
+    processClearWebsiteDataResult("");
+}
+
+void ClearWebsiteData(void *webview, int types, gint64 timespan)
+{
+    WebKitWebsiteDataManager *manager = webkit_web_view_get_website_data_manager(WEBKIT_WEB_VIEW(webview));
+    webkit_website_data_manager_clear(manager, types, timespan, NULL, clearWebsiteDataFinished, NULL);
+}
+
+void extern processPrintToPDFResult(char *);
+
+static void printToPDFFailed(WebKitPrintOperation *operation, GError *error, gpointer data)
+{
+    // The operation is finished after it has failed
+    g_object_set_data_full(G_OBJECT(operation), "error", g_strdup(error->message), g_free);
+}
+
+static void printToPDFFinished(WebKitPrintOperation *operation, gpointer data)
+{
+    char *error = g_object_get_data(G_OBJECT(operation), "error");
+    processPrintToPDFResult(error != NULL ? error : "");
+    g_object_unref(operation);
+}
+
+// PrintToPDF prints the page with the "Print to File" printer of GTK, which writes a PDF file to the path
+void PrintToPDF(void *webview, char *path, PrintToPDFSettings *settings)
+{
+    GError *error = NULL;
+    gchar *uri = g_filename_to_uri(path, NULL, &error);
+    if (uri == NULL)
+    {
+        processPrintToPDFResult(error->message);
+        g_error_free(error);
+        return;
+    }
+
+    GtkPrintSettings *printSettings = gtk_print_settings_new();
+    gtk_print_settings_set_printer(printSettings, "Print to File");
+    gtk_print_settings_set(printSettings, GTK_PRINT_SETTINGS_OUTPUT_FILE_FORMAT, "pdf");
+    gtk_print_settings_set(printSettings, GTK_PRINT_SETTINGS_OUTPUT_URI, uri);
+    if (settings->scale > 0)
+    {
+        gtk_print_settings_set_scale(printSettings, settings->scale * 100);
+    }
+    g_free(uri);
+
+    GtkPageSetup *pageSetup = gtk_page_setup_new();
+    gtk_page_setup_set_orientation(pageSetup, settings->landscape ? GTK_PAGE_ORIENTATION_LANDSCAPE : GTK_PAGE_ORIENTATION_PORTRAIT);
+    if (settings->pageWidth > 0 && settings->pageHeight > 0)
+    {
+        GtkPaperSize *paperSize = gtk_paper_size_new_custom("custom", "Custom", settings->pageWidth, settings->pageHeight, GTK_UNIT_INCH);
+        gtk_page_setup_set_paper_size(pageSetup, paperSize);
+        gtk_paper_size_free(paperSize);
+    }
+    if (settings->hasMargins)
+    {
+        gtk_page_setup_set_top_margin(pageSetup, settings->marginTop, GTK_UNIT_INCH);
+        gtk_page_setup_set_bottom_margin(pageSetup, settings->marginBottom, GTK_UNIT_INCH);
+        gtk_page_setup_set_left_margin(pageSetup, settings->marginLeft, GTK_UNIT_INCH);
+        gtk_page_setup_set_right_margin(pageSetup, settings->marginRight, GTK_UNIT_INCH);
+    }
+
+    webkit_settings_set_print_backgrounds(webkit_web_view_get_settings(WEBKIT_WEB_VIEW(webview)), settings->printBackgrounds);
+
+    WebKitPrintOperation *operation = webkit_print_operation_new(WEBKIT_WEB_VIEW(webview));
+    webkit_print_operation_set_print_settings(operation, printSettings);
+    webkit_print_operation_set_page_setup(operation, pageSetup);
+    g_signal_connect(operation, "failed", G_CALLBACK(printToPDFFailed), NULL);
+    g_signal_connect(operation, "finished", G_CALLBACK(printToPDFFinished), NULL);
+    webkit_print_operation_print(operation);
+    g_object_unref(printSettings);
+    g_object_unref(pageSetup);
+}
+
+void extern processSnapshotResult(void *, int, char *);
+
+static cairo_status_t appendSnapshotData(void *closure, const unsigned char *data, unsigned int length)
+{
+    g_byte_array_append((GByteArray *)closure, data, length);
+    return CAIRO_STATUS_SUCCESS;
+}
+
+static void snapshotFinished(GObject *source, GAsyncResult *result, gpointer data)
+{
+    GError *error = NULL;
+    cairo_surface_t *surface = webkit_web_view_get_snapshot_finish(WEBKIT_WEB_VIEW(source), result, &error);
+    if (surface == NULL)
+    {
+        processSnapshotResult(NULL, 0, error->message);
+        g_error_free(error);
+        return;
+    }
+    GByteArray *png = g_byte_array_new();
+    cairo_status_t status = cairo_surface_write_to_png_stream(surface, appendSnapshotData, png);
+    cairo_surface_destroy(surface);
+    if (status != CAIRO_STATUS_SUCCESS)
+    {
+        processSnapshotResult(NULL, 0, (char *)cairo_status_to_string(status));
+    }
+    else
+    {
+        processSnapshotResult(png->data, png->len, "");
+    }
+    g_byte_array_free(png, TRUE);
+}
+
+// CaptureSnapshot sends the visible content of the webview as PNG to processSnapshotResult
+void CaptureSnapshot(void *webview)
+{
+    webkit_web_view_get_snapshot(WEBKIT_WEB_VIEW(webview), WEBKIT_SNAPSHOT_REGION_VISIBLE, WEBKIT_SNAPSHOT_OPTIONS_NONE, NULL, snapshotFinished, NULL);
+}
+
+void extern processScriptResult(char *, char *);
+
+static void scriptFinished(GObject *source, GAsyncResult *result, gpointer data)
+{
+    GError *error = NULL;
+    WebKitJavascriptResult *js = webkit_web_view_run_javascript_finish(WEBKIT_WEB_VIEW(source), result, &error);
+    if (js == NULL)
+    {
+        processScriptResult(NULL, error->message);
+        g_error_free(error);
+        return;
+    }
+    JSCValue *value = webkit_javascript_result_get_js_value(js);
+    if (jsc_value_is_string(value))
+    {
+        gchar *text = jsc_value_to_string(value);
+        processScriptResult(text, "");
+        g_free(text);
+    }
+    else
+    {
+        processScriptResult(NULL, "the script didn't result in a string");
+    }
+    webkit_javascript_result_unref(js);
+}
+
+// EvaluateScript evaluates a script which results in a string and sends the string to processScriptResult
+void EvaluateScript(void *webview, char *script)
+{
+    webkit_web_view_run_javascript(WEBKIT_WEB_VIEW(webview), script, NULL, scriptFinished, NULL);
+}
+
+void SetUserAgent(void *webview, char *userAgent)
+{
+    WebKitSettings *settings = webkit_web_view_get_settings(WEBKIT_WEB_VIEW(webview));
+    // An empty user agent restores the default, which is set up like in SetupWebview
+    if (strlen(userAgent) == 0)
+    {
+        webkit_settings_set_user_agent_with_application_details(settings, "wails.io", "");
+        return;
+    }
+    webkit_settings_set_user_agent(settings, userAgent);
+}
+
+static gboolean blockCopyShortcut(GtkWidget *widget, GdkEventKey *event, gpointer data)
+{
+    guint modifiers = event->state & gtk_accelerator_get_default_mod_mask();
+    guint key = gdk_keyval_to_lower(event->keyval);
+    if (modifiers == GDK_CONTROL_MASK && (key == GDK_KEY_c || key == GDK_KEY_x || key == GDK_KEY_Insert))
+    {
+        return TRUE;
+    }
+    if (modifiers == GDK_SHIFT_MASK && key == GDK_KEY_Delete)
+    {
+        return TRUE;
+    }
+    return FALSE;
+}
+
+static gboolean removeCopyItems(WebKitWebView *webview, WebKitContextMenu *menu, GdkEvent *event, WebKitHitTestResult *hit_test_result, gpointer data)
+{
+    GList *items = g_list_copy(webkit_context_menu_get_items(menu));
+    for (GList *item = items; item != NULL; item = item->next)
+    {
+        WebKitContextMenuAction action = webkit_context_menu_item_get_stock_action((WebKitContextMenuItem *)item->data);
+        if (action == WEBKIT_CONTEXT_MENU_ACTION_COPY || action == WEBKIT_CONTEXT_MENU_ACTION_CUT ||
+            action == WEBKIT_CONTEXT_MENU_ACTION_COPY_LINK_TO_CLIPBOARD || action == WEBKIT_CONTEXT_MENU_ACTION_COPY_IMAGE_TO_CLIPBOARD ||
+            action == WEBKIT_CONTEXT_MENU_ACTION_COPY_IMAGE_URL_TO_CLIPBOARD)
+        {
+            webkit_context_menu_remove(menu, (WebKitContextMenuItem *)item->data);
+        }
+    }
+    g_list_free(items);
+    return FALSE;
+}
+
+// SetupTextSelection adds the script to every frame before the scripts of the page are run. The copy shortcuts are
+// handled before the webview receives them, and the copy items are removed from the context menu.
+void SetupTextSelection(void *contentManager, void *webview, const char *script, int disableCopy)
+{
+    WebKitUserScript *userScript = webkit_user_script_new(script, WEBKIT_USER_CONTENT_INJECT_ALL_FRAMES,
+                                                          WEBKIT_USER_SCRIPT_INJECT_AT_DOCUMENT_START, NULL, NULL);
+    webkit_user_content_manager_add_script((WebKitUserContentManager *)contentManager, userScript);
+    webkit_user_script_unref(userScript);
+    if (disableCopy)
+    {
+        g_signal_connect(WEBKIT_WEB_VIEW(webview), "key-press-event", G_CALLBACK(blockCopyShortcut), NULL);
+        g_signal_connect(WEBKIT_WEB_VIEW(webview), "context-menu", G_CALLBACK(removeCopyItems), NULL);
+    }
+}
+
+// AddPreloadScript adds the script to every frame before the scripts of the page are run, the returned user script
+// removes it
+void *AddPreloadScript(void *contentManager, const char *script)
+{
+    WebKitUserScript *userScript = webkit_user_script_new(script, WEBKIT_USER_CONTENT_INJECT_ALL_FRAMES,
+                                                          WEBKIT_USER_SCRIPT_INJECT_AT_DOCUMENT_START, NULL, NULL);
+    webkit_user_content_manager_add_script((WebKitUserContentManager *)contentManager, userScript);
+    return userScript;
+}
+
+void RemovePreloadScript(void *contentManager, void *userScript)
+{
+    webkit_user_content_manager_remove_script((WebKitUserContentManager *)contentManager, (WebKitUserScript *)userScript);
+    webkit_user_script_unref((WebKitUserScript *)userScript);
+}
+
 void DisableContextMenu(void *webview)
 {
     // Disable the context menu but propagate the event
@@ -299,6 +736,26 @@ void ConnectButtons(void *webview)
     g_signal_connect(WEBKIT_WEB_VIEW(webview), "button-release-event", G_CALLBACK(buttonRelease), NULL);
 }
 
+const char *GetDisplayServer()
+{
+    GdkDisplay *display = gdk_display_get_default();
+    if (display == NULL)
+    {
+        return "";
+    }
+    // Avoid depending on gdkwayland.h/gdkx.h which are not available in every GTK build
+    const char *displayType = G_OBJECT_TYPE_NAME(display);
+    if (strcmp(displayType, "GdkWaylandDisplay") == 0)
+    {
+        return "wayland";
+    }
+    if (strcmp(displayType, "GdkX11Display") == 0)
+    {
+        return "x11";
+    }
+    return "";
+}
+
 int IsFullscreen(GtkWidget *widget)
 {
     GdkWindow *gdkwindow = gtk_widget_get_window(widget);
@@ -379,6 +836,18 @@ gboolean Minimise(gpointer data)
     return G_SOURCE_REMOVE;
 }
 
+// Focus presents the window with the time of the current event. When there is none, GTK uses the time of the last
+// user interaction on X11, so the focus stealing prevention of the window manager still applies.
+gboolean Focus(gpointer data)
+{
+    GtkWindow *window = (GtkWindow *)data;
+    gtk_widget_show(GTK_WIDGET(window));
+    gtk_window_deiconify(window);
+    gtk_window_present_with_time(window, gtk_get_current_event_time());
+
+    return G_SOURCE_REMOVE;
+}
+
 gboolean UnMinimise(gpointer data)
 {
     gtk_window_present((GtkWindow *)data);
@@ -404,6 +873,35 @@ gboolean Fullscreen(gpointer data)
     return G_SOURCE_REMOVE;
 }
 
+static gboolean borderlessFullscreen(gpointer data)
+{
+    BorderlessFullscreenArgs *args = (BorderlessFullscreenArgs *)data;
+    GtkWindow *window = (GtkWindow *)args->window;
+    GdkMonitor *monitor = gdk_display_get_monitor(gtk_widget_get_display(GTK_WIDGET(window)), args->monitor);
+    if (monitor != NULL)
+    {
+        GdkRectangle m;
+        gdk_monitor_get_geometry(monitor, &m);
+        int scale = gdk_monitor_get_scale_factor(monitor);
+        SetMinMaxSize(window, 0, 0, m.width * scale, m.height * scale);
+
+        // Keeping the window above the others stops the panels being drawn over it
+        gtk_window_set_keep_above(window, TRUE);
+        gtk_window_fullscreen_on_monitor(window, gtk_window_get_screen(window), args->monitor);
+    }
+    free(args);
+
+    return G_SOURCE_REMOVE;
+}
+
+void BorderlessFullscreen(void *window, int monitor)
+{
+    BorderlessFullscreenArgs *args = malloc(sizeof(BorderlessFullscreenArgs));
+    args->window = window;
+    args->monitor = monitor;
+    ExecuteOnMainThread(borderlessFullscreen, (gpointer)args);
+}
+
 gboolean UnFullscreen(gpointer data)
 {
     gtk_window_unfullscreen((GtkWindow *)data);
@@ -496,6 +994,17 @@ static gboolean onDragDrop(GtkWidget* self, GdkDragContext* context, gint x, gin
     return FALSE;
 }
 
+// RegisterCustomScheme passes the requests of the scheme to processURLRequest like the requests of the assets. The
+// scheme is secure and allows CORS, so the pages of the assets can fetch it.
+void RegisterCustomScheme(const char *scheme)
+{
+    WebKitWebContext *context = webkit_web_context_get_default();
+    webkit_web_context_register_uri_scheme(context, scheme, (WebKitURISchemeRequestCallback)processURLRequest, NULL, NULL);
+    WebKitSecurityManager *securityManager = webkit_web_context_get_security_manager(context);
+    webkit_security_manager_register_uri_scheme_as_secure(securityManager, scheme);
+    webkit_security_manager_register_uri_scheme_as_cors_enabled(securityManager, scheme);
+}
+
 // WebView
 GtkWidget *SetupWebview(void *contentManager, GtkWindow *window, int hideWindowOnClose, int gpuPolicy, int disableWebViewDragAndDrop, int enableDragAndDrop)
 {
@@ -684,6 +1193,226 @@ void MessageDialog(void *data)
     free(options->message);
 }
 
+// DateTimePickerDialog shows a dialog with a calendar and spin buttons for the time. The selected date and time are
+// stored in year, month (1-12), day, hour and minute, which also hold the initial values.
+gboolean DateTimePickerDialog(GtkWindow *window, char *title, int showDate, int showTime, int *year, int *month, int *day, int *hour, int *minute)
+{
+    GtkWidget *dialog = gtk_dialog_new_with_buttons(title, window,
+                                                    GTK_DIALOG_MODAL | GTK_DIALOG_DESTROY_WITH_PARENT,
+                                                    "_Cancel", GTK_RESPONSE_CANCEL,
+                                                    "_OK", GTK_RESPONSE_OK,
+                                                    NULL);
+    gtk_dialog_set_default_response(GTK_DIALOG(dialog), GTK_RESPONSE_OK);
+    GtkWidget *content = gtk_dialog_get_content_area(GTK_DIALOG(dialog));
+    gtk_container_set_border_width(GTK_CONTAINER(content), 12);
+    gtk_box_set_spacing(GTK_BOX(content), 12);
+
+    GtkWidget *calendar = NULL;
+    if (showDate)
+    {
+        calendar = gtk_calendar_new();
+        gtk_calendar_select_month(GTK_CALENDAR(calendar), *month - 1, *year);
+        gtk_calendar_select_day(GTK_CALENDAR(calendar), *day);
+        gtk_box_pack_start(GTK_BOX(content), calendar, TRUE, TRUE, 0);
+    }
+
+    GtkWidget *hourButton = NULL;
+    GtkWidget *minuteButton = NULL;
+    if (showTime)
+    {
+        GtkWidget *timeBox = gtk_box_new(GTK_ORIENTATION_HORIZONTAL, 6);
+        hourButton = gtk_spin_button_new_with_range(0, 23, 1);
+        minuteButton = gtk_spin_button_new_with_range(0, 59, 1);
+        gtk_spin_button_set_wrap(GTK_SPIN_BUTTON(hourButton), TRUE);
+        gtk_spin_button_set_wrap(GTK_SPIN_BUTTON(minuteButton), TRUE);
+        gtk_spin_button_set_value(GTK_SPIN_BUTTON(hourButton), *hour);
+        gtk_spin_button_set_value(GTK_SPIN_BUTTON(minuteButton), *minute);
+        gtk_entry_set_activates_default(GTK_ENTRY(hourButton), TRUE);
+        gtk_entry_set_activates_default(GTK_ENTRY(minuteButton), TRUE);
+        gtk_box_pack_start(GTK_BOX(timeBox), hourButton, FALSE, FALSE, 0);
+        gtk_box_pack_start(GTK_BOX(timeBox), gtk_label_new(":"), FALSE, FALSE, 0);
+        gtk_box_pack_start(GTK_BOX(timeBox), minuteButton, FALSE, FALSE, 0);
+        gtk_widget_set_halign(timeBox, GTK_ALIGN_CENTER);
+        gtk_box_pack_start(GTK_BOX(content), timeBox, FALSE, FALSE, 0);
+    }
+
+    gtk_widget_show_all(dialog);
+    gboolean ok = gtk_dialog_run(GTK_DIALOG(dialog)) == GTK_RESPONSE_OK;
+    if (ok)
+    {
+        if (calendar != NULL)
+        {
+            guint y, m, d;
+            gtk_calendar_get_date(GTK_CALENDAR(calendar), &y, &m, &d);
+            *year = y;
+            *month = m + 1;
+            *day = d;
+        }
+        if (hourButton != NULL)
+        {
+            *hour = gtk_spin_button_get_value_as_int(GTK_SPIN_BUTTON(hourButton));
+            *minute = gtk_spin_button_get_value_as_int(GTK_SPIN_BUTTON(minuteButton));
+        }
+    }
+    gtk_widget_destroy(dialog);
+    return ok;
+}
+
+// InputDialog returns the value of the entry, which must be freed with g_free, or NULL if the dialog is cancelled
+char *InputDialog(GtkWindow *window, char *title, char *message, char *defaultValue, char *placeholder, int password)
+{
+    GtkWidget *dialog = gtk_dialog_new_with_buttons(title, window,
+                                                    GTK_DIALOG_MODAL | GTK_DIALOG_DESTROY_WITH_PARENT,
+                                                    "_Cancel", GTK_RESPONSE_CANCEL,
+                                                    "_OK", GTK_RESPONSE_OK,
+                                                    NULL);
+    gtk_dialog_set_default_response(GTK_DIALOG(dialog), GTK_RESPONSE_OK);
+    GtkWidget *content = gtk_dialog_get_content_area(GTK_DIALOG(dialog));
+    gtk_container_set_border_width(GTK_CONTAINER(content), 12);
+    gtk_box_set_spacing(GTK_BOX(content), 12);
+
+    if (strlen(message) > 0)
+    {
+        GtkWidget *label = gtk_label_new(message);
+        gtk_label_set_line_wrap(GTK_LABEL(label), TRUE);
+        gtk_label_set_max_width_chars(GTK_LABEL(label), 50);
+        gtk_label_set_xalign(GTK_LABEL(label), 0);
+        gtk_box_pack_start(GTK_BOX(content), label, FALSE, FALSE, 0);
+    }
+
+    GtkWidget *entry = gtk_entry_new();
+    gtk_entry_set_text(GTK_ENTRY(entry), defaultValue);
+    gtk_entry_set_placeholder_text(GTK_ENTRY(entry), placeholder);
+    gtk_entry_set_activates_default(GTK_ENTRY(entry), TRUE);
+    if (password)
+    {
+        gtk_entry_set_visibility(GTK_ENTRY(entry), FALSE);
+        gtk_entry_set_input_purpose(GTK_ENTRY(entry), GTK_INPUT_PURPOSE_PASSWORD);
+    }
+    gtk_entry_set_width_chars(GTK_ENTRY(entry), 40);
+    gtk_box_pack_start(GTK_BOX(content), entry, FALSE, FALSE, 0);
+
+    gtk_widget_show_all(dialog);
+    char *result = NULL;
+    if (gtk_dialog_run(GTK_DIALOG(dialog)) == GTK_RESPONSE_OK)
+    {
+        result = g_strdup(gtk_entry_get_text(GTK_ENTRY(entry)));
+    }
+    gtk_widget_destroy(dialog);
+    return result;
+}
+
+static void selectDialogToggled(GtkCellRendererToggle *renderer, gchar *path, gpointer store)
+{
+    GtkTreeIter iter;
+    if (gtk_tree_model_get_iter_from_string(GTK_TREE_MODEL(store), &iter, path))
+    {
+        gboolean checked;
+        gtk_tree_model_get(GTK_TREE_MODEL(store), &iter, 0, &checked, -1);
+        gtk_list_store_set(GTK_LIST_STORE(store), &iter, 0, !checked, -1);
+    }
+}
+
+// selectDialogRowActivated toggles the row in multiple selection mode and picks it otherwise
+static void selectDialogRowActivated(GtkTreeView *view, GtkTreePath *path, GtkTreeViewColumn *column, gpointer dialog)
+{
+    if (g_object_get_data(G_OBJECT(view), "multiple") != NULL)
+    {
+        gchar *row = gtk_tree_path_to_string(path);
+        selectDialogToggled(NULL, row, gtk_tree_view_get_model(view));
+        g_free(row);
+        return;
+    }
+    gtk_dialog_response(GTK_DIALOG(dialog), GTK_RESPONSE_OK);
+}
+
+// SelectDialog shows the items in a list, with check boxes in multiple selection mode. The selected flags are the
+// initial selection and are updated with the selection of the user.
+gboolean SelectDialog(GtkWindow *window, char *title, char *message, char **items, int *selected, int count, int multiple)
+{
+    GtkWidget *dialog = gtk_dialog_new_with_buttons(title, window,
+                                                    GTK_DIALOG_MODAL | GTK_DIALOG_DESTROY_WITH_PARENT,
+                                                    "_Cancel", GTK_RESPONSE_CANCEL,
+                                                    "_OK", GTK_RESPONSE_OK,
+                                                    NULL);
+    gtk_dialog_set_default_response(GTK_DIALOG(dialog), GTK_RESPONSE_OK);
+    GtkWidget *content = gtk_dialog_get_content_area(GTK_DIALOG(dialog));
+    gtk_container_set_border_width(GTK_CONTAINER(content), 12);
+    gtk_box_set_spacing(GTK_BOX(content), 12);
+
+    if (strlen(message) > 0)
+    {
+        GtkWidget *label = gtk_label_new(message);
+        gtk_label_set_line_wrap(GTK_LABEL(label), TRUE);
+        gtk_label_set_max_width_chars(GTK_LABEL(label), 50);
+        gtk_label_set_xalign(GTK_LABEL(label), 0);
+        gtk_box_pack_start(GTK_BOX(content), label, FALSE, FALSE, 0);
+    }
+
+    GtkListStore *store = gtk_list_store_new(2, G_TYPE_BOOLEAN, G_TYPE_STRING);
+    GtkTreeIter iter;
+    for (int i = 0; i < count; i++)
+    {
+        gtk_list_store_append(store, &iter);
+        gtk_list_store_set(store, &iter, 0, multiple && selected[i], 1, items[i], -1);
+    }
+
+    GtkWidget *view = gtk_tree_view_new_with_model(GTK_TREE_MODEL(store));
+    gtk_tree_view_set_headers_visible(GTK_TREE_VIEW(view), FALSE);
+    GtkTreeSelection *selection = gtk_tree_view_get_selection(GTK_TREE_VIEW(view));
+    if (multiple)
+    {
+        g_object_set_data(G_OBJECT(view), "multiple", GINT_TO_POINTER(1));
+        GtkCellRenderer *toggle = gtk_cell_renderer_toggle_new();
+        g_signal_connect(toggle, "toggled", G_CALLBACK(selectDialogToggled), store);
+        gtk_tree_view_insert_column_with_attributes(GTK_TREE_VIEW(view), -1, NULL, toggle, "active", 0, NULL);
+    }
+    else
+    {
+        gtk_tree_selection_set_mode(selection, GTK_SELECTION_BROWSE);
+    }
+    gtk_tree_view_insert_column_with_attributes(GTK_TREE_VIEW(view), -1, NULL, gtk_cell_renderer_text_new(), "text", 1, NULL);
+    g_signal_connect(view, "row-activated", G_CALLBACK(selectDialogRowActivated), dialog);
+
+    GtkWidget *scrolled = gtk_scrolled_window_new(NULL, NULL);
+    gtk_scrolled_window_set_policy(GTK_SCROLLED_WINDOW(scrolled), GTK_POLICY_NEVER, GTK_POLICY_AUTOMATIC);
+    gtk_scrolled_window_set_shadow_type(GTK_SCROLLED_WINDOW(scrolled), GTK_SHADOW_IN);
+    gtk_scrolled_window_set_min_content_width(GTK_SCROLLED_WINDOW(scrolled), 320);
+    gtk_scrolled_window_set_min_content_height(GTK_SCROLLED_WINDOW(scrolled), 200);
+    gtk_container_add(GTK_CONTAINER(scrolled), view);
+    gtk_box_pack_start(GTK_BOX(content), scrolled, TRUE, TRUE, 0);
+
+    gtk_widget_show_all(dialog);
+    for (int i = 0; i < count; i++)
+    {
+        if (!multiple && selected[i])
+        {
+            GtkTreePath *path = gtk_tree_path_new_from_indices(i, -1);
+            gtk_tree_view_set_cursor(GTK_TREE_VIEW(view), path, NULL, FALSE);
+            gtk_tree_view_scroll_to_cell(GTK_TREE_VIEW(view), path, NULL, FALSE, 0, 0);
+            gtk_tree_path_free(path);
+            break;
+        }
+    }
+    gtk_widget_grab_focus(view);
+
+    gboolean ok = gtk_dialog_run(GTK_DIALOG(dialog)) == GTK_RESPONSE_OK;
+    if (ok)
+    {
+        gboolean valid = gtk_tree_model_get_iter_first(GTK_TREE_MODEL(store), &iter);
+        for (int i = 0; i < count && valid; i++)
+        {
+            gboolean checked;
+            gtk_tree_model_get(GTK_TREE_MODEL(store), &iter, 0, &checked, -1);
+            selected[i] = multiple ? checked : gtk_tree_selection_iter_is_selected(selection, &iter);
+            valid = gtk_tree_model_iter_next(GTK_TREE_MODEL(store), &iter);
+        }
+    }
+    gtk_widget_destroy(dialog);
+    g_object_unref(store);
+    return ok;
+}
+
 void extern processOpenFileResult(void *);
 
 GtkFileFilter **AllocFileFilterArray(size_t ln)
@@ -816,6 +1545,11 @@ void ShowInspector(void *webview) {
     webkit_web_inspector_show(WEBKIT_WEB_INSPECTOR(inspector));
 }
 
+void CloseInspector(void *webview) {
+    WebKitWebInspector *inspector = webkit_web_view_get_inspector(WEBKIT_WEB_VIEW(webview));
+    webkit_web_inspector_close(WEBKIT_WEB_INSPECTOR(inspector));
+}
+
 void sendShowInspectorMessage() {
     processMessage("wails:showInspector");
 }
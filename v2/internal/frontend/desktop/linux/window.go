@@ -20,8 +20,12 @@ package linux
 import "C"
 import (
 	"log"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/wailsapp/wails/v2/internal/frontend"
@@ -44,12 +48,19 @@ type Window struct {
 	gtkWindow                                unsafe.Pointer
 	contentManager                           unsafe.Pointer
 	webview                                  unsafe.Pointer
+	webviewContent                           *C.GtkWidget
 	applicationMenu                          *menu.Menu
 	menubar                                  *C.GtkWidget
 	webviewBox                               *C.GtkWidget
 	vbox                                     *C.GtkWidget
 	accels                                   *C.GtkAccelGroup
 	minWidth, minHeight, maxWidth, maxHeight int
+	// keepAbove is the keep above state to restore when leaving a borderless fullscreen
+	keepAbove            bool
+	borderlessFullscreen bool
+	// preloadScripts are the user scripts added by AddPreloadScript by their id, they are only used on the main thread
+	preloadScripts  map[string]unsafe.Pointer
+	preloadScriptID atomic.Uint64
 }
 
 func bool2Cint(value bool) C.int {
@@ -107,9 +118,17 @@ func NewWindow(appoptions *options.App, debug bool, devtoolsEnabled bool) *Windo
 		bool2Cint(appoptions.DragAndDrop != nil && appoptions.DragAndDrop.EnableFileDrop),
 	)
 	result.webview = unsafe.Pointer(webview)
+	for scheme := range appoptions.CustomSchemes {
+		cScheme := C.CString(scheme)
+		C.RegisterCustomScheme(cScheme)
+		C.free(unsafe.Pointer(cScheme))
+	}
 	buttonPressedName := C.CString("button-press-event")
 	defer C.free(unsafe.Pointer(buttonPressedName))
 	C.ConnectButtons(unsafe.Pointer(webview))
+	C.ConnectFullscreen(unsafe.Pointer(webview), bool2Cint(appoptions.DisableAutoFullscreen))
+	C.ConnectFind(unsafe.Pointer(webview))
+	result.webviewContent = C.ConnectNavigationProgress(unsafe.Pointer(webview), bool2Cint(appoptions.ShowNavigationProgress))
 
 	if devtoolsEnabled {
 		C.DevtoolsEnabled(unsafe.Pointer(webview), C.int(1), C.bool(debug && appoptions.Debug.OpenInspectorOnStartup))
@@ -121,12 +140,21 @@ func NewWindow(appoptions *options.App, debug bool, devtoolsEnabled bool) *Windo
 		C.DisableContextMenu(unsafe.Pointer(webview))
 	}
 
+	if appoptions.DisableWebviewTextSelection || appoptions.DisableWebviewCopy {
+		script := C.CString(frontend.TextSelectionScript(appoptions.DisableWebviewTextSelection, appoptions.DisableWebviewCopy))
+		C.SetupTextSelection(result.contentManager, unsafe.Pointer(webview), script, bool2Cint(appoptions.DisableWebviewCopy))
+		C.free(unsafe.Pointer(script))
+	}
+
 	// Set background colour
 	RGBA := appoptions.BackgroundColour
 	result.SetBackgroundColour(RGBA.R, RGBA.G, RGBA.B, RGBA.A)
 
 	// Setup window
 	result.SetKeepAbove(appoptions.AlwaysOnTop)
+	if appoptions.WindowLevel != options.WindowLevelNormal {
+		result.SetLevel(int(appoptions.WindowLevel))
+	}
 	result.SetResizable(!appoptions.DisableResize)
 	result.SetDefaultSize(appoptions.Width, appoptions.Height)
 	result.SetDecorated(!appoptions.Frameless)
@@ -168,11 +196,20 @@ func (w *Window) Fullscreen() {
 	C.ExecuteOnMainThread(C.Fullscreen, C.gpointer(w.asGTKWindow()))
 }
 
+func (w *Window) BorderlessFullscreen(monitor int) {
+	w.borderlessFullscreen = true
+	C.BorderlessFullscreen(unsafe.Pointer(w.asGTKWindow()), C.int(monitor))
+}
+
 func (w *Window) UnFullscreen() {
 	if !w.IsFullScreen() {
 		return
 	}
 	C.ExecuteOnMainThread(C.UnFullscreen, C.gpointer(w.asGTKWindow()))
+	if w.borderlessFullscreen {
+		w.borderlessFullscreen = false
+		C.gtk_window_set_keep_above(w.asGTKWindow(), gtkBool(w.keepAbove))
+	}
 	w.SetMinSize(w.minWidth, w.minHeight)
 	w.SetMaxSize(w.maxWidth, w.maxHeight)
 }
@@ -237,9 +274,17 @@ func (w *Window) SetMinSize(minWidth int, minHeight int) {
 }
 
 func (w *Window) Show() {
+	if w.appoptions.FocusOnShow {
+		w.Focus()
+		return
+	}
 	C.ExecuteOnMainThread(C.Show, C.gpointer(w.asGTKWindow()))
 }
 
+func (w *Window) Focus() {
+	C.ExecuteOnMainThread(C.Focus, C.gpointer(w.asGTKWindow()))
+}
+
 func (w *Window) Hide() {
 	C.ExecuteOnMainThread(C.Hide, C.gpointer(w.asGTKWindow()))
 }
@@ -307,12 +352,31 @@ func (w *Window) SetWindowIcon(icon []byte) {
 	C.SetWindowIcon(w.asGTKWindow(), (*C.guchar)(&icon[0]), (C.gsize)(len(icon)))
 }
 
+// SetCursor overrides the cursor of the window with the named cursor, an empty name removes the override
+func (w *Window) SetCursor(cursor string) {
+	invokeOnMainThread(func() {
+		if cursor == "" {
+			C.SetWindowCursor(w.asGTKWindow(), nil)
+			return
+		}
+		name := C.CString(cursor)
+		defer C.free(unsafe.Pointer(name))
+		C.SetWindowCursor(w.asGTKWindow(), name)
+	})
+}
+
+func (w *Window) SetCursorImage(image []byte, hotspotX int, hotspotY int) {
+	invokeOnMainThread(func() {
+		C.SetWindowCursorImage(w.asGTKWindow(), (*C.guchar)(&image[0]), (C.gsize)(len(image)), C.int(hotspotX), C.int(hotspotY))
+	})
+}
+
 func (w *Window) Run(url string) {
 	if w.menubar != nil {
 		C.gtk_box_pack_start(C.GTKBOX(unsafe.Pointer(w.vbox)), w.menubar, 0, 0, 0)
 	}
 
-	C.gtk_box_pack_start(C.GTKBOX(unsafe.Pointer(w.webviewBox)), C.GTKWIDGET(w.webview), 1, 1, 0)
+	C.gtk_box_pack_start(C.GTKBOX(unsafe.Pointer(w.webviewBox)), w.webviewContent, 1, 1, 0)
 	C.gtk_box_pack_start(C.GTKBOX(unsafe.Pointer(w.vbox)), w.webviewBox, 1, 1, 0)
 	_url := C.CString(url)
 	C.LoadIndex(w.webview, _url)
@@ -330,12 +394,51 @@ func (w *Window) Run(url string) {
 	case options.Maximised:
 		w.Maximise()
 	}
+	if w.appoptions.StartActivated && !w.appoptions.StartHidden && w.appoptions.WindowStartState != options.Minimised {
+		w.Focus()
+	}
 }
 
 func (w *Window) SetKeepAbove(top bool) {
+	w.keepAbove = top
 	C.gtk_window_set_keep_above(w.asGTKWindow(), gtkBool(top))
 }
 
+// SetOpacity sets the opacity of the window and returns the previous opacity, it requires a compositing window manager
+func (w *Window) SetOpacity(opacity float64) float64 {
+	var previous float64
+	var wg sync.WaitGroup
+	wg.Add(1)
+	invokeOnMainThread(func() {
+		previous = float64(C.gtk_widget_get_opacity(w.asGTKWidget()))
+		C.gtk_widget_set_opacity(w.asGTKWidget(), C.double(opacity))
+		wg.Done()
+	})
+	wg.Wait()
+	return previous
+}
+
+func (w *Window) SetSkipTaskbar(skip bool) {
+	invokeOnMainThread(func() {
+		C.gtk_window_set_skip_taskbar_hint(w.asGTKWindow(), gtkBool(skip))
+		C.gtk_window_set_skip_pager_hint(w.asGTKWindow(), gtkBool(skip))
+	})
+}
+
+func (w *Window) SetKeepBelow(bottom bool) {
+	invokeOnMainThread(func() {
+		C.gtk_window_set_keep_below(w.asGTKWindow(), gtkBool(bottom))
+	})
+}
+
+func (w *Window) SetLevel(level int) {
+	w.keepAbove = level > 0
+	invokeOnMainThread(func() {
+		C.gtk_window_set_keep_above(w.asGTKWindow(), gtkBool(level > 0))
+		C.gtk_window_set_keep_below(w.asGTKWindow(), gtkBool(level < 0))
+	})
+}
+
 func (w *Window) SetResizable(resizable bool) {
 	C.gtk_window_set_resizable(w.asGTKWindow(), gtkBool(resizable))
 }
@@ -348,6 +451,17 @@ func (w *Window) SetSize(width int, height int) {
 	C.gtk_window_resize(w.asGTKWindow(), C.gint(width), C.gint(height))
 }
 
+// SizeToContent resizes the window so the webview has the size in device pixels. Maximised and fullscreen windows
+// aren't resized.
+func (w *Window) SizeToContent(width int, height int) {
+	invokeOnMainThread(func() {
+		if w.IsMaximised() || w.IsFullScreen() {
+			return
+		}
+		C.SizeToContent(w.asGTKWindow(), w.webview, C.int(width), C.int(height))
+	})
+}
+
 func (w *Window) SetDecorated(frameless bool) {
 	C.gtk_window_set_decorated(w.asGTKWindow(), gtkBool(frameless))
 }
@@ -450,6 +564,100 @@ func (w *Window) MessageDialog(dialogOptions frontend.MessageDialogOptions) {
 	invokeOnMainThread(func() { C.MessageDialog(unsafe.Pointer(&data)) })
 }
 
+// DateTimePickerDialog shows the date time picker dialog and blocks until it is closed
+func (w *Window) DateTimePickerDialog(options frontend.DateTimePickerOptions) (time.Time, bool) {
+	type pickerResult struct {
+		value time.Time
+		ok    bool
+	}
+	results := make(chan pickerResult, 1)
+	invokeOnMainThread(func() {
+		title := C.CString(options.Title)
+		defer C.free(unsafe.Pointer(title))
+		year, month, day := C.int(options.Default.Year()), C.int(options.Default.Month()), C.int(options.Default.Day())
+		hour, minute := C.int(options.Default.Hour()), C.int(options.Default.Minute())
+		ok := C.DateTimePickerDialog(w.asGTKWindow(), title,
+			bool2Cint(options.Mode != frontend.DateTimePickerTime), bool2Cint(options.Mode != frontend.DateTimePickerDate),
+			&year, &month, &day, &hour, &minute)
+		results <- pickerResult{
+			value: time.Date(int(year), time.Month(month), int(day), int(hour), int(minute), 0, 0, time.Local),
+			ok:    ok == C.TRUE,
+		}
+	})
+	result := <-results
+	return result.value, result.ok
+}
+
+// InputDialog shows the input dialog and blocks until it is closed
+func (w *Window) InputDialog(options frontend.InputDialogOptions) (string, bool) {
+	type inputResult struct {
+		value string
+		ok    bool
+	}
+	results := make(chan inputResult, 1)
+	invokeOnMainThread(func() {
+		title := C.CString(options.Title)
+		defer C.free(unsafe.Pointer(title))
+		message := C.CString(options.Message)
+		defer C.free(unsafe.Pointer(message))
+		defaultValue := C.CString(options.Default)
+		defer C.free(unsafe.Pointer(defaultValue))
+		placeholder := C.CString(options.Placeholder)
+		defer C.free(unsafe.Pointer(placeholder))
+		value := C.InputDialog(w.asGTKWindow(), title, message, defaultValue, placeholder, bool2Cint(options.Password))
+		if value == nil {
+			results <- inputResult{}
+			return
+		}
+		defer C.g_free(C.gpointer(value))
+		results <- inputResult{value: C.GoString(value), ok: true}
+	})
+	result := <-results
+	return result.value, result.ok
+}
+
+// SelectDialog shows the select dialog and blocks until it is closed
+func (w *Window) SelectDialog(options frontend.SelectDialogOptions) ([]string, bool) {
+	type selectResult struct {
+		values []string
+		ok     bool
+	}
+	results := make(chan selectResult, 1)
+	invokeOnMainThread(func() {
+		title := C.CString(options.Title)
+		defer C.free(unsafe.Pointer(title))
+		message := C.CString(options.Message)
+		defer C.free(unsafe.Pointer(message))
+
+		count := len(options.Items)
+		items := (**C.char)(C.malloc(C.size_t(count) * C.size_t(unsafe.Sizeof(uintptr(0)))))
+		defer C.free(unsafe.Pointer(items))
+		selected := (*C.int)(C.malloc(C.size_t(count) * C.size_t(unsafe.Sizeof(C.int(0)))))
+		defer C.free(unsafe.Pointer(selected))
+		itemSlice := unsafe.Slice(items, count)
+		selectedSlice := unsafe.Slice(selected, count)
+		for index, item := range options.Items {
+			itemSlice[index] = C.CString(item)
+			defer C.free(unsafe.Pointer(itemSlice[index]))
+			selectedSlice[index] = bool2Cint(slices.Contains(options.Default, item))
+		}
+
+		if C.SelectDialog(w.asGTKWindow(), title, message, items, selected, C.int(count), bool2Cint(options.Multiple)) != C.TRUE {
+			results <- selectResult{}
+			return
+		}
+		values := []string{}
+		for index, item := range options.Items {
+			if selectedSlice[index] != 0 {
+				values = append(values, item)
+			}
+		}
+		results <- selectResult{values: values, ok: true}
+	})
+	result := <-results
+	return result.values, result.ok
+}
+
 func (w *Window) ToggleMaximise() {
 	if w.IsMaximised() {
 		w.UnMaximise()
@@ -458,10 +666,63 @@ func (w *Window) ToggleMaximise() {
 	}
 }
 
+func (w *Window) FindInPage(text string, caseSensitive bool, backwards bool) {
+	invokeOnMainThread(func() {
+		cText := C.CString(text)
+		defer C.free(unsafe.Pointer(cText))
+		C.FindInPage(w.webview, cText, bool2Cint(caseSensitive), bool2Cint(backwards))
+	})
+}
+
+func (w *Window) StopFind() {
+	invokeOnMainThread(func() { C.StopFind(w.webview) })
+}
+
+// SetUserAgent sets the user agent of the webview, wails.io is appended as it is to the default user agent
+func (w *Window) SetUserAgent(userAgent string) {
+	if userAgent != "" {
+		userAgent += " wails.io"
+	}
+	invokeOnMainThread(func() {
+		cUserAgent := C.CString(userAgent)
+		defer C.free(unsafe.Pointer(cUserAgent))
+		C.SetUserAgent(w.webview, cUserAgent)
+	})
+}
+
+// AddPreloadScript adds a script which is run before the scripts of every document, it returns the id of the script to
+// remove it
+func (w *Window) AddPreloadScript(script string) string {
+	id := strconv.FormatUint(w.preloadScriptID.Add(1), 10)
+	invokeOnMainThread(func() {
+		cScript := C.CString(script)
+		defer C.free(unsafe.Pointer(cScript))
+		if w.preloadScripts == nil {
+			w.preloadScripts = map[string]unsafe.Pointer{}
+		}
+		w.preloadScripts[id] = C.AddPreloadScript(w.contentManager, cScript)
+	})
+	return id
+}
+
+// RemovePreloadScript removes a script added by AddPreloadScript, an unknown id is ignored
+func (w *Window) RemovePreloadScript(id string) {
+	invokeOnMainThread(func() {
+		if userScript, ok := w.preloadScripts[id]; ok {
+			C.RemovePreloadScript(w.contentManager, userScript)
+			delete(w.preloadScripts, id)
+		}
+	})
+}
+
 func (w *Window) ShowInspector() {
 	invokeOnMainThread(func() { C.ShowInspector(w.webview) })
 }
 
+func (w *Window) CloseInspector() {
+	invokeOnMainThread(func() { C.CloseInspector(w.webview) })
+}
+
 // showModalDialogAndExit shows a modal dialog and exits the app.
 func showModalDialogAndExit(title, message string) {
 	go func() {
@@ -72,6 +72,12 @@ typedef struct SetPositionArgs
     void *window;
 } SetPositionArgs;
 
+typedef struct BorderlessFullscreenArgs
+{
+    int monitor;
+    void *window;
+} BorderlessFullscreenArgs;
+
 void ExecuteOnMainThread(void *f, gpointer jscallback);
 
 GtkWidget *GTKWIDGET(void *pointer);
@@ -88,15 +94,23 @@ void SetBackgroundColour(void *data);
 void SetTitle(GtkWindow *window, char *title);
 void SetPosition(void *window, int x, int y);
 void SetMinMaxSize(GtkWindow *window, int min_width, int min_height, int max_width, int max_height);
+void SizeToContent(GtkWindow *window, void *webview, int width, int height);
 void DisableContextMenu(void *webview);
+void RegisterCustomScheme(const char *scheme);
+void SetupTextSelection(void *contentManager, void *webview, const char *script, int disableCopy);
+void *AddPreloadScript(void *contentManager, const char *script);
+void RemovePreloadScript(void *contentManager, void *userScript);
 void ConnectButtons(void *webview);
 
+const char *GetDisplayServer();
+
 int IsFullscreen(GtkWidget *widget);
 int IsMaximised(GtkWidget *widget);
 int IsMinimised(GtkWidget *widget);
 
 gboolean Center(gpointer data);
 gboolean Show(gpointer data);
+gboolean Focus(gpointer data);
 gboolean Hide(gpointer data);
 gboolean Maximise(gpointer data);
 gboolean UnMaximise(gpointer data);
@@ -104,8 +118,37 @@ gboolean Minimise(gpointer data);
 gboolean UnMinimise(gpointer data);
 gboolean Fullscreen(gpointer data);
 gboolean UnFullscreen(gpointer data);
+void BorderlessFullscreen(void *window, int monitor);
 
 // WebView
+void ConnectFullscreen(void *webview, int disableAutoFullscreen);
+void ConnectFind(void *webview);
+GtkWidget *ConnectNavigationProgress(void *webview, int showBar);
+void SetWindowCursor(GtkWindow *window, char *name);
+void SetWindowCursorImage(GtkWindow *window, const guchar *buf, gsize len, int hotspotX, int hotspotY);
+void FindInPage(void *webview, char *text, int caseSensitive, int backwards);
+void StopFind(void *webview);
+void SetUserAgent(void *webview, char *userAgent);
+void ClearWebsiteData(void *webview, int types, gint64 timespan);
+
+// The settings of PrintToPDF, the sizes are in inches and the zero values are the defaults of GTK
+typedef struct PrintToPDFSettings
+{
+    int landscape;
+    double scale;
+    double pageWidth;
+    double pageHeight;
+    int hasMargins;
+    double marginTop;
+    double marginBottom;
+    double marginLeft;
+    double marginRight;
+    int printBackgrounds;
+} PrintToPDFSettings;
+
+void PrintToPDF(void *webview, char *path, PrintToPDFSettings *settings);
+void CaptureSnapshot(void *webview);
+void EvaluateScript(void *webview, char *script);
 GtkWidget *SetupWebview(void *contentManager, GtkWindow *window, int hideWindowOnClose, int gpuPolicy, int disableWebViewDragAndDrop, int enableDragAndDrop);
 void LoadIndex(void *webview, char *url);
 void DevtoolsEnabled(void *webview, int enabled, bool showInspector);
@@ -117,12 +160,16 @@ void StartResize(void *webview, GtkWindow *mainwindow, GdkWindowEdge edge);
 
 // Dialog
 void MessageDialog(void *data);
+gboolean DateTimePickerDialog(GtkWindow *window, char *title, int showDate, int showTime, int *year, int *month, int *day, int *hour, int *minute);
+char *InputDialog(GtkWindow *window, char *title, char *message, char *defaultValue, char *placeholder, int password);
+gboolean SelectDialog(GtkWindow *window, char *title, char *message, char **items, int *selected, int count, int multiple);
 GtkFileFilter **AllocFileFilterArray(size_t ln);
 void Opendialog(void *data);
 
 // Inspector
 void sendShowInspectorMessage();
 void ShowInspector(void *webview);
+void CloseInspector(void *webview);
 void InstallF12Hotkey(void *window);
 
 #endif /* window_h */
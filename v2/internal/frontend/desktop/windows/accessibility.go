@@ -0,0 +1,24 @@
+//go:build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+)
+
+// SystemPrefersReducedMotion returns true if "Show animations in Windows" is turned off
+func (f *Frontend) SystemPrefersReducedMotion() bool {
+	return !win32.IsClientAreaAnimationEnabled()
+}
+
+func (f *Frontend) SystemHighContrastActive() bool {
+	return win32.IsCurrentlyHighContrastMode()
+}
+
+func (f *Frontend) notifyAccessibilityChanged() {
+	frontend.AccessibilityChanged(f.ctx, frontend.AccessibilitySettings{
+		ReducedMotion: f.SystemPrefersReducedMotion(),
+		HighContrast:  f.SystemHighContrastActive(),
+	})
+}
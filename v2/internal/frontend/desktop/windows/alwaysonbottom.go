@@ -0,0 +1,53 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// setAlwaysOnBottom keeps the window below the other windows like a desktop widget. The window isn't activated by a
+// click and is hidden from the taskbar and Alt+Tab like with SkipTaskbar. It must be called on the main thread.
+func (w *Window) setAlwaysOnBottom(onBottom bool) {
+	if w.alwaysOnBottom == onBottom {
+		return
+	}
+	w.alwaysOnBottom = onBottom
+	winc.SetExStyle(w.Handle(), onBottom, w32.WS_EX_NOACTIVATE)
+	w.updateToolWindowStyle()
+
+	insertAfter := w32.HWND_TOP
+	if onBottom {
+		insertAfter = w32.HWND_BOTTOM
+	}
+	w32.SetWindowPos(w.Handle(), insertAfter, 0, 0, 0, 0, w32.SWP_NOSIZE|w32.SWP_NOMOVE|w32.SWP_NOACTIVATE)
+}
+
+// keepOnBottom handles the messages which would raise or activate an always on bottom window
+func (w *Window) keepOnBottom(msg uint32, lparam uintptr) (uintptr, bool) {
+	if !w.alwaysOnBottom {
+		return 0, false
+	}
+	switch msg {
+	case w32.WM_MOUSEACTIVATE:
+		return win32.MA_NOACTIVATE, true
+	case w32.WM_WINDOWPOSCHANGING:
+		pos := (*win32.WINDOWPOS)(unsafe.Pointer(lparam))
+		if pos.Flags&w32.SWP_NOZORDER == 0 {
+			pos.HwndInsertAfter = w32.HWND_BOTTOM
+		}
+	}
+	return 0, false
+}
+
+// WindowSetAlwaysOnBottom keeps the window below the other windows, or restores the normal z-order and activation
+func (f *Frontend) WindowSetAlwaysOnBottom(onBottom bool) error {
+	f.mainWindow.Invoke(func() {
+		f.mainWindow.setAlwaysOnBottom(onBottom)
+	})
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+	winoptions "github.com/wailsapp/wails/v2/pkg/options/windows"
+)
+
+// The base colours of the acrylic material of Windows 11, which are tinted with the AcrylicTintOpacity
+var (
+	acrylicDarkTint  = uint32(winoptions.RGB(0x20, 0x20, 0x20))
+	acrylicLightTint = uint32(winoptions.RGB(0xF3, 0xF3, 0xF3))
+)
+
+// clampTintOpacity returns the opacity clamped to 0.0-1.0
+func clampTintOpacity(opacity float64) float64 {
+	return min(max(opacity, 0), 1)
+}
+
+// setBackdrop draws the backdrop behind the translucent window. The system backdrops of DWM have a fixed tint, an
+// Acrylic backdrop with a tint opacity is drawn with the acrylic accent instead.
+func (w *Window) setBackdrop(backdrop winoptions.BackdropType) {
+	w.backdrop = backdrop
+	if backdrop == winoptions.Acrylic && w.acrylicTintOpacity > 0 && win32.SupportsAcrylicTint() {
+		if win32.SupportsBackdropTypes() {
+			win32.EnableTranslucency(w.Handle(), win32.BackdropType(winoptions.None))
+		}
+		w.acrylicTinted = true
+		w.updateAcrylicTint()
+		return
+	}
+	if w.acrylicTinted {
+		w.acrylicTinted = false
+		w.ClearTranslucentBackground()
+	}
+	if !win32.SupportsBackdropTypes() {
+		w.SetTranslucentBackground()
+	} else {
+		win32.EnableTranslucency(w.Handle(), win32.BackdropType(backdrop))
+	}
+}
+
+// updateAcrylicTint tints the acrylic accent with the base colour of the theme of the window
+func (w *Window) updateAcrylicTint() {
+	if !w.acrylicTinted {
+		return
+	}
+	tint := acrylicLightTint
+	if w.isDarkMode {
+		tint = acrylicDarkTint
+	}
+	alpha := uint32(w.acrylicTintOpacity*255 + 0.5)
+	w.SetAcrylicBackground(alpha<<24 | tint)
+}
@@ -0,0 +1,103 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/platform/com"
+)
+
+var (
+	iidCoreWebView2Profile2              = ole.NewGUID("{FA740D4B-5EAE-4344-A8AD-74BE31925397}")
+	iidClearBrowsingDataCompletedHandler = ole.NewGUID("{E9710A06-1D1D-49B2-8234-226F35846AE5}")
+)
+
+// The vtable index of ClearBrowsingDataInTimeRange of ICoreWebView2Profile2
+const methodProfile2ClearBrowsingDataInTimeRange = 11
+
+// browsingDataKinds are the COREWEBVIEW2_BROWSING_DATA_KINDS of the cache kinds
+var browsingDataKinds = map[frontend.CacheKind]uint32{
+	frontend.CacheCookies:    1 << 6,
+	frontend.CacheDiskCache:  1 << 8,
+	frontend.CacheDOMStorage: 1<<2 | 1<<3,
+	frontend.CacheIndexedDB:  1 << 1,
+	// The file systems, the cache storage and the service workers
+	frontend.CacheOther: 1<<0 | 1<<4 | 1<<15,
+}
+
+// WebviewClearCache clears the browsing data of the profile of the webview, which is modified between start and end
+func (f *Frontend) WebviewClearCache(kinds frontend.CacheKind, start, end time.Time) error {
+	var dataKinds uint32
+	for kind, dataKind := range browsingDataKinds {
+		if kinds&kind != 0 {
+			dataKinds |= dataKind
+		}
+	}
+	// The time range is in seconds since the UNIX epoch
+	startTime := 0.0
+	if !start.IsZero() {
+		startTime = float64(start.UnixMilli()) / 1000
+	}
+	if end.IsZero() {
+		end = time.Now()
+	}
+	endTime := float64(end.UnixMilli()) / 1000
+
+	if !f.mainWindow.InvokeRequired() {
+		return errWaitOnMainThread
+	}
+	done := make(chan error, 1)
+	handler := newWebviewActionCompletedHandler(iidClearBrowsingDataCompletedHandler, func(errorCode uintptr) {
+		if int32(errorCode) < 0 {
+			done <- ole.NewError(errorCode)
+		} else {
+			done <- nil
+		}
+	})
+	started := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		started <- f.clearBrowsingData(dataKinds, startTime, endTime, handler)
+	})
+	if err := <-started; err != nil {
+		return err
+	}
+	err := <-done
+	runtime.KeepAlive(handler)
+	return err
+}
+
+func (f *Frontend) clearBrowsingData(dataKinds uint32, startTime, endTime float64, handler *webviewCompletedHandler) error {
+	webview, err := f.chromium.GetController().GetCoreWebView2()
+	if err != nil {
+		return err
+	}
+	var webview13 *winrtObject
+	if err := (*winrtObject)(unsafe.Pointer(webview)).Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(iidCoreWebView2_13)), uintptr(unsafe.Pointer(&webview13))); err != nil {
+		return errors.New("clearing the browsing data requires WebView2 Runtime 1.0.1245.22 or later")
+	}
+	defer webview13.Release()
+
+	var profile *winrtObject
+	if err := webview13.Call(methodWebView13GetProfile, uintptr(unsafe.Pointer(&profile))); err != nil {
+		return err
+	}
+	defer profile.Release()
+
+	var profile2 *winrtObject
+	if err := profile.Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(iidCoreWebView2Profile2)), uintptr(unsafe.Pointer(&profile2))); err != nil {
+		return errors.New("clearing the browsing data requires WebView2 Runtime 1.0.1245.22 or later")
+	}
+	defer profile2.Release()
+
+	// The doubles are passed as their bits like in go-webview2, syscall also loads the first arguments in the floating
+	// point registers
+	return profile2.Call(methodProfile2ClearBrowsingDataInTimeRange, uintptr(dataKinds),
+		uintptr(math.Float64bits(startTime)), uintptr(math.Float64bits(endTime)), uintptr(unsafe.Pointer(handler)))
+}
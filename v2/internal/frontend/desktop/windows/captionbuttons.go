@@ -0,0 +1,67 @@
+//go:build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// redrawFrame redraws the caption of the window after a change of its style
+func (w *Window) redrawFrame() {
+	w32.SetWindowPos(w.Handle(), 0, 0, 0, 0, 0,
+		w32.SWP_NOMOVE|w32.SWP_NOSIZE|w32.SWP_NOZORDER|w32.SWP_NOACTIVATE|w32.SWP_FRAMECHANGED)
+}
+
+// setCloseButtonEnabled grays out the close button, the close command of the window menu and Alt+F4, unless
+// AllowAltF4 is set. It must be called on the main thread.
+func (w *Window) setCloseButtonEnabled(enabled bool) {
+	w.closeButtonDisabled = !enabled
+	win32.EnableCloseButton(w.Handle(), enabled)
+	w.redrawFrame()
+}
+
+// closeCommandBlocked returns true if a WM_SYSKEYDOWN or a WM_SYSCOMMAND must not close the window because the close
+// button is disabled. Alt+F4 closes the window with WM_CLOSE if AllowAltF4 is set.
+func (w *Window) closeCommandBlocked(msg uint32, wparam uintptr) bool {
+	if !w.closeButtonDisabled {
+		return false
+	}
+	switch msg {
+	case w32.WM_SYSKEYDOWN:
+		if wparam != w32.VK_F4 {
+			return false
+		}
+		if w.allowAltF4 {
+			w32.PostMessage(w.Handle(), w32.WM_CLOSE, 0, 0)
+		}
+		return true
+	case w32.WM_SYSCOMMAND:
+		// The low four bits of the command are used by Windows
+		return wparam&0xFFF0 == win32.SC_CLOSE
+	}
+	return false
+}
+
+// WindowSetMinimiseButtonEnabled enables or disables the minimise button of the window
+func (f *Frontend) WindowSetMinimiseButtonEnabled(enabled bool) {
+	f.mainWindow.Invoke(func() {
+		f.mainWindow.EnableMinButton(enabled)
+		f.mainWindow.redrawFrame()
+	})
+}
+
+// WindowSetMaximiseButtonEnabled enables or disables the maximise button of the window
+func (f *Frontend) WindowSetMaximiseButtonEnabled(enabled bool) {
+	f.mainWindow.Invoke(func() {
+		f.mainWindow.EnableMaxButton(enabled)
+		f.mainWindow.redrawFrame()
+	})
+}
+
+// WindowSetCloseButtonEnabled enables or disables the close button of the window
+func (f *Frontend) WindowSetCloseButtonEnabled(enabled bool) {
+	f.mainWindow.Invoke(func() {
+		f.mainWindow.setCloseButtonEnabled(enabled)
+	})
+}
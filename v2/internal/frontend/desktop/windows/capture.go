@@ -0,0 +1,164 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"image"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+var iidCapturePreviewCompletedHandler = ole.NewGUID("{697E05E9-3D8F-45FA-96F4-8FFE1EDEDAF5}")
+
+// The vtable indexes of CapturePreview of ICoreWebView2 and of Seek of IStream
+const (
+	methodWebViewCapturePreview = 30
+	methodStreamSeek            = 5
+)
+
+// captureFormats are the COREWEBVIEW2_CAPTURE_PREVIEW_IMAGE_FORMAT of the image formats
+var captureFormats = map[frontend.ImageFormat]uintptr{
+	frontend.ImagePNG:  0,
+	frontend.ImageJPEG: 1,
+}
+
+// seekStreamStart moves an IStream to its start. The LARGE_INTEGER offset is passed by value, which takes two
+// arguments on 32 bit.
+func seekStreamStart(stream *winrtObject) error {
+	const streamSeekSet = 0
+	args := []uintptr{0}
+	if unsafe.Sizeof(uintptr(0)) == 4 {
+		args = append(args, 0)
+	}
+	return stream.Call(methodStreamSeek, append(args, streamSeekSet, 0)...)
+}
+
+// WebviewCapturePreview captures the visible content of the webview at the DPI of the window
+func (f *Frontend) WebviewCapturePreview(format frontend.ImageFormat) ([]byte, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	if !f.mainWindow.InvokeRequired() {
+		return nil, errWaitOnMainThread
+	}
+	done := make(chan streamResult, 1)
+	var handler *webviewCompletedHandler
+	started := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		webview, err := f.chromium.GetController().GetCoreWebView2()
+		if err != nil {
+			started <- err
+			return
+		}
+		stream := (*winrtObject)(unsafe.Pointer(w32.CreateStreamOnHGlobal(0, true)))
+		// The image is read from the stream once it has been written
+		handler = newWebviewActionCompletedHandler(iidCapturePreviewCompletedHandler, func(errorCode uintptr) {
+			defer stream.Release()
+			if int32(errorCode) < 0 {
+				done <- streamResult{err: ole.NewError(errorCode)}
+				return
+			}
+			if err := seekStreamStart(stream); err != nil {
+				done <- streamResult{err: err}
+				return
+			}
+			data, err := readStream(stream)
+			done <- streamResult{data: data, err: err}
+		})
+		if err := (*winrtObject)(unsafe.Pointer(webview)).Call(methodWebViewCapturePreview, captureFormats[format], uintptr(unsafe.Pointer(stream)), uintptr(unsafe.Pointer(handler))); err != nil {
+			stream.Release()
+			started <- err
+			return
+		}
+		started <- nil
+	})
+	if err := <-started; err != nil {
+		return nil, err
+	}
+	result := <-done
+	runtime.KeepAlive(handler)
+	return result.data, result.err
+}
+
+// WindowCapture captures the window including its frame with PrintWindow. The invisible resize borders of the window
+// are cropped.
+func (f *Frontend) WindowCapture(format frontend.ImageFormat) ([]byte, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	type captureResult struct {
+		img *image.RGBA
+		err error
+	}
+	results := make(chan captureResult, 1)
+	f.mainWindow.Invoke(func() {
+		img, err := captureWindow(f.mainWindow.Handle())
+		results <- captureResult{img: img, err: err}
+	})
+	result := <-results
+	if result.err != nil {
+		return nil, result.err
+	}
+	return frontend.EncodeImage(result.img, format)
+}
+
+func captureWindow(hwnd w32.HWND) (*image.RGBA, error) {
+	rect := win32.GetWindowRect(uintptr(hwnd))
+	width, height := int(rect.Right-rect.Left), int(rect.Bottom-rect.Top)
+	if width <= 0 || height <= 0 {
+		return nil, errors.New("the window has no size")
+	}
+
+	screenDC := w32.GetDC(0)
+	defer w32.ReleaseDC(0, screenDC)
+	memoryDC := w32.CreateCompatibleDC(screenDC)
+	defer w32.DeleteDC(memoryDC)
+
+	// A negative height makes the bitmap top-down, as image.RGBA
+	info := w32.BITMAPINFO{BmiHeader: w32.BITMAPINFOHEADER{
+		BiWidth:       int32(width),
+		BiHeight:      -int32(height),
+		BiPlanes:      1,
+		BiBitCount:    32,
+		BiCompression: w32.BI_RGB,
+	}}
+	info.BmiHeader.BiSize = uint32(unsafe.Sizeof(info.BmiHeader))
+	var bits unsafe.Pointer
+	bitmap := w32.CreateDIBSection(memoryDC, &info, w32.DIB_RGB_COLORS, &bits, 0, 0)
+	if bitmap == 0 {
+		return nil, errors.New("unable to create the bitmap of the window")
+	}
+	defer w32.DeleteObject(w32.HGDIOBJ(bitmap))
+	previous := w32.SelectObject(memoryDC, w32.HGDIOBJ(bitmap))
+	defer w32.SelectObject(memoryDC, previous)
+
+	if err := win32.PrintWindow(uintptr(hwnd), uintptr(memoryDC), win32.PW_RENDERFULLCONTENT); err != nil {
+		return nil, err
+	}
+
+	bounds := image.Rect(0, 0, width, height)
+	if frame, err := win32.GetExtendedFrameBounds(uintptr(hwnd)); err == nil {
+		bounds = image.Rect(int(frame.Left-rect.Left), int(frame.Top-rect.Top), int(frame.Right-rect.Left), int(frame.Bottom-rect.Top)).Intersect(bounds)
+	}
+
+	// The pixels of the bitmap are BGRA, the alpha of the frame isn't meaningful
+	pixels := unsafe.Slice((*byte)(bits), width*height*4)
+	result := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			source := ((bounds.Min.Y+y)*width + bounds.Min.X + x) * 4
+			target := result.PixOffset(x, y)
+			result.Pix[target] = pixels[source+2]
+			result.Pix[target+1] = pixels[source+1]
+			result.Pix[target+2] = pixels[source]
+			result.Pix[target+3] = 0xff
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,29 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/wails/v2/internal/platform/com"
+	"golang.org/x/sys/windows"
+)
+
+// errWaitOnMainThread is returned by the calls which wait for a completion handler when they are made on the main
+// thread, as the handler is invoked by the message loop which would be blocked
+var errWaitOnMainThread = errors.New("this call waits for the webview and can't be made on the main thread")
+
+// winrtObject is a COM interface pointer
+type winrtObject = com.Object
+
+// getCoTaskString returns the string of a getter which allocates it with CoTaskMemAlloc
+func getCoTaskString(object *winrtObject, method int) (string, error) {
+	var value *uint16
+	if err := object.Call(method, uintptr(unsafe.Pointer(&value))); err != nil {
+		return "", err
+	}
+	defer ole.CoTaskMemFree(uintptr(unsafe.Pointer(value)))
+	return windows.UTF16PtrToString(value), nil
+}
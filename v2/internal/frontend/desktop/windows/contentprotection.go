@@ -0,0 +1,35 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+)
+
+// errContentProtectionMonitor is returned when the window can't be excluded from the captures, it is shown black
+var errContentProtectionMonitor = errors.New("content protection requires Windows 10 2004 to exclude the window from captures, the window is captured black instead")
+
+// setContentProtection excludes the window from the captures, it must be called on the main thread
+func (w *Window) setContentProtection(enabled bool) error {
+	if !enabled {
+		return win32.SetWindowDisplayAffinity(w.Handle(), win32.WDA_NONE)
+	}
+	if !win32.SupportsExcludeFromCapture() {
+		if err := win32.SetWindowDisplayAffinity(w.Handle(), win32.WDA_MONITOR); err != nil {
+			return err
+		}
+		return errContentProtectionMonitor
+	}
+	return win32.SetWindowDisplayAffinity(w.Handle(), win32.WDA_EXCLUDEFROMCAPTURE)
+}
+
+// WindowSetContentProtection excludes the window from screenshots, screen recording and screen sharing
+func (f *Frontend) WindowSetContentProtection(enabled bool) error {
+	results := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		results <- f.mainWindow.setContentProtection(enabled)
+	})
+	return <-results
+}
@@ -0,0 +1,90 @@
+//go:build windows
+
+package windows
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+var cursorIDs = map[string]uint16{
+	frontend.CursorDefault:    w32.IDC_ARROW,
+	frontend.CursorPointer:    w32.IDC_HAND,
+	frontend.CursorText:       w32.IDC_IBEAM,
+	frontend.CursorCrosshair:  w32.IDC_CROSS,
+	frontend.CursorWait:       w32.IDC_WAIT,
+	frontend.CursorProgress:   w32.IDC_APPSTARTING,
+	frontend.CursorMove:       w32.IDC_SIZEALL,
+	frontend.CursorNotAllowed: w32.IDC_NO,
+	frontend.CursorGrab:       w32.IDC_HAND,
+	frontend.CursorGrabbing:   w32.IDC_SIZEALL,
+	frontend.CursorHelp:       w32.IDC_HELP,
+	frontend.CursorResizeEW:   w32.IDC_SIZEWE,
+	frontend.CursorResizeNS:   w32.IDC_SIZENS,
+	frontend.CursorResizeNESW: w32.IDC_SIZENESW,
+	frontend.CursorResizeNWSE: w32.IDC_SIZENWSE,
+}
+
+// WindowSetCursor overrides the cursor of the window. The window gets WM_SETCURSOR while it is moved and when the mouse
+// is not over the webview, the cursor of the page is overridden with CSS.
+func (f *Frontend) WindowSetCursor(cursor string) {
+	f.mainWindow.Invoke(func() {
+		var hcursor w32.HCURSOR
+		if cursor != "" {
+			id, ok := cursorIDs[cursor]
+			if !ok {
+				f.logger.Warning("Unknown cursor '%s'", cursor)
+				id = w32.IDC_ARROW
+			}
+			hcursor = w32.LoadCursorWithResourceID(0, id)
+		}
+		f.mainWindow.setCursor(hcursor, false)
+	})
+	f.ExecJS(frontend.CursorScript(cursor))
+}
+
+func (f *Frontend) WindowSetCursorImage(image []byte, hotspotX int, hotspotY int) {
+	f.mainWindow.Invoke(func() {
+		cursor, err := createCursorFromPNG(image, hotspotX, hotspotY)
+		if err != nil {
+			f.logger.Error(err.Error())
+			return
+		}
+		f.mainWindow.setCursor(cursor, true)
+	})
+	f.ExecJS(frontend.CursorScript(frontend.CursorImageCSS(image, hotspotX, hotspotY)))
+}
+
+// setCursor overrides the cursor of the window, which is also used by the webview while the window is moved or
+// resized. A cursor of 0 removes the override, custom cursors are destroyed when they are replaced.
+func (w *Window) setCursor(cursor w32.HCURSOR, custom bool) {
+	previous, previousCustom := w.cursor, w.customCursor
+	w.cursor, w.customCursor = cursor, custom
+
+	if cursor != 0 {
+		w32.SetCursor(cursor)
+	} else {
+		w32.SetCursor(w32.LoadCursorWithResourceID(0, w32.IDC_ARROW))
+	}
+	if previousCustom {
+		w32.DestroyCursor(previous)
+	}
+}
+
+// createCursorFromPNG creates a cursor from a PNG image. Cursor resources start with the hotspot, followed by the image.
+func createCursorFromPNG(image []byte, hotspotX int, hotspotY int) (w32.HCURSOR, error) {
+	data := make([]byte, 4+len(image))
+	binary.LittleEndian.PutUint16(data[0:], uint16(hotspotX))
+	binary.LittleEndian.PutUint16(data[2:], uint16(hotspotY))
+	copy(data[4:], image)
+
+	cursor := w32.CreateIconFromResourceEx(unsafe.Pointer(&data[0]), uint32(len(data)), false, 0x00030000, 0, 0, w32.LR_DEFAULTCOLOR)
+	if cursor == 0 {
+		return 0, fmt.Errorf("unable to create cursor from image")
+	}
+	return w32.HCURSOR(cursor), nil
+}
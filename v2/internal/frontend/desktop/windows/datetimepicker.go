@@ -0,0 +1,113 @@
+//go:build windows
+
+package windows
+
+import (
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// The layout of the date time picker dialog in logical pixels
+const (
+	pickerPadding      = 12
+	pickerWidth        = 220
+	pickerHeight       = 24
+	pickerButtonWidth  = 90
+	pickerButtonHeight = 26
+)
+
+// DateTimePickerDialog shows a modal dialog with DateTimePicker controls for the date and the time
+func (f *Frontend) DateTimePickerDialog(options frontend.DateTimePickerOptions) (time.Time, bool) {
+	type pickerResult struct {
+		value time.Time
+		ok    bool
+	}
+	results := make(chan pickerResult, 1)
+
+	f.mainWindow.Invoke(func() {
+		dlg := winc.NewDialog(f.mainWindow)
+		dlg.SetText(options.Title)
+
+		y := pickerPadding
+		var datePicker, timePicker *winc.DateTimePicker
+		if options.Mode != frontend.DateTimePickerTime {
+			datePicker = winc.NewDateTimePicker(dlg, w32.DTS_SHORTDATECENTURYFORMAT)
+			placeControl(dlg, datePicker, pickerPadding, y, pickerWidth, pickerHeight)
+			y += pickerHeight + pickerPadding
+		}
+		if options.Mode != frontend.DateTimePickerDate {
+			timePicker = winc.NewDateTimePicker(dlg, w32.DTS_TIMEFORMAT)
+			placeControl(dlg, timePicker, pickerPadding, y, pickerWidth, pickerHeight)
+			y += pickerHeight + pickerPadding
+		}
+		for _, picker := range []*winc.DateTimePicker{datePicker, timePicker} {
+			if picker != nil {
+				picker.SetRange(options.Min, options.Max)
+				picker.SetValue(options.Default)
+			}
+		}
+
+		okButton := winc.NewPushButton(dlg)
+		okButton.SetText("OK")
+		placeControl(dlg, okButton, pickerPadding+pickerWidth-2*pickerButtonWidth-pickerPadding/2, y, pickerButtonWidth, pickerButtonHeight)
+		cancelButton := winc.NewPushButton(dlg)
+		cancelButton.SetText("Cancel")
+		placeControl(dlg, cancelButton, pickerPadding+pickerWidth-pickerButtonWidth, y, pickerButtonWidth, pickerButtonHeight)
+		dlg.SetButtons(okButton, cancelButton)
+
+		done := false
+		closeDialog := func(result pickerResult) {
+			if done {
+				return
+			}
+			done = true
+			dlg.Close()
+			results <- result
+		}
+		okButton.OnClick().Bind(func(*winc.Event) {
+			value := options.Default
+			if datePicker != nil {
+				date := datePicker.Value()
+				value = time.Date(date.Year(), date.Month(), date.Day(), value.Hour(), value.Minute(), value.Second(), 0, time.Local)
+			}
+			if timePicker != nil {
+				clock := timePicker.Value()
+				value = time.Date(value.Year(), value.Month(), value.Day(), clock.Hour(), clock.Minute(), clock.Second(), 0, time.Local)
+			}
+			closeDialog(pickerResult{value: value, ok: true})
+		})
+		cancelButton.OnClick().Bind(func(*winc.Event) {
+			closeDialog(pickerResult{})
+		})
+
+		setClientSize(dlg, pickerWidth+2*pickerPadding, y+pickerButtonHeight+pickerPadding)
+		dlg.Center()
+		dlg.Show()
+	})
+
+	result := <-results
+	return result.value, result.ok
+}
+
+// placeControl moves the control to the position in the client area of the dialog, scaled to the DPI of the dialog
+func placeControl(dlg *winc.Dialog, control winc.Controller, x, y, width, height int) {
+	dpix, dpiy := dlg.GetWindowDPI()
+	w32.MoveWindow(control.Handle(),
+		winc.ScaleWithDPI(x, uint(dpix)), winc.ScaleWithDPI(y, uint(dpiy)),
+		winc.ScaleWithDPI(width, uint(dpix)), winc.ScaleWithDPI(height, uint(dpiy)), true)
+}
+
+// setClientSize resizes the dialog to the size of the client area, scaled to the DPI of the dialog
+func setClientSize(dlg *winc.Dialog, width, height int) {
+	dpix, dpiy := dlg.GetWindowDPI()
+	windowRect := w32.GetWindowRect(dlg.Handle())
+	clientRect := w32.GetClientRect(dlg.Handle())
+	frameWidth := int((windowRect.Right - windowRect.Left) - (clientRect.Right - clientRect.Left))
+	frameHeight := int((windowRect.Bottom - windowRect.Top) - (clientRect.Bottom - clientRect.Top))
+	w32.SetWindowPos(dlg.Handle(), 0, 0, 0,
+		winc.ScaleWithDPI(width, uint(dpix))+frameWidth, winc.ScaleWithDPI(height, uint(dpiy))+frameHeight,
+		w32.SWP_NOMOVE|w32.SWP_NOZORDER)
+}
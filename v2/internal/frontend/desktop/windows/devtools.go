@@ -0,0 +1,77 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// The method of ICoreWebView2 returning the ID of the browser process, which owns the DevTools window
+const methodWebViewGetBrowserProcessID = 37
+
+var errDevToolsDisabled = errors.New("the dev tools are disabled, set EnableDevTools in the Windows options to enable them")
+
+// devToolsSearch collects the DevTools windows of the browser process, activeDevToolsSearch is used by the EnumWindows
+// callback, which can't be bound to a value. EnumWindows is only called on the main thread.
+type devToolsSearch struct {
+	processID uint32
+	windows   []w32.HWND
+}
+
+var activeDevToolsSearch *devToolsSearch
+
+var devToolsWindowCallback = windows.NewCallback(func(hwnd windows.HWND, _ uintptr) uintptr {
+	var processID uint32
+	if _, err := windows.GetWindowThreadProcessId(hwnd, &processID); err == nil && processID == activeDevToolsSearch.processID {
+		if strings.HasPrefix(w32.GetWindowText(w32.HWND(hwnd)), "DevTools") {
+			activeDevToolsSearch.windows = append(activeDevToolsSearch.windows, w32.HWND(hwnd))
+		}
+	}
+	return 1
+})
+
+// WindowOpenDevTools opens the DevTools window of the webview
+func (f *Frontend) WindowOpenDevTools() error {
+	if !f.devtoolsEnabled {
+		return errDevToolsDisabled
+	}
+	f.mainWindow.Invoke(func() {
+		f.chromium.OpenDevToolsWindow()
+	})
+	return nil
+}
+
+// WindowCloseDevTools closes the DevTools window of the webview. WebView2 can't close it, so the windows of the browser
+// process titled DevTools are closed.
+func (f *Frontend) WindowCloseDevTools() error {
+	if !f.devtoolsEnabled {
+		return errDevToolsDisabled
+	}
+	results := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		webview, err := f.chromium.GetController().GetCoreWebView2()
+		if err != nil {
+			results <- err
+			return
+		}
+		search := &devToolsSearch{}
+		if err := (*winrtObject)(unsafe.Pointer(webview)).Call(methodWebViewGetBrowserProcessID, uintptr(unsafe.Pointer(&search.processID))); err != nil {
+			results <- err
+			return
+		}
+		activeDevToolsSearch = search
+		_ = windows.EnumWindows(devToolsWindowCallback, nil)
+		activeDevToolsSearch = nil
+		for _, hwnd := range search.windows {
+			w32.PostMessage(hwnd, w32.WM_CLOSE, 0, 0)
+		}
+		results <- nil
+	})
+	return <-results
+}
@@ -37,9 +37,10 @@ func (f *Frontend) OpenDirectoryDialog(options frontend.OpenDialogOptions) (stri
 	}
 
 	config := cfd.DialogConfig{
-		Title:  options.Title,
-		Role:   "PickFolder",
-		Folder: defaultFolder,
+		Title:           options.Title,
+		Role:            "PickFolder",
+		Folder:          defaultFolder,
+		ShowHiddenFiles: options.ShowHiddenFiles,
 	}
 
 	result, err := f.showCfdDialog(
@@ -61,10 +62,11 @@ func (f *Frontend) OpenFileDialog(options frontend.OpenDialogOptions) (string, e
 	}
 
 	config := cfd.DialogConfig{
-		Folder:      defaultFolder,
-		FileFilters: convertFilters(options.Filters),
-		FileName:    options.DefaultFilename,
-		Title:       options.Title,
+		Folder:          defaultFolder,
+		FileFilters:     convertFilters(options.Filters),
+		FileName:        options.DefaultFilename,
+		ShowHiddenFiles: options.ShowHiddenFiles,
+		Title:           options.Title,
 	}
 
 	result, err := f.showCfdDialog(
@@ -87,11 +89,12 @@ func (f *Frontend) OpenMultipleFilesDialog(options frontend.OpenDialogOptions) (
 	}
 
 	config := cfd.DialogConfig{
-		Title:       options.Title,
-		Role:        "OpenMultipleFiles",
-		FileFilters: convertFilters(options.Filters),
-		FileName:    options.DefaultFilename,
-		Folder:      defaultFolder,
+		Title:           options.Title,
+		Role:            "OpenMultipleFiles",
+		FileFilters:     convertFilters(options.Filters),
+		FileName:        options.DefaultFilename,
+		ShowHiddenFiles: options.ShowHiddenFiles,
+		Folder:          defaultFolder,
 	}
 
 	result, err := f.showCfdDialog(
@@ -114,11 +117,12 @@ func (f *Frontend) SaveFileDialog(options frontend.SaveDialogOptions) (string, e
 	}
 
 	config := cfd.DialogConfig{
-		Title:       options.Title,
-		Role:        "SaveFile",
-		FileFilters: convertFilters(options.Filters),
-		FileName:    options.DefaultFilename,
-		Folder:      defaultFolder,
+		Title:           options.Title,
+		Role:            "SaveFile",
+		FileFilters:     convertFilters(options.Filters),
+		FileName:        options.DefaultFilename,
+		ShowHiddenFiles: options.ShowHiddenFiles,
+		Folder:          defaultFolder,
 	}
 
 	if len(options.Filters) > 0 {
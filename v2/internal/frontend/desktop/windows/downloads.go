@@ -0,0 +1,132 @@
+//go:build windows
+
+package windows
+
+import (
+	"path/filepath"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	"github.com/wailsapp/wails/v2/internal/platform/com"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	iidCoreWebView2_4              = ole.NewGUID("{20D02D59-6DF2-42DC-BD06-F98A694B1302}")
+	iidDownloadStartingHandler     = ole.NewGUID("{EFEDC989-C396-41CA-83F7-07F845A55724}")
+	iidBytesReceivedChangedHandler = ole.NewGUID("{828E8AB6-D94C-4264-9CEF-5217170D6251}")
+)
+
+// The vtable indexes of the DownloadStarting event of ICoreWebView2_4, its arguments and the download operation
+const (
+	methodWebView4AddDownloadStarting              = 75
+	methodDownloadStartingArgsGetDownloadOperation = 3
+	methodDownloadStartingArgsPutCancel            = 5
+	methodDownloadStartingArgsGetResultFilePath    = 6
+	methodDownloadStartingArgsPutResultFilePath    = 7
+	methodDownloadOperationAddBytesReceivedChanged = 3
+	methodDownloadOperationGetURI                  = 9
+	methodDownloadOperationGetTotalBytesToReceive  = 12
+	methodDownloadOperationGetBytesReceived        = 13
+)
+
+// downloads calls OnWebviewDownloadStarted and OnWebviewDownloadProgress for the downloads of the webview. A single
+// progress handler is shared by the downloads, which are its senders.
+type downloads struct {
+	started  *webviewEventHandler
+	progress *webviewEventHandler
+}
+
+// addDownloadHandlers adds the handler of the DownloadStarting event, it returns nil if there are no callbacks or if
+// WebView2 doesn't support the event
+func (f *Frontend) addDownloadHandlers(webview *edge.ICoreWebView2) *downloads {
+	opts := f.frontendOptions.Windows
+	if opts == nil || (opts.OnWebviewDownloadStarted == nil && opts.OnWebviewDownloadProgress == nil) {
+		return nil
+	}
+
+	result := &downloads{}
+	if opts.OnWebviewDownloadProgress != nil {
+		result.progress = newWebviewEventHandler(iidBytesReceivedChangedHandler, func(operation, _ *winrtObject) {
+			var received, total int64
+			if err := operation.Call(methodDownloadOperationGetBytesReceived, uintptr(unsafe.Pointer(&received))); err != nil {
+				return
+			}
+			if err := operation.Call(methodDownloadOperationGetTotalBytesToReceive, uintptr(unsafe.Pointer(&total))); err != nil {
+				return
+			}
+			uri, err := getCoTaskString(operation, methodDownloadOperationGetURI)
+			if err != nil {
+				return
+			}
+			opts.OnWebviewDownloadProgress(uri, received, total)
+		})
+	}
+	result.started = newWebviewEventHandler(iidDownloadStartingHandler, func(_, args *winrtObject) {
+		var operation *winrtObject
+		if err := args.Call(methodDownloadStartingArgsGetDownloadOperation, uintptr(unsafe.Pointer(&operation))); err != nil {
+			f.logger.Error("Unable to get the operation of the download: %s", err)
+			return
+		}
+		defer operation.Release()
+
+		if opts.OnWebviewDownloadStarted != nil && !f.downloadStarted(args, operation) {
+			return
+		}
+		if result.progress != nil {
+			var token int64
+			if err := operation.Call(methodDownloadOperationAddBytesReceivedChanged, uintptr(unsafe.Pointer(result.progress)), uintptr(unsafe.Pointer(&token))); err != nil {
+				f.logger.Error("Unable to track the progress of the download: %s", err)
+			}
+		}
+	})
+
+	var webview4 *winrtObject
+	if err := (*winrtObject)(unsafe.Pointer(webview)).Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(iidCoreWebView2_4)), uintptr(unsafe.Pointer(&webview4))); err != nil {
+		f.logger.Warning("Unable to handle the downloads, WebView2 Runtime 1.0.902.49 or later is required: %s", err)
+		return nil
+	}
+	defer webview4.Release()
+	var token int64
+	if err := webview4.Call(methodWebView4AddDownloadStarting, uintptr(unsafe.Pointer(result.started)), uintptr(unsafe.Pointer(&token))); err != nil {
+		f.logger.Error("Unable to handle the downloads: %s", err)
+		return nil
+	}
+	return result
+}
+
+// downloadStarted calls OnWebviewDownloadStarted and applies its result, it returns false if the download has been
+// cancelled
+func (f *Frontend) downloadStarted(args, operation *winrtObject) bool {
+	uri, err := getCoTaskString(operation, methodDownloadOperationGetURI)
+	if err != nil {
+		f.logger.Error("Unable to get the URI of the download: %s", err)
+		return true
+	}
+	// The default path is the suggested name in the Downloads folder
+	path, err := getCoTaskString(args, methodDownloadStartingArgsGetResultFilePath)
+	if err != nil {
+		f.logger.Error("Unable to get the path of the download: %s", err)
+		return true
+	}
+
+	savePath, cancel := f.frontendOptions.Windows.OnWebviewDownloadStarted(uri, filepath.Base(path))
+	if cancel {
+		if err := args.Call(methodDownloadStartingArgsPutCancel, 1); err != nil {
+			f.logger.Error("Unable to cancel the download: %s", err)
+		}
+		return false
+	}
+	if savePath != "" {
+		pathPtr, err := windows.UTF16PtrFromString(savePath)
+		if err != nil {
+			f.logger.Error("Invalid path of the download %s: %s", savePath, err)
+			return true
+		}
+		if err := args.Call(methodDownloadStartingArgsPutResultFilePath, uintptr(unsafe.Pointer(pathPtr))); err != nil {
+			f.logger.Error("Unable to set the path of the download: %s", err)
+		}
+	}
+	return true
+}
@@ -6,12 +6,15 @@ package windows
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"net/url"
 	"os"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"sync"
 	"text/template"
@@ -21,6 +24,7 @@ import (
 	"github.com/bep/debounce"
 	"github.com/wailsapp/go-webview2/pkg/edge"
 	"github.com/wailsapp/wails/v2/internal/binding"
+	"github.com/wailsapp/wails/v2/internal/crashreport"
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
@@ -51,6 +55,10 @@ type Frontend struct {
 	debug           bool
 	devtoolsEnabled bool
 
+	// userAgent is the user agent set by the options or by WindowSetUserAgent, the default is kept to restore it
+	userAgent        string
+	defaultUserAgent string
+
 	// Assets
 	assets   *assetserver.AssetServer
 	startURL *url.URL
@@ -65,6 +73,34 @@ type Frontend struct {
 	// Windows build number
 	versionInfo     *operatingsystem.WindowsVersionInfo
 	resizeDebouncer func(f func())
+
+	keyboardHook *keyboardHook
+	// suppressedKeys are the SuppressedKeys of the options which are swallowed by the webview
+	suppressedKeys []winc.Shortcut
+	// mouseForwarder forwards the mouse moves to the page while the window ignores the mouse events
+	mouseForwarder *mouseForwarder
+
+	// elementFullscreen is set when the window has been made fullscreen for an element of the page
+	elementFullscreen bool
+
+	imeComposition frontend.IMECompositionState
+	imeHook        *imeHook
+
+	// launch buffers the URLs and the files to open for the frontend
+	launch *frontend.LaunchBuffer
+
+	originZoom *originZoom
+
+	navigationProgress *navigationProgress
+
+	permissionRequested *webviewEventHandler
+	newWindowRequested  *webviewEventHandler
+	downloads           *downloads
+	proxyAuthentication *webviewEventHandler
+
+	taskbar taskbar
+
+	hotkeys globalHotkeys
 }
 
 func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.Logger, appBindings *binding.Bindings, dispatcher frontend.Dispatcher) *Frontend {
@@ -79,6 +115,7 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 		dispatcher:      dispatcher,
 		ctx:             ctx,
 		versionInfo:     versionInfo,
+		launch:          frontend.NewLaunchBuffer(frontend.ParseLaunchArgs(os.Args[1:], "")),
 	}
 
 	if appoptions.Windows != nil {
@@ -114,6 +151,7 @@ func NewFrontend(ctx context.Context, appoptions *options.App, myLogger *logger.
 	if err != nil {
 		log.Fatal(err)
 	}
+	assets.UseLaunchScript(result.launch.Script)
 	result.assets = assets
 
 	go result.startSecondInstanceProcessor()
@@ -147,8 +185,53 @@ func (f *Frontend) Run(ctx context.Context) error {
 	}
 
 	mainWindow := NewWindow(nil, f.frontendOptions, f.versionInfo, f.chromium)
+	if opts := f.frontendOptions.Windows; opts != nil && opts.AcrylicTintOpacity != clampTintOpacity(opts.AcrylicTintOpacity) {
+		f.logger.Warning("AcrylicTintOpacity %v is out of range 0.0-1.0, it has been clamped to %v",
+			opts.AcrylicTintOpacity, clampTintOpacity(opts.AcrylicTintOpacity))
+	}
+	if opts := f.frontendOptions.Windows; opts != nil && opts.CornerPreference != windows.CornerDefault && !win32.SupportsCornerPreference() {
+		f.logger.Debug("CornerPreference is ignored, it requires Windows 11")
+	}
+	if opts := f.frontendOptions.Windows; opts != nil && opts.EnableContentProtection {
+		if err := mainWindow.setContentProtection(true); err != nil {
+			f.logger.Warning(err.Error())
+		}
+	}
+	mainWindow.OnLocaleChanged = f.notifyLocaleChanged
+	mainWindow.OnAccessibilityChanged = f.notifyAccessibilityChanged
+	mainWindow.OnSessionChange = func(change frontend.SessionChange) {
+		frontend.SessionChanged(f.ctx, change)
+	}
+	mainWindow.OnTaskbarButtonCreated = func() {
+		f.taskbar.buttonCreated(mainWindow.Handle())
+	}
+	mainWindow.OnThumbbarButtonClicked = f.taskbar.buttonClicked
+	mainWindow.OnMenuChanged = func() {
+		frontend.MenuChanged(f.ctx, mainWindow.applicationMenu)
+	}
 	f.mainWindow = mainWindow
 
+	if f.frontendOptions.Windows != nil {
+		f.suppressedKeys = acceleratorsToWincShortcuts(f.frontendOptions.Windows.SuppressedKeys)
+	}
+
+	if f.frontendOptions.Windows != nil && f.frontendOptions.Windows.KeyboardHook != nil {
+		f.keyboardHook = installKeyboardHook(mainWindow, f.frontendOptions.Windows.KeyboardHook)
+		if f.keyboardHook == nil {
+			f.logger.Error("Unable to install the keyboard hook")
+		}
+	}
+
+	f.imeHook = installIMEHook(mainWindow, func(phase frontend.IMECompositionPhase) {
+		f.imeComposition.Update(f.ctx, frontend.IMEComposition{Phase: phase})
+	})
+
+	go func() {
+		if err := frontend.WatchNetworkStatus(f.ctx); err != nil {
+			f.logger.Debug("Unable to watch the network status: %s", err.Error())
+		}
+	}()
+
 	var _debug = ctx.Value("debug")
 	var _devtoolsEnabled = ctx.Value("devtoolsEnabled")
 
@@ -158,8 +241,13 @@ func (f *Frontend) Run(ctx context.Context) error {
 	if _devtoolsEnabled != nil {
 		f.devtoolsEnabled = _devtoolsEnabled.(bool)
 	}
+	if opts := f.frontendOptions.Windows; opts != nil {
+		f.devtoolsEnabled = f.devtoolsEnabled || opts.EnableDevTools
+		f.userAgent = opts.WebviewUserAgent
+	}
 
-	f.WindowCenter()
+	_ = f.WindowCenter()
+	f.restoreWindowState()
 	f.setupChromium()
 
 	mainWindow.OnSize().Bind(func(arg *winc.Event) {
@@ -189,10 +277,12 @@ func (f *Frontend) Run(ctx context.Context) error {
 			f.resizeDebouncer(func() {
 				f.mainWindow.Invoke(func() {
 					f.chromium.Resize()
+					f.navigationProgress.layout()
 				})
 			})
 		} else {
 			f.chromium.Resize()
+			f.navigationProgress.layout()
 		}
 	})
 
@@ -223,22 +313,39 @@ func (f *Frontend) RunMainLoop() {
 	_ = winc.RunMainLoop()
 }
 
-func (f *Frontend) WindowCenter() {
+func (f *Frontend) WindowCenter() error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	f.mainWindow.Center()
+	return nil
 }
 
-func (f *Frontend) WindowSetAlwaysOnTop(b bool) {
+func (f *Frontend) WindowSetLevel(level options.WindowLevel) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	f.mainWindow.SetLevel(int(level))
+	return nil
+}
+
+// WindowSetAnimationsEnabled enables or disables the animations of the window
+func (f *Frontend) WindowSetAnimationsEnabled(enabled bool) {
+	f.mainWindow.Invoke(func() {
+		win32.SetTransitionsEnabled(f.mainWindow.Handle(), enabled)
+	})
+}
+
+func (f *Frontend) WindowSetAlwaysOnTop(b bool) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	f.mainWindow.SetAlwaysOnTop(b)
+	return nil
 }
 
-func (f *Frontend) WindowSetPosition(x, y int) {
+func (f *Frontend) WindowSetPosition(x, y int) error {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 	f.mainWindow.SetPos(x, y)
+	return nil
 }
 func (f *Frontend) WindowGetPosition() (int, int) {
 	runtime.LockOSThread()
@@ -252,6 +359,20 @@ func (f *Frontend) WindowSetSize(width, height int) {
 	f.mainWindow.SetSize(width, height)
 }
 
+// WindowSetSizeToContent measures the content in a goroutine, as the script is evaluated on the main thread
+func (f *Frontend) WindowSetSizeToContent() {
+	go func() {
+		width, height, err := frontend.MeasureContent(f.evalScript)
+		if err != nil {
+			f.logger.Error("WindowSetSizeToContent: %s", err)
+			return
+		}
+		f.mainWindow.Invoke(func() {
+			f.mainWindow.SizeToContent(width, height)
+		})
+	}()
+}
+
 func (f *Frontend) WindowGetSize() (int, int) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
@@ -273,6 +394,20 @@ func (f *Frontend) WindowFullscreen() {
 	f.mainWindow.Fullscreen()
 }
 
+func (f *Frontend) WindowSetBorderlessFullscreen(screen int) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	monitor, err := getMonitor(screen)
+	if err != nil {
+		return err
+	}
+	if f.frontendOptions.Frameless && f.frontendOptions.DisableResize == false {
+		f.ExecJS("window.wails.flags.enableResize = false;")
+	}
+	f.mainWindow.BorderlessFullscreen(monitor)
+	return nil
+}
+
 func (f *Frontend) WindowReloadApp() {
 	f.ExecJS(fmt.Sprintf("window.location.href = '%s';", f.startURL))
 }
@@ -411,6 +546,10 @@ func (f *Frontend) ScreenGetAll() ([]Screen, error) {
 	return screens, err
 }
 
+func (f *Frontend) GetDisplayServer() string {
+	return ""
+}
+
 func (f *Frontend) Show() {
 	f.mainWindow.Show()
 }
@@ -441,24 +580,139 @@ func (f *Frontend) Quit() {
 	}
 	// Exit must be called on the Main-Thread. It calls PostQuitMessage which sends the WM_QUIT message to the thread's
 	// message queue and our message queue runs on the Main-Thread.
-	f.mainWindow.Invoke(winc.Exit)
+	f.mainWindow.Invoke(func() {
+		f.saveWindowState()
+		f.mainWindow.releaseModalParent()
+		f.keyboardHook.uninstall()
+		f.mouseForwarder.uninstall()
+		f.imeHook.uninstall()
+		f.originZoom.close(f.chromium.GetController())
+		winc.Exit()
+	})
+}
+
+// PerformHaptic is not supported on Windows
+func (f *Frontend) PerformHaptic(pattern string) {}
+
+// TouchBarSet is not supported on Windows
+func (f *Frontend) TouchBarSet(items []frontend.TouchBarItem) {}
+
+// FindInPage selects the next match of the text in the page. The ICoreWebView2Find API of recent runtimes isn't
+// available through go-webview2, so the page is searched with window.find
+// FindInPage searches the page in a goroutine, as the script is evaluated on the main thread
+func (f *Frontend) FindInPage(text string, options frontend.FindOptions) {
+	go func() {
+		if err := frontend.FindWithScript(f.ctx, f.evalScript, text, options); err != nil {
+			f.logger.Error("FindInPage: %s", err)
+		}
+	}()
+}
+
+func (f *Frontend) StopFind() {
+	f.ExecJS(frontend.StopFindScript)
+}
+
+// IMEIsComposing returns true while an input method composition is in progress in the page
+func (f *Frontend) IMEIsComposing() bool {
+	return f.imeComposition.IsComposing()
 }
 
 func (f *Frontend) WindowPrint() {
 	f.ExecJS("window.print();")
 }
 
+func (f *Frontend) WindowSetBlurRegion(rects []frontend.Rect) {
+	if f.frontendOptions.Windows == nil || !f.frontendOptions.Windows.WindowIsTranslucent {
+		f.logger.Warning("WindowSetBlurRegion requires Windows.WindowIsTranslucent to be enabled")
+		return
+	}
+
+	f.mainWindow.Invoke(func() {
+		dpix, dpiy := f.mainWindow.GetWindowDPI()
+		regions := make([]win32.RECT, 0, len(rects))
+		for _, rect := range rects {
+			regions = append(regions, win32.RECT{
+				Left:   int32(winc.ScaleWithDPI(rect.X, uint(dpix))),
+				Top:    int32(winc.ScaleWithDPI(rect.Y, uint(dpiy))),
+				Right:  int32(winc.ScaleWithDPI(rect.X+rect.Width, uint(dpix))),
+				Bottom: int32(winc.ScaleWithDPI(rect.Y+rect.Height, uint(dpiy))),
+			})
+		}
+		if err := win32.SetBlurRegion(f.mainWindow.Handle(), regions); err != nil {
+			f.logger.Error(err.Error())
+		}
+	})
+}
+
+func (f *Frontend) WindowSetBackdropType(backdrop windows.BackdropType) error {
+	if backdrop < windows.Auto || backdrop > windows.Tabbed {
+		return fmt.Errorf("unknown backdrop type: %d", backdrop)
+	}
+	if !win32.SupportsBackdropTypes() {
+		return errors.New("the backdrop type can only be changed on Windows 11 22621 or later")
+	}
+	if f.frontendOptions.Windows == nil || !f.frontendOptions.Windows.WindowIsTranslucent {
+		// The backdrop is drawn behind the webview, which is only transparent when the window is translucent
+		return errors.New("the backdrop type can only be changed when Windows.WindowIsTranslucent is enabled")
+	}
+
+	f.mainWindow.Invoke(func() {
+		f.mainWindow.setBackdrop(backdrop)
+	})
+	return nil
+}
+
+// WindowSetCustomTheme overrides the CustomTheme of the options for the window, nil restores it
+func (f *Frontend) WindowSetCustomTheme(theme *windows.ThemeSettings) {
+	f.mainWindow.SetCustomTheme(theme)
+}
+
+// webviewUserDataPath returns the user data folder of WebView2
+func (f *Frontend) webviewUserDataPath() string {
+	if opts := f.frontendOptions.Windows; opts != nil && opts.WebviewUserDataPath != "" {
+		// The variables have been checked by the preflight checks
+		path, _ := windows.ExpandPath(opts.WebviewUserDataPath)
+		return path
+	}
+	// The default of go-webview2
+	executable, _ := os.Executable()
+	return filepath.Join(os.Getenv("AppData"), filepath.Base(executable))
+}
+
+// setupChromium embeds the webview in the window and sets up the state of the frontend which outlives a webview
 func (f *Frontend) setupChromium() {
+	f.originZoom = newOriginZoom(false, "", 0)
+	if opts := f.frontendOptions.Windows; opts != nil {
+		f.originZoom = newOriginZoom(opts.PersistZoomPerOrigin, f.webviewUserDataPath(), opts.ZoomFactor)
+	}
+	f.navigationProgress = newNavigationProgress(f.mainWindow, f.frontendOptions.ShowNavigationProgress, func(progress float64) {
+		frontend.NavigationProgressChanged(f.ctx, progress)
+	})
+
+	f.embedChromium()
+
+	// Setup focus event handler
+	onFocus := f.mainWindow.OnSetFocus()
+	onFocus.Bind(f.onFocus)
+}
+
+// embedChromium configures f.chromium, embeds it in the window and navigates it to the start page. It is called again
+// with a new chromium when the webview is recreated.
+func (f *Frontend) embedChromium() {
 	chromium := f.chromium
 
+	enableFeatures := []string{}
 	disableFeatues := []string{}
-	if !f.frontendOptions.EnableFraudulentWebsiteDetection {
+	smartScreenDisabled := f.frontendOptions.Windows != nil && f.frontendOptions.Windows.DisableSmartScreen
+	if !f.frontendOptions.EnableFraudulentWebsiteDetection || smartScreenDisabled {
 		disableFeatues = append(disableFeatues, "msSmartScreenProtection")
 	}
 
 	if opts := f.frontendOptions.Windows; opts != nil {
-		chromium.DataPath = opts.WebviewUserDataPath
-		chromium.BrowserPath = opts.WebviewBrowserPath
+		if opts.WebviewUserDataPath != "" {
+			chromium.DataPath = f.webviewUserDataPath()
+		}
+		chromium.BrowserPath, _ = windows.ExpandPath(opts.WebviewBrowserPath)
 
 		if opts.WebviewGpuIsDisabled {
 			chromium.AdditionalBrowserArgs = append(chromium.AdditionalBrowserArgs, "--disable-gpu")
@@ -466,6 +720,39 @@ func (f *Frontend) setupChromium() {
 		if opts.WebviewDisableRendererCodeIntegrity {
 			disableFeatues = append(disableFeatues, "RendererCodeIntegrity")
 		}
+		if opts.EnabledSwipeGestures()&windows.SwipeVertical == 0 {
+			// The overscroll of WebView2 can only be disabled with the feature of Chromium
+			disableFeatues = append(disableFeatues, "ElasticOverscroll")
+		}
+		if opts.WebviewScrollbarStyle == windows.ScrollbarStyleOverlay {
+			enableFeatures = append(enableFeatures, "msOverlayScrollbarWinStyle", "msOverlayScrollbarWinStyleAnimation")
+		}
+		if opts.WebviewProxy != nil {
+			chromium.AdditionalBrowserArgs = append(chromium.AdditionalBrowserArgs, opts.WebviewProxy.BrowserArguments()...)
+		}
+		for _, arg := range opts.WebviewBrowserArguments {
+			// Only the last --enable-features and --disable-features are used, so they are merged with the ones above
+			if features, ok := strings.CutPrefix(arg, "--enable-features="); ok {
+				enableFeatures = append(enableFeatures, features)
+			} else if features, ok := strings.CutPrefix(arg, "--disable-features="); ok {
+				disableFeatues = append(disableFeatues, features)
+			} else if arg != "" {
+				chromium.AdditionalBrowserArgs = append(chromium.AdditionalBrowserArgs, arg)
+			}
+		}
+	}
+
+	if f.frontendOptions.DisableBackgroundThrottling {
+		chromium.AdditionalBrowserArgs = append(chromium.AdditionalBrowserArgs,
+			"--disable-background-timer-throttling",
+			"--disable-backgrounding-occluded-windows",
+			"--disable-renderer-backgrounding")
+		disableFeatues = append(disableFeatues, "CalculateNativeWinOcclusion")
+	}
+
+	if len(enableFeatures) > 0 {
+		arg := fmt.Sprintf("--enable-features=%s", strings.Join(enableFeatures, ","))
+		chromium.AdditionalBrowserArgs = append(chromium.AdditionalBrowserArgs, arg)
 	}
 
 	if len(disableFeatues) > 0 {
@@ -483,7 +770,11 @@ func (f *Frontend) setupChromium() {
 	chromium.MessageWithAdditionalObjectsCallback = f.processMessageWithAdditionalObjects
 	chromium.WebResourceRequestedCallback = f.processRequest
 	chromium.NavigationCompletedCallback = f.navigationCompleted
+	chromium.ContainsFullScreenElementChangedCallback = f.containsFullScreenElementChanged
 	chromium.AcceleratorKeyCallback = func(vkey uint) bool {
+		if f.isBlockedClipboardShortcut(vkey) || f.isSuppressedKey(vkey) {
+			return true
+		}
 		if vkey == w32.VK_F12 && f.devtoolsEnabled {
 			var keyState [256]byte
 			if w32.GetKeyboardState(keyState[:]) {
@@ -507,19 +798,37 @@ func (f *Frontend) setupChromium() {
 		}
 
 		f.logger.Error("WebVie2wProcess failed with kind %d", kind)
+		if _, err := crashreport.Write("webview2", fmt.Sprintf("The WebView2 process failed with kind %d.\nThe crash dumps of WebView2 are in %s\n",
+			kind, filepath.Join(f.webviewUserDataPath(), "EBWebView", "Crashpad", "reports"))); err != nil {
+			f.logger.Error("Unable to write the crash report: %s", err.Error())
+		}
+		restart := f.webviewCrashed(kind)
 		switch kind {
 		case edge.COREWEBVIEW2_PROCESS_FAILED_KIND_BROWSER_PROCESS_EXITED:
 			// => The app has to recreate a new WebView to recover from this failure.
+			if restart {
+				f.recreateWebview()
+				return
+			}
 			messages := windows.DefaultMessages()
 			if f.frontendOptions.Windows != nil && f.frontendOptions.Windows.Messages != nil {
 				messages = f.frontendOptions.Windows.Messages
 			}
-			winc.Errorf(f.mainWindow, messages.WebView2ProcessCrash)
+			message := windows.FormatMessage(messages.WebView2ProcessCrash, map[string]string{"appName": f.frontendOptions.Title})
+			winc.Errorf(f.mainWindow, message)
 			os.Exit(-1)
+		case edge.COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_UNRESPONSIVE:
+			// => The page is reloaded to recover, otherwise WebView2 keeps waiting for the renderer.
+			if restart {
+				chromium.Navigate(f.startURL.String())
+			}
 		case edge.COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_EXITED,
 			edge.COREWEBVIEW2_PROCESS_FAILED_KIND_FRAME_RENDER_PROCESS_EXITED:
 			// => A new render process is created automatically and navigated to an error page.
-			// => Make sure that the error page is shown.
+			// => Make sure that the error page is shown, or load the app again to recover.
+			if restart && kind == edge.COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_EXITED {
+				chromium.Navigate(f.startURL.String())
+			}
 			if !f.hasStarted {
 				// NavgiationCompleted didn't come in, make sure the chromium is shown
 				chromium.Show()
@@ -532,9 +841,33 @@ func (f *Frontend) setupChromium() {
 	}
 
 	chromium.Embed(f.mainWindow.Handle())
+	if f.frontendOptions.DisableWebviewTextSelection || f.frontendOptions.DisableWebviewCopy {
+		chromium.Init(frontend.TextSelectionScript(f.frontendOptions.DisableWebviewTextSelection, f.frontendOptions.DisableWebviewCopy))
+	}
+	if f.frontendOptions.Windows != nil && f.frontendOptions.Windows.DisableWebviewHistoryNavigation {
+		chromium.Init(historyNavigationScript)
+	}
+	if f.frontendOptions.Windows != nil {
+		for _, script := range f.frontendOptions.Windows.WebviewPreloadScripts {
+			chromium.Init(script)
+		}
+	}
+	if webview, err := chromium.GetController().GetCoreWebView2(); err == nil {
+		f.permissionRequested = f.addPermissionRequestedHandler(webview)
+		f.newWindowRequested = f.addNewWindowRequestedHandler(webview)
+		f.downloads = f.addDownloadHandlers(webview)
+		f.proxyAuthentication = f.addProxyAuthenticationHandler(webview)
+		f.navigationProgress.attach(webview)
+		if opts := f.frontendOptions.Windows; opts != nil && opts.TrackingPreventionLevel != windows.TrackingPreventionDefault {
+			if err := setTrackingPreventionLevel(webview, opts.TrackingPreventionLevel); err != nil {
+				f.logger.Warning("Unable to set the tracking prevention level, WebView2 Runtime 1.0.1722.45 or later is required: %s", err)
+			}
+		}
+	}
 
 	if chromium.HasCapability(edge.SwipeNavigation) {
-		swipeGesturesEnabled := f.frontendOptions.Windows != nil && f.frontendOptions.Windows.EnableSwipeGestures
+		swipeGesturesEnabled := f.frontendOptions.Windows != nil &&
+			f.frontendOptions.Windows.EnabledSwipeGestures()&windows.SwipeHorizontal != 0
 		err := chromium.PutIsSwipeNavigationEnabled(swipeGesturesEnabled)
 		if err != nil {
 			log.Fatal(err)
@@ -545,7 +878,11 @@ func (f *Frontend) setupChromium() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	err = settings.PutAreDefaultContextMenusEnabled(f.debug || f.frontendOptions.EnableDefaultContextMenu)
+	defaultContextMenuEnabled := f.debug || f.frontendOptions.EnableDefaultContextMenu
+	if f.frontendOptions.Windows != nil && f.frontendOptions.Windows.DisableDefaultContextMenu {
+		defaultContextMenuEnabled = false
+	}
+	err = settings.PutAreDefaultContextMenusEnabled(defaultContextMenuEnabled)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -568,6 +905,7 @@ func (f *Frontend) setupChromium() {
 		}
 	}
 
+	f.setupUserAgent(settings)
 	err = settings.PutIsStatusBarEnabled(false)
 	if err != nil {
 		log.Fatal(err)
@@ -581,10 +919,6 @@ func (f *Frontend) setupChromium() {
 		chromium.OpenDevToolsWindow()
 	}
 
-	// Setup focus event handler
-	onFocus := f.mainWindow.OnSetFocus()
-	onFocus.Bind(f.onFocus)
-
 	// Set background colour
 	f.WindowSetBackgroundColour(f.frontendOptions.BackgroundColour)
 
@@ -616,8 +950,10 @@ func (f *Frontend) processRequest(req *edge.ICoreWebView2WebResourceRequest, arg
 	// we want to just append our ApplicationIdentifier. So we adjust the UserAgent for every request.
 	if reqHeaders, err := req.GetHeaders(); err == nil {
 		useragent, _ := reqHeaders.GetHeader(assetserver.HeaderUserAgent)
-		useragent = strings.Join([]string{useragent, assetserver.WailsUserAgentValue}, " ")
-		reqHeaders.SetHeader(assetserver.HeaderUserAgent, useragent)
+		if !strings.HasSuffix(useragent, " "+assetserver.WailsUserAgentValue) {
+			useragent = strings.Join([]string{useragent, assetserver.WailsUserAgentValue}, " ")
+			reqHeaders.SetHeader(assetserver.HeaderUserAgent, useragent)
+		}
 		reqHeaders.Release()
 	}
 
@@ -628,6 +964,7 @@ func (f *Frontend) processRequest(req *edge.ICoreWebView2WebResourceRequest, arg
 
 	//Get the request
 	uri, _ := req.GetUri()
+
 	reqUri, err := url.ParseRequestURI(uri)
 	if err != nil {
 		f.logger.Error("Unable to parse equest uri %s: %s", uri, err)
@@ -642,7 +979,18 @@ func (f *Frontend) processRequest(req *edge.ICoreWebView2WebResourceRequest, arg
 		return
 	}
 
-	webviewRequest, err := webview.NewRequest(
+	webviewRequest, err := f.newWebviewRequest(args)
+	if err != nil {
+		f.logger.Error("%s: NewRequest failed: %s", uri, err)
+		return
+	}
+
+	f.assets.ServeWebViewRequest(webviewRequest)
+}
+
+// newWebviewRequest returns the request of the event, its response is completed on the main thread
+func (f *Frontend) newWebviewRequest(args *edge.ICoreWebView2WebResourceRequestedEventArgs) (webview.Request, error) {
+	return webview.NewRequest(
 		f.chromium.Environment(),
 		args,
 		func(fn func()) {
@@ -660,13 +1008,6 @@ func (f *Frontend) processRequest(req *edge.ICoreWebView2WebResourceRequest, arg
 				fn()
 			}
 		})
-
-	if err != nil {
-		f.logger.Error("%s: NewRequest failed: %s", uri, err)
-		return
-	}
-
-	f.assets.ServeWebViewRequest(webviewRequest)
 }
 
 var edgeMap = map[string]uintptr{
@@ -702,6 +1043,20 @@ func (f *Frontend) processMessage(message string) {
 		)
 
 		f.ExecJS(cmd)
+		f.ExecJS(frontend.IMECompositionScript)
+		if !f.frontendOptions.DisableDoubleClickMaximise {
+			f.ExecJS(frontend.DragRegionDoubleClickScript)
+		}
+		return
+	}
+
+	if strings.HasPrefix(message, frontend.IMECompositionMessage) {
+		composition, err := frontend.ParseIMECompositionMessage(message)
+		if err != nil {
+			f.logger.Error(err.Error())
+			return
+		}
+		f.imeComposition.Update(f.ctx, composition)
 		return
 	}
 
@@ -822,6 +1177,19 @@ func (f *Frontend) startDrag() error {
 	return nil
 }
 
+func (f *Frontend) WindowStartConstrainedDrag(constraints frontend.DragConstraints) {
+	f.mainWindow.Invoke(func() {
+		if f.mainWindow.IsFullScreen() {
+			return
+		}
+		f.mainWindow.setDragConstraints(&constraints)
+		if err := f.startDrag(); err != nil {
+			f.mainWindow.setDragConstraints(nil)
+			f.logger.Error(err.Error())
+		}
+	})
+}
+
 func (f *Frontend) startResize(border uintptr) error {
 	if !w32.ReleaseCapture() {
 		return fmt.Errorf("unable to release mouse capture")
@@ -837,7 +1205,30 @@ func (f *Frontend) ExecJS(js string) {
 	})
 }
 
+func (f *Frontend) containsFullScreenElementChanged(sender *edge.ICoreWebView2, args *edge.ICoreWebView2ContainsFullScreenElementChangedEventArgs) {
+	fullscreen, err := sender.GetContainsFullScreenElement()
+	if err != nil {
+		f.logger.Error("GetContainsFullScreenElement: %s", err)
+		return
+	}
+
+	if !f.frontendOptions.DisableAutoFullscreen {
+		if fullscreen && !f.mainWindow.IsFullScreen() {
+			f.elementFullscreen = true
+			f.WindowFullscreen()
+		} else if !fullscreen && f.elementFullscreen {
+			f.elementFullscreen = false
+			f.WindowUnfullscreen()
+		}
+	}
+
+	frontend.FullscreenChanged(f.ctx, fullscreen)
+}
+
 func (f *Frontend) navigationCompleted(sender *edge.ICoreWebView2, args *edge.ICoreWebView2NavigationCompletedEventArgs) {
+	f.originZoom.navigated(sender, f.chromium.GetController())
+	f.navigationProgress.completed()
+
 	if f.frontendOptions.OnDomReady != nil {
 		go f.frontendOptions.OnDomReady(f.ctx)
 	}
@@ -887,6 +1278,9 @@ func (f *Frontend) navigationCompleted(sender *edge.ICoreWebView2, args *edge.IC
 		}
 		win32.ShowWindow(f.mainWindow.Handle())
 	}
+	if f.frontendOptions.StartActivated && f.frontendOptions.WindowStartState != options.Minimised {
+		win32.ForceForeground(f.mainWindow.Handle())
+	}
 
 	f.mainWindow.hasBeenShown = true
 
@@ -921,18 +1315,63 @@ func (f *Frontend) ShowWindow() {
 				win32.ShowWindow(f.mainWindow.Handle())
 			}
 		}
-		w32.SetForegroundWindow(f.mainWindow.Handle())
+		if f.frontendOptions.FocusOnShow {
+			win32.ForceForeground(f.mainWindow.Handle())
+		} else {
+			w32.SetForegroundWindow(f.mainWindow.Handle())
+		}
 		w32.SetFocus(f.mainWindow.Handle())
 	})
 
 }
 
+func (f *Frontend) WindowFocus() {
+	f.mainWindow.Invoke(func() {
+		f.mainWindow.hasBeenShown = true
+		win32.ForceForeground(f.mainWindow.Handle())
+		w32.SetFocus(f.mainWindow.Handle())
+	})
+}
+
+// WindowFlash flashes the taskbar button of the window once, or until the window is activated if untilFocused is
+// true. It does nothing if the window is the foreground window.
+func (f *Frontend) WindowFlash(untilFocused bool) {
+	f.mainWindow.Invoke(func() {
+		hwnd := f.mainWindow.Handle()
+		if win32.IsForeground(hwnd) {
+			return
+		}
+		win32.FlashWindow(hwnd, untilFocused)
+	})
+}
+
+// isBlockedClipboardShortcut returns true if the key is a shortcut to copy or cut and DisableWebviewCopy is set
+func (f *Frontend) isBlockedClipboardShortcut(vkey uint) bool {
+	if !f.frontendOptions.DisableWebviewCopy {
+		return false
+	}
+	var keyState [256]byte
+	if !w32.GetKeyboardState(keyState[:]) {
+		return false
+	}
+	ctrl := keyState[w32.VK_CONTROL]&0x80 != 0
+	shift := keyState[w32.VK_SHIFT]&0x80 != 0
+	return ctrl && (vkey == 'C' || vkey == 'X' || vkey == w32.VK_INSERT) || shift && vkey == w32.VK_DELETE
+}
+
+// isSuppressedKey returns true if the key with the modifiers which are down is one of the SuppressedKeys
+func (f *Frontend) isSuppressedKey(vkey uint) bool {
+	shortcut := winc.Shortcut{Modifiers: winc.ModifiersDown(), Key: winc.Key(vkey)}
+	return slices.Contains(f.suppressedKeys, shortcut)
+}
+
 func (f *Frontend) onFocus(arg *winc.Event) {
 	f.chromium.Focus()
 }
 
 func (f *Frontend) startSecondInstanceProcessor() {
 	for secondInstanceData := range secondInstanceBuffer {
+		f.launch.Launched(f.ctx, frontend.ParseLaunchArgs(secondInstanceData.Args, secondInstanceData.WorkingDirectory), f.ExecJS)
 		if f.frontendOptions.SingleInstanceLock != nil &&
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch != nil {
 			f.frontendOptions.SingleInstanceLock.OnSecondInstanceLaunch(secondInstanceData)
@@ -0,0 +1,14 @@
+//go:build windows
+
+package windows
+
+// historyNavigationScript stops the back and forward buttons of the mouse from navigating the webview. The webview
+// navigates on the mouseup of the buttons unless its default action is prevented, the propagation isn't stopped so the
+// page still receives the mousedown, mouseup and auxclick events of the buttons.
+const historyNavigationScript = `(function() {
+	window.addEventListener("mouseup", function(event) {
+		if (event.button === 3 || event.button === 4) {
+			event.preventDefault();
+		}
+	}, true);
+})();`
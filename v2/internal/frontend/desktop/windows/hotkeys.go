@@ -0,0 +1,138 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+	"golang.org/x/sys/windows"
+)
+
+const hotkeysClassName = "wails-hotkeys"
+
+// The RegisterHotKey modifiers of the winc modifiers
+var hotkeyModifiers = map[winc.Modifiers]uint{
+	winc.ModShift:   win32.MOD_SHIFT,
+	winc.ModControl: win32.MOD_CONTROL,
+	winc.ModAlt:     win32.MOD_ALT,
+}
+
+// globalHotkeys receives the WM_HOTKEY messages of the global hotkeys with a hidden message window. The window is
+// created on the main thread when the first hotkey is registered, the hotkeys are only used on the main thread.
+type globalHotkeys struct {
+	hwnd     w32.HWND
+	handlers map[int]func()
+	nextID   int
+}
+
+// register registers the hotkey of the accelerator and returns its id
+func (g *globalHotkeys) register(accelerator *keys.Accelerator, handler func()) (int, error) {
+	if accelerator == nil {
+		return 0, errors.New("no hotkey given")
+	}
+	shortcut := acceleratorToWincShortcut(accelerator)
+	if shortcut == winc.NoShortcut {
+		return 0, fmt.Errorf("invalid hotkey: %s", keys.Stringify(accelerator, "windows"))
+	}
+	if g.hwnd == 0 {
+		g.hwnd = g.createWindow()
+		if g.hwnd == 0 {
+			return 0, errors.New("unable to create the window of the global hotkeys")
+		}
+	}
+
+	// MOD_NOREPEAT stops the hotkey from being repeated while it is held down
+	modifiers := uint(win32.MOD_NOREPEAT)
+	for modifier, hotkeyModifier := range hotkeyModifiers {
+		if shortcut.Modifiers&modifier != 0 {
+			modifiers |= hotkeyModifier
+		}
+	}
+	id := g.nextID
+	if err := win32.RegisterHotKey(uintptr(g.hwnd), id, modifiers, uint(shortcut.Key)); err != nil {
+		if errors.Is(err, win32.ERROR_HOTKEY_ALREADY_REGISTERED) {
+			return 0, fmt.Errorf("the hotkey %s is already registered by another application", keys.Stringify(accelerator, "windows"))
+		}
+		return 0, fmt.Errorf("unable to register the hotkey %s: %w", keys.Stringify(accelerator, "windows"), err)
+	}
+	g.nextID++
+	if g.handlers == nil {
+		g.handlers = make(map[int]func())
+	}
+	g.handlers[id] = handler
+	return id, nil
+}
+
+// unregister removes the hotkey, it does nothing if the id isn't registered
+func (g *globalHotkeys) unregister(id int) {
+	if _, ok := g.handlers[id]; !ok {
+		return
+	}
+	win32.UnregisterHotKey(uintptr(g.hwnd), id)
+	delete(g.handlers, id)
+}
+
+// createWindow creates the hidden message window receiving WM_HOTKEY
+func (g *globalHotkeys) createWindow() w32.HWND {
+	wndProc := func(hwnd w32.HWND, msg uint32, wparam w32.WPARAM, lparam w32.LPARAM) w32.LRESULT {
+		if msg == w32.WM_HOTKEY {
+			// The handlers are called outside of the message loop, so they can call the runtime
+			if handler := g.handlers[int(wparam)]; handler != nil {
+				go handler()
+			}
+			return 0
+		}
+		return w32.DefWindowProc(hwnd, msg, wparam, lparam)
+	}
+
+	var class w32.WNDCLASSEX
+	class.Size = uint32(unsafe.Sizeof(class))
+	class.WndProc = syscall.NewCallback(wndProc)
+	class.Instance = w32.GetModuleHandle("")
+	class.ClassName = windows.StringToUTF16Ptr(hotkeysClassName)
+	w32.RegisterClassEx(&class)
+
+	return w32.CreateWindowEx(
+		0,
+		windows.StringToUTF16Ptr(hotkeysClassName),
+		nil,
+		0,
+		0,
+		0,
+		0,
+		0,
+		w32.HWND_MESSAGE,
+		0,
+		w32.GetModuleHandle(""),
+		nil,
+	)
+}
+
+// RegisterGlobalHotkey registers a system wide hotkey, the handler is called when it is pressed
+func (f *Frontend) RegisterGlobalHotkey(accelerator *keys.Accelerator, handler func()) (int, error) {
+	type registerResult struct {
+		id  int
+		err error
+	}
+	results := make(chan registerResult, 1)
+	f.mainWindow.Invoke(func() {
+		id, err := f.hotkeys.register(accelerator, handler)
+		results <- registerResult{id: id, err: err}
+	})
+	result := <-results
+	return result.id, result.err
+}
+
+// UnregisterGlobalHotkey removes a hotkey registered by RegisterGlobalHotkey
+func (f *Frontend) UnregisterGlobalHotkey(id int) {
+	f.mainWindow.Invoke(func() {
+		f.hotkeys.unregister(id)
+	})
+}
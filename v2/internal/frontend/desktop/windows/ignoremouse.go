@@ -0,0 +1,124 @@
+//go:build windows
+
+package windows
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// forwardedMouseMoveScript dispatches a mousemove event to the element at the position in device pixels, the event
+// isn't trusted and doesn't update the :hover state
+const forwardedMouseMoveScript = `(function(x, y) {
+	var ratio = window.devicePixelRatio || 1;
+	var clientX = x / ratio, clientY = y / ratio;
+	var target = document.elementFromPoint(clientX, clientY) || document.documentElement;
+	target.dispatchEvent(new MouseEvent("mousemove", {bubbles: true, cancelable: true, clientX: clientX, clientY: clientY}));
+})(%d, %d);`
+
+type msllHookStruct struct {
+	x, y      int32
+	mouseData uint32
+	flags     uint32
+	time      uint32
+	extraInfo uintptr
+}
+
+// setIgnoreMouseEvents lets the mouse events fall through the window to the windows beneath it. The window is made
+// layered, which is required by WS_EX_TRANSPARENT. It must be called on the main thread.
+func (w *Window) setIgnoreMouseEvents(ignore bool) {
+	if ignore == w.ignoreMouseEvents {
+		return
+	}
+	w.ignoreMouseEvents = ignore
+	if ignore {
+		w.updateLayeredStyle()
+	}
+	winc.SetExStyle(w.Handle(), ignore, w32.WS_EX_TRANSPARENT)
+	if !ignore {
+		w.updateLayeredStyle()
+	}
+}
+
+// mouseForwarder forwards the mouse moves over the window to the page while the window ignores the mouse events, so
+// the page can stop ignoring them when the mouse is over an interactive element. It uses a low-level mouse hook, the
+// hook procedure is called on the main thread by the message loop and must return quickly.
+type mouseForwarder struct {
+	window *Window
+	eval   func(string)
+	hook   w32.HHOOK
+
+	lastX, lastY int
+}
+
+// activeMouseForwarder is used by the hook procedure, which can't be bound to a value
+var activeMouseForwarder *mouseForwarder
+
+// installMouseForwarder installs the mouse hook, this must be called on the main thread
+func installMouseForwarder(window *Window, eval func(string)) *mouseForwarder {
+	result := &mouseForwarder{window: window, eval: eval, lastX: -1, lastY: -1}
+	activeMouseForwarder = result
+	result.hook = w32.SetWindowsHookEx(w32.WH_MOUSE_LL, mouseForwarderProc, w32.GetModuleHandle(""), 0)
+	if result.hook == 0 {
+		activeMouseForwarder = nil
+		return nil
+	}
+	return result
+}
+
+// uninstall removes the mouse hook, this must be called on the main thread
+func (m *mouseForwarder) uninstall() {
+	if m == nil || m.hook == 0 {
+		return
+	}
+	w32.UnhookWindowsHookEx(m.hook)
+	m.hook = 0
+	activeMouseForwarder = nil
+}
+
+func mouseForwarderProc(nCode int, wParam w32.WPARAM, lParam w32.LPARAM) w32.LRESULT {
+	m := activeMouseForwarder
+	if nCode == w32.HC_ACTION && wParam == w32.WM_MOUSEMOVE && m != nil {
+		info := (*msllHookStruct)(unsafe.Pointer(lParam))
+		m.mouseMoved(int(info.x), int(info.y))
+	}
+	return w32.CallNextHookEx(0, nCode, wParam, lParam)
+}
+
+// mouseMoved forwards the position in screen coordinates if it is over the window
+func (m *mouseForwarder) mouseMoved(screenX, screenY int) {
+	hwnd := m.window.Handle()
+	rect := win32.GetWindowRect(hwnd)
+	if screenX < int(rect.Left) || screenX >= int(rect.Right) || screenY < int(rect.Top) || screenY >= int(rect.Bottom) {
+		return
+	}
+	x, y, ok := w32.ScreenToClient(hwnd, screenX, screenY)
+	if !ok || x == m.lastX && y == m.lastY {
+		return
+	}
+	m.lastX, m.lastY = x, y
+	m.eval(fmt.Sprintf(forwardedMouseMoveScript, x, y))
+}
+
+// WindowSetIgnoreMouseEvents lets the mouse events fall through the window, the mouse moves are forwarded to the page
+// if forward is true
+func (f *Frontend) WindowSetIgnoreMouseEvents(ignore bool, forward bool) {
+	f.mainWindow.Invoke(func() {
+		f.mainWindow.setIgnoreMouseEvents(ignore)
+		if ignore && forward == (f.mouseForwarder != nil) {
+			return
+		}
+		f.mouseForwarder.uninstall()
+		f.mouseForwarder = nil
+		if ignore && forward {
+			f.mouseForwarder = installMouseForwarder(f.mainWindow, f.chromium.Eval)
+			if f.mouseForwarder == nil {
+				f.logger.Warning("Unable to install the mouse hook, the mouse moves are not forwarded")
+			}
+		}
+	})
+}
@@ -0,0 +1,79 @@
+//go:build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// imeHook reports the input method compositions of the webview with a WinEvent hook. The WM_IME_* messages are sent
+// to the window of the WebView2 browser process and never reach the main window, but the IME window events are raised
+// for every process. The hook procedure is called on the main thread by the message loop.
+type imeHook struct {
+	window  *Window
+	hook    w32.HWINEVENTHOOK
+	changes chan frontend.IMECompositionPhase
+}
+
+// activeIMEHook is used by the hook procedure, which can't be bound to a value
+var activeIMEHook *imeHook
+
+// installIMEHook installs the hook, this must be called on the main thread
+func installIMEHook(window *Window, onChange func(phase frontend.IMECompositionPhase)) *imeHook {
+	result := &imeHook{
+		window:  window,
+		changes: make(chan frontend.IMECompositionPhase, 16),
+	}
+
+	activeIMEHook = result
+	result.hook = w32.SetWinEventHook(w32.EVENT_OBJECT_IME_SHOW, w32.EVENT_OBJECT_IME_CHANGE, 0, imeHookProc, 0, 0, w32.WINEVENT_OUTOFCONTEXT)
+	if result.hook == 0 {
+		activeIMEHook = nil
+		return nil
+	}
+
+	// The changes are reported in order and off the main thread, as emitting an event executes JS in the webview
+	go func() {
+		for phase := range result.changes {
+			onChange(phase)
+		}
+	}()
+	return result
+}
+
+// uninstall removes the hook, this must be called on the main thread
+func (i *imeHook) uninstall() {
+	if i == nil || i.hook == 0 {
+		return
+	}
+	w32.UnhookWinEvent(i.hook)
+	i.hook = 0
+	activeIMEHook = nil
+	close(i.changes)
+}
+
+func imeHookProc(hWinEventHook w32.HWINEVENTHOOK, event w32.DWORD, hwnd w32.HWND, idObject int32, idChild int32, idEventThread w32.DWORD, dwmsEventTime w32.DWORD) uintptr {
+	i := activeIMEHook
+	// The events of all processes are reported, only the ones raised while the window has the focus belong to it
+	if i == nil || !i.window.isActive {
+		return 0
+	}
+	var phase frontend.IMECompositionPhase
+	switch event {
+	case w32.EVENT_OBJECT_IME_SHOW:
+		phase = frontend.IMECompositionStart
+	case w32.EVENT_OBJECT_IME_CHANGE:
+		phase = frontend.IMECompositionUpdate
+	case w32.EVENT_OBJECT_IME_HIDE:
+		phase = frontend.IMECompositionEnd
+	default:
+		return 0
+	}
+	select {
+	case i.changes <- phase:
+	default:
+		// The changes are dropped rather than blocking the message loop
+	}
+	return 0
+}
@@ -0,0 +1,99 @@
+//go:build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// The layout of the input dialog in logical pixels, the buttons are the size of the date time picker ones
+const (
+	inputPadding = 12
+	inputWidth   = 320
+	inputHeight  = 24
+)
+
+// InputDialog shows a modal dialog with the message above an edit for the value
+func (f *Frontend) InputDialog(options frontend.InputDialogOptions) (string, bool) {
+	type inputResult struct {
+		value string
+		ok    bool
+	}
+	results := make(chan inputResult, 1)
+
+	f.mainWindow.Invoke(func() {
+		dlg := winc.NewDialog(f.mainWindow)
+		dlg.SetText(options.Title)
+
+		y := inputPadding
+		if options.Message != "" {
+			label := winc.NewLabel(dlg)
+			// The message is wrapped to the width of the dialog
+			winc.SetStyle(label.Handle(), false, w32.SS_LEFTNOWORDWRAP)
+			label.SetText(options.Message)
+			height := messageHeight(dlg, label, options.Message, inputWidth)
+			placeControl(dlg, label, inputPadding, y, inputWidth, height)
+			y += height + inputPadding/2
+		}
+
+		edit := winc.NewEdit(dlg)
+		edit.SetText(options.Default)
+		edit.SetPassword(options.Password)
+		if options.Placeholder != "" {
+			edit.SetCueBanner(options.Placeholder)
+		}
+		placeControl(dlg, edit, inputPadding, y, inputWidth, inputHeight)
+		y += inputHeight + inputPadding
+
+		okButton := winc.NewPushButton(dlg)
+		okButton.SetText("OK")
+		placeControl(dlg, okButton, inputPadding+inputWidth-2*pickerButtonWidth-inputPadding/2, y, pickerButtonWidth, pickerButtonHeight)
+		cancelButton := winc.NewPushButton(dlg)
+		cancelButton.SetText("Cancel")
+		placeControl(dlg, cancelButton, inputPadding+inputWidth-pickerButtonWidth, y, pickerButtonWidth, pickerButtonHeight)
+		dlg.SetButtons(okButton, cancelButton)
+
+		done := false
+		closeDialog := func(result inputResult) {
+			if done {
+				return
+			}
+			done = true
+			dlg.Close()
+			results <- result
+		}
+		okButton.OnClick().Bind(func(*winc.Event) {
+			closeDialog(inputResult{value: edit.Text(), ok: true})
+		})
+		cancelButton.OnClick().Bind(func(*winc.Event) {
+			closeDialog(inputResult{})
+		})
+
+		setClientSize(dlg, inputWidth+2*inputPadding, y+pickerButtonHeight+inputPadding)
+		dlg.Center()
+		dlg.Show()
+		edit.SetFocus()
+		edit.SelectAll()
+	})
+
+	result := <-results
+	return result.value, result.ok
+}
+
+// messageHeight returns the height in logical pixels of the text of the label wrapped to the width
+func messageHeight(dlg *winc.Dialog, label *winc.Label, text string, width int) int {
+	_, dpiy := dlg.GetWindowDPI()
+	if dpiy == 0 {
+		dpiy = 96
+	}
+	dc := w32.GetDC(label.Handle())
+	defer w32.ReleaseDC(label.Handle(), dc)
+	previous := w32.SelectObject(dc, w32.HGDIOBJ(label.Font().GetHFONT()))
+	defer w32.SelectObject(dc, previous)
+
+	rect := w32.RECT{Right: int32(winc.ScaleWithDPI(width, uint(dpiy)))}
+	w32.DrawText(dc, text, -1, &rect, w32.DT_CALCRECT|w32.DT_WORDBREAK|w32.DT_NOPREFIX)
+	return int(rect.Bottom-rect.Top)*96/int(dpiy) + 1
+}
@@ -0,0 +1,144 @@
+//go:build windows
+
+package windows
+
+import (
+	"strings"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+	winoptions "github.com/wailsapp/wails/v2/pkg/options/windows"
+)
+
+// keyboardHook captures key combinations with a low-level keyboard hook. The hook procedure is called on the main
+// thread by the message loop and must return quickly, otherwise Windows removes the hook and input lags system wide.
+type keyboardHook struct {
+	window  *Window
+	options *winoptions.KeyboardHook
+	hook    w32.HHOOK
+
+	passthrough []winc.Shortcut
+	capture     []winc.Shortcut
+	// passthroughWinKey is true if the Windows key is passed through with keys.Key("win")
+	passthroughWinKey bool
+}
+
+// winKey is the key of the accelerator which passes the Windows key through
+const winKey = "win"
+
+// activeKeyboardHook is used by the hook procedure, which can't be bound to a value
+var activeKeyboardHook *keyboardHook
+
+// installKeyboardHook installs the keyboard hook, this must be called on the main thread
+func installKeyboardHook(window *Window, options *winoptions.KeyboardHook) *keyboardHook {
+	result := &keyboardHook{
+		window:      window,
+		options:     options,
+		passthrough: acceleratorsToWincShortcuts(options.Passthrough),
+		capture:     acceleratorsToWincShortcuts(options.Capture),
+	}
+	for _, accelerator := range options.Passthrough {
+		if accelerator != nil && strings.EqualFold(accelerator.Key, winKey) {
+			result.passthroughWinKey = true
+		}
+	}
+
+	activeKeyboardHook = result
+	result.hook = w32.SetWindowsHookEx(w32.WH_KEYBOARD_LL, keyboardHookProc, w32.GetModuleHandle(""), 0)
+	if result.hook == 0 {
+		activeKeyboardHook = nil
+		return nil
+	}
+	return result
+}
+
+// uninstall removes the keyboard hook, this must be called on the main thread
+func (k *keyboardHook) uninstall() {
+	if k == nil || k.hook == 0 {
+		return
+	}
+	w32.UnhookWindowsHookEx(k.hook)
+	k.hook = 0
+	activeKeyboardHook = nil
+}
+
+func keyboardHookProc(nCode int, wParam w32.WPARAM, lParam w32.LPARAM) w32.LRESULT {
+	k := activeKeyboardHook
+	if nCode == w32.HC_ACTION && k != nil && k.window.isActive {
+		info := (*w32.KBDLLHOOKSTRUCT)(unsafe.Pointer(lParam))
+		keyDown := wParam == w32.WM_KEYDOWN || wParam == w32.WM_SYSKEYDOWN
+		if k.handleKey(winc.Key(info.VkCode), keyDown) {
+			return 1
+		}
+	}
+	return w32.CallNextHookEx(0, nCode, wParam, lParam)
+}
+
+// handleKey returns true if the key has been captured and must not be passed on
+func (k *keyboardHook) handleKey(key winc.Key, keyDown bool) bool {
+	if key == winc.KeyLWIN || key == winc.KeyRWIN {
+		// The start menu opens on key up, so both must be captured
+		return k.options.BlockSystemKeys && !k.passthroughWinKey
+	}
+	if !keyDown {
+		return false
+	}
+
+	shortcut := winc.Shortcut{Modifiers: asyncModifiersDown(), Key: key}
+	for index, captured := range k.capture {
+		if captured == shortcut {
+			if k.options.OnKeyCaptured != nil {
+				go k.options.OnKeyCaptured(k.options.Capture[index])
+			}
+			return true
+		}
+	}
+
+	if !k.options.BlockSystemKeys || !isSystemShortcut(shortcut) {
+		return false
+	}
+	for _, passthrough := range k.passthrough {
+		if passthrough == shortcut {
+			return false
+		}
+	}
+	return true
+}
+
+func isSystemShortcut(shortcut winc.Shortcut) bool {
+	alt := shortcut.Modifiers&winc.ModAlt != 0
+	ctrl := shortcut.Modifiers&winc.ModControl != 0
+	switch shortcut.Key {
+	case winc.KeyTab, winc.KeyF4:
+		return alt
+	case winc.KeyEscape:
+		return alt || ctrl
+	}
+	return false
+}
+
+// asyncModifiersDown returns the physical state of the modifiers. The low-level hook is called before the key state
+// of the thread has been updated, so winc.ModifiersDown can't be used.
+func asyncModifiersDown() winc.Modifiers {
+	var m winc.Modifiers
+	if w32.GetAsyncKeyState(w32.VK_SHIFT)&0x8000 != 0 {
+		m |= winc.ModShift
+	}
+	if w32.GetAsyncKeyState(w32.VK_CONTROL)&0x8000 != 0 {
+		m |= winc.ModControl
+	}
+	if w32.GetAsyncKeyState(w32.VK_MENU)&0x8000 != 0 {
+		m |= winc.ModAlt
+	}
+	return m
+}
+
+func acceleratorsToWincShortcuts(accelerators []*keys.Accelerator) []winc.Shortcut {
+	result := make([]winc.Shortcut, 0, len(accelerators))
+	for _, accelerator := range accelerators {
+		result = append(result, acceleratorToWincShortcut(accelerator))
+	}
+	return result
+}
@@ -0,0 +1,54 @@
+//go:build windows
+
+package windows
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+)
+
+func (f *Frontend) GetLocaleInfo() (frontend.LocaleInfo, error) {
+	var errs []error
+	get := func(lcType uint32) string {
+		value, err := win32.GetUserLocaleInfo(lcType)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return value
+	}
+
+	result := frontend.LocaleInfo{
+		Locale:             get(win32.LOCALE_SNAME),
+		DecimalSeparator:   get(win32.LOCALE_SDECIMAL),
+		ThousandsSeparator: get(win32.LOCALE_STHOUSAND),
+		ShortDateFormat:    get(win32.LOCALE_SSHORTDATE),
+		LongDateFormat:     get(win32.LOCALE_SLONGDATE),
+		TimeFormat:         get(win32.LOCALE_STIMEFORMAT),
+		CurrencySymbol:     get(win32.LOCALE_SCURRENCY),
+		CurrencyCode:       get(win32.LOCALE_SINTLSYMBOL),
+	}
+
+	// Windows starts the week with 0 for Monday
+	if day, err := strconv.Atoi(get(win32.LOCALE_IFIRSTDAYOFWEEK)); err == nil {
+		result.FirstDayOfWeek = (day + 1) % 7
+	}
+
+	if len(errs) > 0 {
+		return result, fmt.Errorf("unable to get locale info: %w", errs[0])
+	}
+	return result, nil
+}
+
+func (f *Frontend) notifyLocaleChanged() {
+	info, err := f.GetLocaleInfo()
+	if err != nil {
+		f.logger.Error(err.Error())
+		return
+	}
+	if events, ok := f.ctx.Value("events").(frontend.Events); ok {
+		events.Emit(frontend.LocaleChangeEvent, info)
+	}
+}
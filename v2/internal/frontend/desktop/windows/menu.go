@@ -5,6 +5,7 @@ package windows
 
 import (
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 )
 
@@ -50,14 +51,18 @@ func processMenu(window *Window, menu *menu.Menu) {
 		submenu := mainMenu.AddSubMenu(menuItem.Label)
 		if menuItem.SubMenu != nil {
 			for _, menuItem := range menuItem.SubMenu.Items {
-				processMenuItem(submenu, menuItem)
+				processMenuItem(window, submenu, menuItem)
 			}
 		}
 	}
 	mainMenu.Show()
+	if opts := window.frontendOptions.Windows; opts != nil && opts.HideMenuBar {
+		// The items and their accelerators stay registered, only the menu bar is removed from the window
+		w32.SetMenu(window.Handle(), 0)
+	}
 }
 
-func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
+func processMenuItem(window *Window, parent *winc.MenuItem, menuItem *menu.MenuItem) {
 	if menuItem.Hidden {
 		return
 	}
@@ -90,6 +95,9 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 		if menuItem.Click != nil {
 			newItem.OnClick().Bind(func(e *winc.Event) {
 				toggleCheckBox(menuItem)
+				if window.OnMenuChanged != nil {
+					window.OnMenuChanged()
+				}
 				menuItem.Click(&menu.CallbackData{
 					MenuItem: menuItem,
 				})
@@ -108,6 +116,9 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 		if menuItem.Click != nil {
 			newItem.OnClick().Bind(func(e *winc.Event) {
 				toggleRadioItem(menuItem)
+				if window.OnMenuChanged != nil {
+					window.OnMenuChanged()
+				}
 				menuItem.Click(&menu.CallbackData{
 					MenuItem: menuItem,
 				})
@@ -118,7 +129,7 @@ func processMenuItem(parent *winc.MenuItem, menuItem *menu.MenuItem) {
 	case menu.SubmenuType:
 		submenu := parent.AddSubMenu(menuItem.Label)
 		for _, menuItem := range menuItem.SubMenu.Items {
-			processMenuItem(submenu, menuItem)
+			processMenuItem(window, submenu, menuItem)
 		}
 	}
 }
@@ -130,3 +141,7 @@ func (f *Frontend) MenuSetApplicationMenu(menu *menu.Menu) {
 func (f *Frontend) MenuUpdateApplicationMenu() {
 	processMenu(f.mainWindow, f.mainWindow.applicationMenu)
 }
+
+func (f *Frontend) MenuGetApplicationMenu() *menu.Menu {
+	return f.mainWindow.applicationMenu
+}
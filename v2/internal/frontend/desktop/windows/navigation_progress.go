@@ -0,0 +1,280 @@
+//go:build windows
+
+package windows
+
+import (
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	iidNavigationStartingHandler = ole.NewGUID("{9ADBE429-F36D-432B-9DDC-F8881FBD76E3}")
+	iidContentLoadingHandler     = ole.NewGUID("{364471E7-F2BE-4910-BDBA-D72077D51C4B}")
+)
+
+// The vtable indexes of the ICoreWebView2 events which aren't wrapped by go-webview2
+const (
+	methodWebViewAddNavigationStarting = 7
+	methodWebViewAddContentLoading     = 9
+)
+
+// WebView2 doesn't report the progress of a load, it is estimated from the navigation events
+const (
+	navigationProgressStarted       = 0.1
+	navigationProgressContentLoaded = 0.6
+	navigationProgressCompleted     = 1.0
+)
+
+const (
+	navigationProgressClassName = "WailsNavigationProgress"
+	// navigationProgressHeight is the height of the bar at 96 DPI
+	navigationProgressHeight = 3
+	// navigationProgressHideDelay keeps the full bar visible for a moment once the page has loaded
+	navigationProgressHideDelay = 200 * time.Millisecond
+)
+
+// webviewEventHandler is a handler for an event of WebView2, invoke is called with the sender and the arguments of the
+// event. The handlers live as long as the webview, so they aren't reference counted.
+type webviewEventHandler struct {
+	vtbl   *webviewEventHandlerVtbl
+	iid    *ole.GUID
+	invoke func(sender, args *winrtObject)
+}
+
+type webviewEventHandlerVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	Invoke         uintptr
+}
+
+var (
+	webviewEventHandlerMethods     *webviewEventHandlerVtbl
+	initWebviewEventHandlerMethods sync.Once
+)
+
+// newWebviewEventHandler creates a handler for the event interface iid. The callbacks are shared by all the handlers,
+// as the number of callbacks which can be created is limited.
+func newWebviewEventHandler(iid *ole.GUID, invoke func(sender, args *winrtObject)) *webviewEventHandler {
+	initWebviewEventHandlerMethods.Do(func() {
+		webviewEventHandlerMethods = &webviewEventHandlerVtbl{
+			QueryInterface: syscall.NewCallback(func(this *webviewEventHandler, iid *ole.GUID, result *unsafe.Pointer) uintptr {
+				if ole.IsEqualGUID(iid, ole.IID_IUnknown) || ole.IsEqualGUID(iid, this.iid) {
+					*result = unsafe.Pointer(this)
+					return ole.S_OK
+				}
+				*result = nil
+				return ole.E_NOINTERFACE
+			}),
+			AddRef: syscall.NewCallback(func(this *webviewEventHandler) uintptr {
+				return 1
+			}),
+			Release: syscall.NewCallback(func(this *webviewEventHandler) uintptr {
+				return 1
+			}),
+			Invoke: syscall.NewCallback(func(this *webviewEventHandler, sender, args *winrtObject) uintptr {
+				this.invoke(sender, args)
+				return ole.S_OK
+			}),
+		}
+	})
+	return &webviewEventHandler{vtbl: webviewEventHandlerMethods, iid: iid, invoke: invoke}
+}
+
+// webviewCompletedHandler is a completion handler of an asynchronous method of WebView2, invoke is called with the
+// error code and the result of the method. The handlers are kept alive by the caller until they have been invoked.
+type webviewCompletedHandler struct {
+	vtbl   *webviewEventHandlerVtbl
+	iid    *ole.GUID
+	invoke func(errorCode uintptr, result unsafe.Pointer)
+}
+
+var (
+	webviewCompletedHandlerMethods           *webviewEventHandlerVtbl
+	webviewActionCompletedHandlerMethods     *webviewEventHandlerVtbl
+	initWebviewCompletedHandlerMethods       sync.Once
+	initWebviewActionCompletedHandlerMethods sync.Once
+)
+
+func webviewCompletedHandlerQueryInterface(this *webviewCompletedHandler, iid *ole.GUID, result *unsafe.Pointer) uintptr {
+	if ole.IsEqualGUID(iid, ole.IID_IUnknown) || ole.IsEqualGUID(iid, this.iid) {
+		*result = unsafe.Pointer(this)
+		return ole.S_OK
+	}
+	*result = nil
+	return ole.E_NOINTERFACE
+}
+
+func webviewCompletedHandlerAddRef(this *webviewCompletedHandler) uintptr {
+	return 1
+}
+
+// newWebviewCompletedHandler creates a handler for the completion interface iid, whose Invoke takes the error code and
+// a result
+func newWebviewCompletedHandler(iid *ole.GUID, invoke func(errorCode uintptr, result unsafe.Pointer)) *webviewCompletedHandler {
+	initWebviewCompletedHandlerMethods.Do(func() {
+		webviewCompletedHandlerMethods = &webviewEventHandlerVtbl{
+			QueryInterface: syscall.NewCallback(webviewCompletedHandlerQueryInterface),
+			AddRef:         syscall.NewCallback(webviewCompletedHandlerAddRef),
+			Release:        syscall.NewCallback(webviewCompletedHandlerAddRef),
+			Invoke: syscall.NewCallback(func(this *webviewCompletedHandler, errorCode uintptr, result unsafe.Pointer) uintptr {
+				this.invoke(errorCode, result)
+				return ole.S_OK
+			}),
+		}
+	})
+	return &webviewCompletedHandler{vtbl: webviewCompletedHandlerMethods, iid: iid, invoke: invoke}
+}
+
+// newWebviewActionCompletedHandler creates a handler for the completion interface iid, whose Invoke only takes the
+// error code. It needs its own callback, as the callee pops the arguments on 386.
+func newWebviewActionCompletedHandler(iid *ole.GUID, invoke func(errorCode uintptr)) *webviewCompletedHandler {
+	initWebviewActionCompletedHandlerMethods.Do(func() {
+		webviewActionCompletedHandlerMethods = &webviewEventHandlerVtbl{
+			QueryInterface: syscall.NewCallback(webviewCompletedHandlerQueryInterface),
+			AddRef:         syscall.NewCallback(webviewCompletedHandlerAddRef),
+			Release:        syscall.NewCallback(webviewCompletedHandlerAddRef),
+			Invoke: syscall.NewCallback(func(this *webviewCompletedHandler, errorCode uintptr) uintptr {
+				this.invoke(errorCode, nil)
+				return ole.S_OK
+			}),
+		}
+	})
+	return &webviewCompletedHandler{vtbl: webviewActionCompletedHandlerMethods, iid: iid, invoke: func(errorCode uintptr, _ unsafe.Pointer) {
+		invoke(errorCode)
+	}}
+}
+
+// navigationProgress emits the estimated progress of the loads of the webview and shows it with a bar at the top of
+// the window if enabled. It is only used on the main thread.
+type navigationProgress struct {
+	window   *Window
+	bar      w32.HWND
+	handlers []*webviewEventHandler
+	progress float64
+	changes  chan float64
+}
+
+var registerNavigationProgressClass sync.Once
+
+// newNavigationProgress creates the bar if showBar is set, attach has to be called with the webview. The
+// NavigationCompleted event is handled by the frontend, which calls completed.
+func newNavigationProgress(window *Window, showBar bool, onChange func(progress float64)) *navigationProgress {
+	result := &navigationProgress{
+		window:   window,
+		progress: navigationProgressCompleted,
+		changes:  make(chan float64, 16),
+	}
+	if showBar {
+		result.bar = createNavigationProgressBar(window.Handle())
+	}
+
+	// The changes are reported in order and off the main thread, as emitting an event executes JS in the webview
+	go func() {
+		for progress := range result.changes {
+			onChange(progress)
+		}
+	}()
+	return result
+}
+
+// attach registers the handlers of the navigation events of the webview, replacing the handlers of a previous webview
+func (n *navigationProgress) attach(webview *edge.ICoreWebView2) {
+	n.handlers = nil
+	n.progress = navigationProgressCompleted
+	handlers := []struct {
+		method   int
+		iid      *ole.GUID
+		progress float64
+	}{
+		{methodWebViewAddNavigationStarting, iidNavigationStartingHandler, navigationProgressStarted},
+		{methodWebViewAddContentLoading, iidContentLoadingHandler, navigationProgressContentLoaded},
+	}
+	for _, handler := range handlers {
+		progress := handler.progress
+		eventHandler := newWebviewEventHandler(handler.iid, func(_, _ *winrtObject) {
+			n.update(progress)
+		})
+		var token int64
+		if err := (*winrtObject)(unsafe.Pointer(webview)).Call(handler.method, uintptr(unsafe.Pointer(eventHandler)), uintptr(unsafe.Pointer(&token))); err != nil {
+			continue
+		}
+		n.handlers = append(n.handlers, eventHandler)
+	}
+}
+
+func createNavigationProgressBar(parent w32.HWND) w32.HWND {
+	className := windows.StringToUTF16Ptr(navigationProgressClassName)
+	registerNavigationProgressClass.Do(func() {
+		var class w32.WNDCLASSEX
+		class.Size = uint32(unsafe.Sizeof(class))
+		class.WndProc = syscall.NewCallback(w32.DefWindowProc)
+		class.Instance = w32.GetModuleHandle("")
+		class.Background = w32.COLOR_HIGHLIGHT + 1
+		class.ClassName = className
+		w32.RegisterClassEx(&class)
+	})
+	return w32.CreateWindowEx(w32.WS_EX_NOACTIVATE, className, nil, w32.WS_CHILD|w32.WS_CLIPSIBLINGS,
+		0, 0, 0, 0, parent, 0, w32.GetModuleHandle(""), nil)
+}
+
+// completed must be called when the navigation has completed
+func (n *navigationProgress) completed() {
+	if n == nil {
+		return
+	}
+	n.update(navigationProgressCompleted)
+}
+
+func (n *navigationProgress) update(progress float64) {
+	// The events of a navigation are only reported once, a new navigation restarts the progress
+	if progress <= n.progress && progress != navigationProgressStarted {
+		return
+	}
+	n.progress = progress
+	select {
+	case n.changes <- progress:
+	default:
+		// The changes are dropped rather than blocking the main thread
+	}
+	if n.bar == 0 {
+		return
+	}
+	n.layout()
+	if progress < navigationProgressCompleted {
+		w32.ShowWindow(n.bar, w32.SW_SHOWNA)
+	} else {
+		time.AfterFunc(navigationProgressHideDelay, func() {
+			n.window.Invoke(func() {
+				if n.progress == navigationProgressCompleted {
+					w32.ShowWindow(n.bar, w32.SW_HIDE)
+				}
+			})
+		})
+	}
+}
+
+// layout sizes the bar to the progress, this must be called when the window has been resized
+func (n *navigationProgress) layout() {
+	if n == nil || n.bar == 0 {
+		return
+	}
+	rect := w32.GetClientRect(n.window.Handle())
+	if rect == nil {
+		return
+	}
+	dpi, _ := n.window.GetWindowDPI()
+	if dpi == 0 {
+		dpi = 96
+	}
+	width := int(float64(rect.Right-rect.Left) * n.progress)
+	height := int(navigationProgressHeight * dpi / 96)
+	w32.SetWindowPos(n.bar, w32.HWND_TOP, 0, 0, width, height, w32.SWP_NOACTIVATE)
+}
@@ -0,0 +1,61 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	winoptions "github.com/wailsapp/wails/v2/pkg/options/windows"
+)
+
+var iidNewWindowRequestedHandler = ole.NewGUID("{D4C185FE-C81C-4989-97AF-2D3FA7AB5651}")
+
+// The vtable indexes of the NewWindowRequested event of ICoreWebView2 and its arguments
+const (
+	methodWebViewAddNewWindowRequested     = 44
+	methodNewWindowRequestedArgsGetURI     = 3
+	methodNewWindowRequestedArgsPutHandled = 6
+)
+
+// addNewWindowRequestedHandler calls OnWebviewNewWindowRequest for the requests of the pages to open new windows. It
+// returns nil if there is no callback.
+func (f *Frontend) addNewWindowRequestedHandler(webview *edge.ICoreWebView2) *webviewEventHandler {
+	opts := f.frontendOptions.Windows
+	if opts == nil || opts.OnWebviewNewWindowRequest == nil {
+		return nil
+	}
+	handler := newWebviewEventHandler(iidNewWindowRequestedHandler, func(_, args *winrtObject) {
+		target, err := getCoTaskString(args, methodNewWindowRequestedArgsGetURI)
+		if err != nil {
+			f.logger.Error("Unable to get the URI of the new window request: %s", err)
+			return
+		}
+
+		action := opts.OnWebviewNewWindowRequest(target)
+		if action == winoptions.AllowNewWindow {
+			return
+		}
+		// Handling the request stops WebView2 from opening the popup window
+		if err := args.Call(methodNewWindowRequestedArgsPutHandled, 1); err != nil {
+			f.logger.Error("Unable to handle the new window request: %s", err)
+			return
+		}
+		switch action {
+		case winoptions.OpenInDefaultBrowser:
+			go f.BrowserOpenURL(target)
+		case winoptions.OpenInSameWindow:
+			// The webview is navigated once the handler has returned
+			go f.mainWindow.Invoke(func() {
+				f.chromium.Navigate(target)
+			})
+		}
+	})
+	var token int64
+	if err := (*winrtObject)(unsafe.Pointer(webview)).Call(methodWebViewAddNewWindowRequested, uintptr(unsafe.Pointer(handler)), uintptr(unsafe.Pointer(&token))); err != nil {
+		f.logger.Error("Unable to handle the new window requests: %s", err)
+		return nil
+	}
+	return handler
+}
@@ -0,0 +1,50 @@
+//go:build windows
+
+package windows
+
+import (
+	"math"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// updateLayeredStyle adds WS_EX_LAYERED while the window ignores the mouse events or isn't opaque, and applies the
+// opacity. The style is removed again if it has been added for them, a layered window is composed less efficiently.
+func (w *Window) updateLayeredStyle() {
+	hwnd := w.Handle()
+	needed := w.ignoreMouseEvents || w.opacity < 1
+	layered := w32.GetWindowLong(hwnd, w32.GWL_EXSTYLE)&w32.WS_EX_LAYERED != 0
+	switch {
+	case needed && !layered:
+		winc.SetExStyle(hwnd, true, w32.WS_EX_LAYERED)
+		w.addedLayeredStyle = true
+	case !needed && w.addedLayeredStyle:
+		winc.SetExStyle(hwnd, false, w32.WS_EX_LAYERED)
+		w.addedLayeredStyle = false
+		return
+	}
+	if needed || layered {
+		// A layered window isn't drawn until its attributes are set
+		win32.SetLayeredWindowAttributes(hwnd, byte(math.Round(w.opacity*255)))
+	}
+}
+
+// setOpacity sets the opacity of the whole window, including the webview, and returns the previous opacity. It must be
+// called on the main thread.
+func (w *Window) setOpacity(opacity float64) float64 {
+	previous := w.opacity
+	w.opacity = min(max(opacity, 0), 1)
+	w.updateLayeredStyle()
+	return previous
+}
+
+// WindowSetOpacity sets the opacity of the window from 0 to 1 and returns the previous opacity
+func (f *Frontend) WindowSetOpacity(opacity float64) float64 {
+	results := make(chan float64, 1)
+	f.mainWindow.Invoke(func() {
+		results <- f.mainWindow.setOpacity(opacity)
+	})
+	return <-results
+}
@@ -0,0 +1,56 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	winoptions "github.com/wailsapp/wails/v2/pkg/options/windows"
+)
+
+var iidPermissionRequestedHandler = ole.NewGUID("{15E1C6A3-C72A-4DF3-91D7-D097FBEC6BFD}")
+
+// The vtable indexes of the PermissionRequested event and its arguments. The handler of go-webview2 only applies the
+// permissions set by kind, it doesn't expose the arguments.
+const (
+	methodWebViewAddPermissionRequested = 23
+	methodPermissionRequestedArgsGetURI = 3
+	methodPermissionRequestedArgsKind   = 4
+	methodPermissionRequestedArgsPut    = 7
+)
+
+// addPermissionRequestedHandler calls OnWebviewPermissionRequest for the permission requests of the webview. The
+// handler is added after the handler of go-webview2, which allows every permission, and replaces its state. It returns
+// nil if there is no callback.
+func (f *Frontend) addPermissionRequestedHandler(webview *edge.ICoreWebView2) *webviewEventHandler {
+	opts := f.frontendOptions.Windows
+	if opts == nil || opts.OnWebviewPermissionRequest == nil {
+		return nil
+	}
+	handler := newWebviewEventHandler(iidPermissionRequestedHandler, func(_, args *winrtObject) {
+		var kind int32
+		if err := args.Call(methodPermissionRequestedArgsKind, uintptr(unsafe.Pointer(&kind))); err != nil {
+			f.logger.Error("Unable to get the kind of the permission request: %s", err)
+			return
+		}
+		source, err := getCoTaskString(args, methodPermissionRequestedArgsGetURI)
+		if err != nil {
+			f.logger.Error("Unable to get the URI of the permission request: %s", err)
+			return
+		}
+
+		decision := opts.OnWebviewPermissionRequest(winoptions.PermissionKind(kind), source)
+		// The decisions are the COREWEBVIEW2_PERMISSION_STATE values
+		if err := args.Call(methodPermissionRequestedArgsPut, uintptr(decision)); err != nil {
+			f.logger.Error("Unable to answer the permission request: %s", err)
+		}
+	})
+	var token int64
+	if err := (*winrtObject)(unsafe.Pointer(webview)).Call(methodWebViewAddPermissionRequested, uintptr(unsafe.Pointer(handler)), uintptr(unsafe.Pointer(&token))); err != nil {
+		f.logger.Error("Unable to handle the permission requests: %s", err)
+		return nil
+	}
+	return handler
+}
@@ -0,0 +1,74 @@
+//go:build windows
+
+package windows
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"golang.org/x/sys/windows"
+)
+
+var iidAddScriptCompletedHandler = ole.NewGUID("{B99369F3-9B11-47B5-BC6F-8E7895FCEA17}")
+
+// The vtable indexes of the scripts to execute on document created of ICoreWebView2
+const (
+	methodWebViewAddScriptToExecuteOnDocumentCreated    = 27
+	methodWebViewRemoveScriptToExecuteOnDocumentCreated = 28
+)
+
+// addScriptResult is the id of an added script, or the error of AddScriptToExecuteOnDocumentCreated
+type addScriptResult struct {
+	id  string
+	err error
+}
+
+// WebviewAddPreloadScript adds a script which is run before the scripts of every document, it returns the id of the
+// script to remove it
+func (f *Frontend) WebviewAddPreloadScript(script string) (string, error) {
+	if !f.mainWindow.InvokeRequired() {
+		return "", errWaitOnMainThread
+	}
+	done := make(chan addScriptResult, 1)
+	handler := newWebviewCompletedHandler(iidAddScriptCompletedHandler, func(errorCode uintptr, id unsafe.Pointer) {
+		if int32(errorCode) < 0 {
+			done <- addScriptResult{err: ole.NewError(errorCode)}
+		} else {
+			done <- addScriptResult{id: windows.UTF16PtrToString((*uint16)(id))}
+		}
+	})
+	started := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		started <- f.callWebview(methodWebViewAddScriptToExecuteOnDocumentCreated, script, uintptr(unsafe.Pointer(handler)))
+	})
+	if err := <-started; err != nil {
+		return "", err
+	}
+	result := <-done
+	runtime.KeepAlive(handler)
+	return result.id, result.err
+}
+
+// WebviewRemovePreloadScript removes a script added by WebviewAddPreloadScript, it is still run by the current
+// document
+func (f *Frontend) WebviewRemovePreloadScript(id string) error {
+	result := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		result <- f.callWebview(methodWebViewRemoveScriptToExecuteOnDocumentCreated, id)
+	})
+	return <-result
+}
+
+// callWebview calls a method of ICoreWebView2 which takes a string and the other arguments
+func (f *Frontend) callWebview(method int, value string, args ...uintptr) error {
+	webview, err := f.chromium.GetController().GetCoreWebView2()
+	if err != nil {
+		return err
+	}
+	valuePtr, err := windows.UTF16PtrFromString(value)
+	if err != nil {
+		return err
+	}
+	return (*winrtObject)(unsafe.Pointer(webview)).Call(method, append([]uintptr{uintptr(unsafe.Pointer(valuePtr))}, args...)...)
+}
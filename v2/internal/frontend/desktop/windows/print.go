@@ -0,0 +1,238 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/platform/com"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	iidCoreWebView2_7                   = ole.NewGUID("{79C24D83-09A3-45AE-9418-487F32A58740}")
+	iidCoreWebView2_16                  = ole.NewGUID("{0EB34DC9-9F91-41E1-8639-95CD5943906B}")
+	iidCoreWebView2Environment6         = ole.NewGUID("{E59EE362-ACBD-4857-9A8E-D3644D9459A9}")
+	iidPrintToPdfCompletedHandler       = ole.NewGUID("{CCF1EF04-FD8E-4D5F-B2DE-0983E41B8C36}")
+	iidPrintToPdfStreamCompletedHandler = ole.NewGUID("{4C9F8229-8F93-444F-A711-2C0DFD6359D5}")
+	errPrintToPdfRequiresRuntime        = errors.New("printing to PDF requires WebView2 Runtime 1.0.1185.39 or later")
+	errPrintToPdfStreamRequiresRuntime  = errors.New("printing to a PDF stream requires WebView2 Runtime 1.0.1518.46 or later")
+	errPrintToPdfFailed                 = errors.New("printing to PDF failed")
+)
+
+// The vtable indexes of the printing methods
+const (
+	methodWebView7PrintToPdf              = 80
+	methodWebView16PrintToPdfStream       = 115
+	methodEnvironment6CreatePrintSettings = 14
+	methodStreamRead                      = 3
+)
+
+// The vtable indexes of the setters of ICoreWebView2PrintSettings
+const (
+	methodPrintSettingsPutOrientation                = 4
+	methodPrintSettingsPutScaleFactor                = 6
+	methodPrintSettingsPutPageWidth                  = 8
+	methodPrintSettingsPutPageHeight                 = 10
+	methodPrintSettingsPutMarginTop                  = 12
+	methodPrintSettingsPutMarginBottom               = 14
+	methodPrintSettingsPutMarginLeft                 = 16
+	methodPrintSettingsPutMarginRight                = 18
+	methodPrintSettingsPutShouldPrintBackgrounds     = 20
+	methodPrintSettingsPutShouldPrintHeaderAndFooter = 24
+	methodPrintSettingsPutHeaderTitle                = 26
+	methodPrintSettingsPutFooterUri                  = 28
+)
+
+// streamResult is the data read from the stream of a completion handler, or the error of the operation
+type streamResult struct {
+	data []byte
+	err  error
+}
+
+// readStream reads an IStream until its end
+func readStream(stream *winrtObject) ([]byte, error) {
+	var result []byte
+	buffer := make([]byte, 32*1024)
+	for {
+		var read uint32
+		if err := stream.Call(methodStreamRead, uintptr(unsafe.Pointer(&buffer[0])), uintptr(len(buffer)), uintptr(unsafe.Pointer(&read))); err != nil {
+			return nil, err
+		}
+		if read == 0 {
+			return result, nil
+		}
+		result = append(result, buffer[:read]...)
+	}
+}
+
+// WebviewPrintToPDF prints the page to a PDF file at path without showing the print dialog
+func (f *Frontend) WebviewPrintToPDF(path string, settings frontend.PrintSettings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	if !f.mainWindow.InvokeRequired() {
+		return errWaitOnMainThread
+	}
+	done := make(chan error, 1)
+	handler := newWebviewCompletedHandler(iidPrintToPdfCompletedHandler, func(errorCode uintptr, isSuccessful unsafe.Pointer) {
+		switch {
+		case int32(errorCode) < 0:
+			done <- ole.NewError(errorCode)
+		// The BOOL is 32 bit, the upper half of the register is undefined
+		case uintptr(isSuccessful)&0xffffffff == 0:
+			done <- errPrintToPdfFailed
+		default:
+			done <- nil
+		}
+	})
+	started := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		started <- f.printToPdf(iidCoreWebView2_7, errPrintToPdfRequiresRuntime, settings, func(webview, printSettings *winrtObject) error {
+			return webview.Call(methodWebView7PrintToPdf, uintptr(unsafe.Pointer(pathPtr)), uintptr(unsafe.Pointer(printSettings)), uintptr(unsafe.Pointer(handler)))
+		})
+	})
+	if err := <-started; err != nil {
+		return err
+	}
+	err = <-done
+	runtime.KeepAlive(handler)
+	return err
+}
+
+// WebviewPrintToPDFData prints the page to a PDF without showing the print dialog and returns its content
+func (f *Frontend) WebviewPrintToPDFData(settings frontend.PrintSettings) ([]byte, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+	if !f.mainWindow.InvokeRequired() {
+		return nil, errWaitOnMainThread
+	}
+	// The stream is read in Invoke, it is only valid during the call
+	done := make(chan streamResult, 1)
+	handler := newWebviewCompletedHandler(iidPrintToPdfStreamCompletedHandler, func(errorCode uintptr, stream unsafe.Pointer) {
+		switch {
+		case int32(errorCode) < 0:
+			done <- streamResult{err: ole.NewError(errorCode)}
+		case stream == nil:
+			done <- streamResult{err: errPrintToPdfFailed}
+		default:
+			data, err := readStream((*winrtObject)(stream))
+			done <- streamResult{data: data, err: err}
+		}
+	})
+	started := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		started <- f.printToPdf(iidCoreWebView2_16, errPrintToPdfStreamRequiresRuntime, settings, func(webview, printSettings *winrtObject) error {
+			return webview.Call(methodWebView16PrintToPdfStream, uintptr(unsafe.Pointer(printSettings)), uintptr(unsafe.Pointer(handler)))
+		})
+	})
+	if err := <-started; err != nil {
+		return nil, err
+	}
+	result := <-done
+	runtime.KeepAlive(handler)
+	return result.data, result.err
+}
+
+// printToPdf calls printPdf with the webview interface iid, which older runtimes don't support, and the print
+// settings
+func (f *Frontend) printToPdf(iid *ole.GUID, unsupported error, settings frontend.PrintSettings, printPdf func(webview, printSettings *winrtObject) error) error {
+	webview, err := f.chromium.GetController().GetCoreWebView2()
+	if err != nil {
+		return err
+	}
+	var printWebview *winrtObject
+	if err := (*winrtObject)(unsafe.Pointer(webview)).Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(iid)), uintptr(unsafe.Pointer(&printWebview))); err != nil {
+		return unsupported
+	}
+	defer printWebview.Release()
+
+	printSettings, err := f.createPrintSettings(settings)
+	if err != nil {
+		return err
+	}
+	defer printSettings.Release()
+	return printPdf(printWebview, printSettings)
+}
+
+// createPrintSettings creates the ICoreWebView2PrintSettings of the settings, the zero values keep the defaults
+func (f *Frontend) createPrintSettings(settings frontend.PrintSettings) (*winrtObject, error) {
+	var environment6 *winrtObject
+	if err := (*winrtObject)(unsafe.Pointer(f.chromium.Environment())).Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(iidCoreWebView2Environment6)), uintptr(unsafe.Pointer(&environment6))); err != nil {
+		return nil, errPrintToPdfRequiresRuntime
+	}
+	defer environment6.Release()
+
+	var result *winrtObject
+	if err := environment6.Call(methodEnvironment6CreatePrintSettings, uintptr(unsafe.Pointer(&result))); err != nil {
+		return nil, err
+	}
+	if err := applyPrintSettings(result, settings); err != nil {
+		result.Release()
+		return nil, err
+	}
+	return result, nil
+}
+
+func applyPrintSettings(printSettings *winrtObject, settings frontend.PrintSettings) error {
+	var err error
+	put := func(method int, value uintptr) {
+		if err == nil {
+			err = printSettings.Call(method, value)
+		}
+	}
+	// The doubles are passed as their bits like in cache.go
+	putDouble := func(method int, value float64) {
+		put(method, uintptr(math.Float64bits(value)))
+	}
+	putString := func(method int, value string) {
+		if err != nil || value == "" {
+			return
+		}
+		var valuePtr *uint16
+		if valuePtr, err = windows.UTF16PtrFromString(value); err == nil {
+			put(method, uintptr(unsafe.Pointer(valuePtr)))
+		}
+	}
+
+	if settings.Orientation == frontend.PrintLandscape {
+		put(methodPrintSettingsPutOrientation, 1)
+	}
+	if settings.ScaleFactor != 0 {
+		putDouble(methodPrintSettingsPutScaleFactor, settings.ScaleFactor)
+	}
+	if settings.PageWidth != 0 {
+		putDouble(methodPrintSettingsPutPageWidth, settings.PageWidth)
+	}
+	if settings.PageHeight != 0 {
+		putDouble(methodPrintSettingsPutPageHeight, settings.PageHeight)
+	}
+	if margins := settings.Margins; margins != nil {
+		putDouble(methodPrintSettingsPutMarginTop, margins.Top)
+		putDouble(methodPrintSettingsPutMarginBottom, margins.Bottom)
+		putDouble(methodPrintSettingsPutMarginLeft, margins.Left)
+		putDouble(methodPrintSettingsPutMarginRight, margins.Right)
+	}
+	put(methodPrintSettingsPutShouldPrintBackgrounds, boolToUintptr(settings.PrintBackgrounds))
+	put(methodPrintSettingsPutShouldPrintHeaderAndFooter, boolToUintptr(settings.HeaderAndFooter))
+	putString(methodPrintSettingsPutHeaderTitle, settings.HeaderTitle)
+	putString(methodPrintSettingsPutFooterUri, settings.FooterURI)
+	return err
+}
+
+func boolToUintptr(value bool) uintptr {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,122 @@
+//go:build windows
+
+package windows
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	"github.com/wailsapp/wails/v2/internal/platform/com"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	iidCoreWebView2_10                     = ole.NewGUID("{B1690564-6F5A-4983-8E48-31D1143FECDB}")
+	iidBasicAuthenticationRequestedHandler = ole.NewGUID("{58B4D6C2-18D4-497E-B39B-9A96533FA278}")
+)
+
+// The vtable indexes of the BasicAuthenticationRequested event of ICoreWebView2_10, its arguments and the response
+const (
+	methodWebView10AddBasicAuthenticationRequested = 97
+	methodBasicAuthenticationArgsGetURI            = 3
+	methodBasicAuthenticationArgsGetResponse       = 5
+	methodBasicAuthenticationResponsePutUserName   = 4
+	methodBasicAuthenticationResponsePutPassword   = 6
+)
+
+// addProxyAuthenticationHandler answers the authentication challenges of the proxy with the credentials of the
+// WebviewProxy. The URI of the challenges of a proxy is the URI of the proxy, the challenges of the other servers are
+// left to WebView2 so the credentials aren't sent to them. It returns nil if there are no credentials.
+func (f *Frontend) addProxyAuthenticationHandler(webview *edge.ICoreWebView2) *webviewEventHandler {
+	opts := f.frontendOptions.Windows
+	if opts == nil || opts.WebviewProxy == nil || opts.WebviewProxy.Username == "" {
+		return nil
+	}
+	proxy := opts.WebviewProxy
+	if proxy.Server == "" {
+		f.logger.Warning("The credentials of the proxy are ignored without a Server")
+		return nil
+	}
+	hosts := proxyHosts(proxy.Server)
+
+	handler := newWebviewEventHandler(iidBasicAuthenticationRequestedHandler, func(_, args *winrtObject) {
+		uri, err := getCoTaskString(args, methodBasicAuthenticationArgsGetURI)
+		if err != nil {
+			f.logger.Error("Unable to get the URI of the authentication challenge: %s", err)
+			return
+		}
+		if !isProxyURI(uri, hosts) {
+			return
+		}
+		var response *winrtObject
+		if err := args.Call(methodBasicAuthenticationArgsGetResponse, uintptr(unsafe.Pointer(&response))); err != nil {
+			f.logger.Error("Unable to answer the authentication challenge of the proxy: %s", err)
+			return
+		}
+		defer response.Release()
+		credentials := []struct {
+			method int
+			value  string
+		}{
+			{methodBasicAuthenticationResponsePutUserName, proxy.Username},
+			{methodBasicAuthenticationResponsePutPassword, proxy.Password},
+		}
+		for _, credential := range credentials {
+			value, err := windows.UTF16PtrFromString(credential.value)
+			if err == nil {
+				err = response.Call(credential.method, uintptr(unsafe.Pointer(value)))
+			}
+			if err != nil {
+				f.logger.Error("Unable to answer the authentication challenge of the proxy: %s", err)
+				return
+			}
+		}
+	})
+
+	var webview10 *winrtObject
+	if err := (*winrtObject)(unsafe.Pointer(webview)).Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(iidCoreWebView2_10)), uintptr(unsafe.Pointer(&webview10))); err != nil {
+		f.logger.Warning("Unable to authenticate to the proxy, WebView2 Runtime 1.0.1150.38 or later is required: %s", err)
+		return nil
+	}
+	defer webview10.Release()
+	var token int64
+	if err := webview10.Call(methodWebView10AddBasicAuthenticationRequested, uintptr(unsafe.Pointer(handler)), uintptr(unsafe.Pointer(&token))); err != nil {
+		f.logger.Error("Unable to authenticate to the proxy: %s", err)
+		return nil
+	}
+	return handler
+}
+
+// proxyHosts returns the hosts of the proxies of a --proxy-server value, which is a proxy or a list of proxies per
+// scheme
+func proxyHosts(server string) []string {
+	var result []string
+	for _, entry := range strings.Split(server, ";") {
+		if _, proxy, ok := strings.Cut(entry, "="); ok {
+			entry = proxy
+		}
+		entry = strings.TrimSpace(entry)
+		if !strings.Contains(entry, "://") {
+			entry = "http://" + entry
+		}
+		if parsed, err := url.Parse(entry); err == nil && parsed.Hostname() != "" {
+			result = append(result, strings.ToLower(parsed.Hostname()))
+		}
+	}
+	return result
+}
+
+func isProxyURI(uri string, hosts []string) bool {
+	if !strings.Contains(uri, "://") {
+		uri = "http://" + uri
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return slices.Contains(hosts, strings.ToLower(parsed.Hostname()))
+}
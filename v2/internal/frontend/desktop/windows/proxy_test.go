@@ -0,0 +1,67 @@
+//go:build windows
+
+package windows
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_proxyHosts(t *testing.T) {
+	tests := []struct {
+		name   string
+		server string
+		want   []string
+	}{
+		{name: "empty", server: "", want: nil},
+		{name: "host", server: "proxy.example.com", want: []string{"proxy.example.com"}},
+		{name: "host with a port", server: "proxy.example.com:8080", want: []string{"proxy.example.com"}},
+		{name: "http url", server: "http://proxy.example.com:3128", want: []string{"proxy.example.com"}},
+		{name: "socks5", server: "socks5://socks.example.com:1080", want: []string{"socks.example.com"}},
+		{name: "upper case", server: "HTTP://Proxy.Example.COM:8080", want: []string{"proxy.example.com"}},
+		{name: "ipv6", server: "[::1]:8080", want: []string{"::1"}},
+		{
+			name:   "per scheme",
+			server: "http=proxy1.example.com:8080;https=proxy2.example.com:8443",
+			want:   []string{"proxy1.example.com", "proxy2.example.com"},
+		},
+		{
+			name:   "per scheme with urls and spaces",
+			server: "http=http://proxy1.example.com:8080; https=socks5://proxy2.example.com:1080 ;",
+			want:   []string{"proxy1.example.com", "proxy2.example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := proxyHosts(tt.server); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("proxyHosts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_isProxyURI(t *testing.T) {
+	hosts := proxyHosts("http=proxy1.example.com:8080;socks=socks5://[::1]:1080")
+	tests := []struct {
+		name string
+		uri  string
+		want bool
+	}{
+		{name: "proxy host", uri: "proxy1.example.com", want: true},
+		{name: "proxy host with a port", uri: "proxy1.example.com:8080", want: true},
+		{name: "proxy url", uri: "http://proxy1.example.com:8080/", want: true},
+		{name: "case difference", uri: "HTTP://PROXY1.Example.com:8080", want: true},
+		{name: "socks5 ipv6 proxy", uri: "socks5://[::1]:1080", want: true},
+		{name: "other server", uri: "https://example.com/login", want: false},
+		{name: "subdomain of the proxy", uri: "https://sub.proxy1.example.com", want: false},
+		{name: "proxy host in the path", uri: "https://example.com/proxy1.example.com", want: false},
+		{name: "invalid uri", uri: "http://[::1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isProxyURI(tt.uri, hosts); got != tt.want {
+				t.Errorf("isProxyURI(%q) = %v, want %v", tt.uri, got, tt.want)
+			}
+		})
+	}
+}
@@ -127,3 +127,22 @@ func GetAllScreens(mainWinHandle w32.HWND) ([]Screen, error) {
 	}
 	return monitorContainer.monitors, returnErr
 }
+
+func collectMonitorsProc(hMonitor w32.HMONITOR, hdcMonitor w32.HDC, lprcMonitor *w32.RECT, monitors *[]w32.HMONITOR) uintptr {
+	*monitors = append(*monitors, hMonitor)
+	return w32.TRUE
+}
+
+// getMonitor returns the monitor of the screen at the index of GetAllScreens
+func getMonitor(index int) (w32.HMONITOR, error) {
+	var monitors []w32.HMONITOR
+	dc := w32.GetDC(0)
+	defer w32.ReleaseDC(0, dc)
+	if !w32.EnumDisplayMonitors(dc, nil, syscall.NewCallback(collectMonitorsProc), unsafe.Pointer(&monitors)) {
+		return 0, errors.New("Windows call to EnumDisplayMonitors failed")
+	}
+	if index < 0 || index >= len(monitors) {
+		return 0, fmt.Errorf("unknown screen: %d", index)
+	}
+	return monitors[index], nil
+}
@@ -0,0 +1,54 @@
+//go:build windows
+
+package windows
+
+import (
+	"encoding/json"
+	"runtime"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"golang.org/x/sys/windows"
+)
+
+var iidExecuteScriptCompletedHandler = ole.NewGUID("{49511172-CC67-4BCA-9923-137112F4C4CC}")
+
+// The vtable index of ExecuteScript of ICoreWebView2
+const methodWebViewExecuteScript = 29
+
+// scriptResult is the JSON of the result of a script, or the error of ExecuteScript
+type scriptResult struct {
+	json string
+	err  error
+}
+
+// evalScript evaluates a script which results in a string in the webview and returns the string. The result is read
+// from the completion handler of ExecuteScript, unlike the messages it can't be sent by the scripts of the page.
+func (f *Frontend) evalScript(script string) (string, error) {
+	if !f.mainWindow.InvokeRequired() {
+		return "", errWaitOnMainThread
+	}
+	done := make(chan scriptResult, 1)
+	handler := newWebviewCompletedHandler(iidExecuteScriptCompletedHandler, func(errorCode uintptr, result unsafe.Pointer) {
+		if int32(errorCode) < 0 {
+			done <- scriptResult{err: ole.NewError(errorCode)}
+		} else {
+			done <- scriptResult{json: windows.UTF16PtrToString((*uint16)(result))}
+		}
+	})
+	started := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		started <- f.callWebview(methodWebViewExecuteScript, script, uintptr(unsafe.Pointer(handler)))
+	})
+	if err := <-started; err != nil {
+		return "", err
+	}
+	result := <-done
+	runtime.KeepAlive(handler)
+	if result.err != nil {
+		return "", result.err
+	}
+	var value string
+	err := json.Unmarshal([]byte(result.json), &value)
+	return value, err
+}
@@ -0,0 +1,128 @@
+//go:build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// The height of the list in logical pixels, the dialog is laid out like the input dialog
+const selectListHeight = 200
+
+// selectItem is an item of the list, the checked state is kept by the item for the check boxes of the ListView
+type selectItem struct {
+	text    string
+	checked bool
+}
+
+func (s *selectItem) Text() []string          { return []string{s.text} }
+func (s *selectItem) ImageIndex() int         { return 0 }
+func (s *selectItem) Checked() bool           { return s.checked }
+func (s *selectItem) SetChecked(checked bool) { s.checked = checked }
+
+// SelectDialog shows a modal dialog with a ListView of the items, which has check boxes in multiple selection mode
+func (f *Frontend) SelectDialog(options frontend.SelectDialogOptions) ([]string, bool) {
+	type selectResult struct {
+		values []string
+		ok     bool
+	}
+	results := make(chan selectResult, 1)
+
+	f.mainWindow.Invoke(func() {
+		dlg := winc.NewDialog(f.mainWindow)
+		dlg.SetText(options.Title)
+
+		y := inputPadding
+		if options.Message != "" {
+			label := winc.NewLabel(dlg)
+			// The message is wrapped to the width of the dialog
+			winc.SetStyle(label.Handle(), false, w32.SS_LEFTNOWORDWRAP)
+			label.SetText(options.Message)
+			height := messageHeight(dlg, label, options.Message, inputWidth)
+			placeControl(dlg, label, inputPadding, y, inputWidth, height)
+			y += height + inputPadding/2
+		}
+
+		defaults := make(map[string]bool, len(options.Default))
+		for _, value := range options.Default {
+			defaults[value] = true
+		}
+
+		list := winc.NewListView(dlg)
+		list.EnableEditLabels(false)
+		list.EnableFullRowSelect(true)
+		winc.SetStyle(list.Handle(), true, w32.LVS_NOCOLUMNHEADER)
+		list.EnableSingleSelect(!options.Multiple)
+		list.SetCheckBoxes(options.Multiple)
+		list.AddColumn("", 0)
+		items := make([]*selectItem, len(options.Items))
+		selected := -1
+		for index, value := range options.Items {
+			items[index] = &selectItem{text: value, checked: options.Multiple && defaults[value]}
+			list.AddItem(items[index])
+			if selected == -1 && defaults[value] {
+				selected = index
+			}
+		}
+		placeControl(dlg, list, inputPadding, y, inputWidth, selectListHeight)
+		list.StretchLastColumn()
+		if selected != -1 {
+			list.SetSelectedIndex(selected)
+			list.EnsureVisible(items[selected])
+		}
+		y += selectListHeight + inputPadding
+
+		okButton := winc.NewPushButton(dlg)
+		okButton.SetText("OK")
+		placeControl(dlg, okButton, inputPadding+inputWidth-2*pickerButtonWidth-inputPadding/2, y, pickerButtonWidth, pickerButtonHeight)
+		cancelButton := winc.NewPushButton(dlg)
+		cancelButton.SetText("Cancel")
+		placeControl(dlg, cancelButton, inputPadding+inputWidth-pickerButtonWidth, y, pickerButtonWidth, pickerButtonHeight)
+		dlg.SetButtons(okButton, cancelButton)
+
+		done := false
+		closeDialog := func(result selectResult) {
+			if done {
+				return
+			}
+			done = true
+			dlg.Close()
+			results <- result
+		}
+		accept := func() {
+			values := []string{}
+			if options.Multiple {
+				for _, item := range items {
+					if item.checked {
+						values = append(values, item.text)
+					}
+				}
+			} else if item, ok := list.SelectedItem().(*selectItem); ok {
+				values = append(values, item.text)
+			}
+			closeDialog(selectResult{values: values, ok: true})
+		}
+		okButton.OnClick().Bind(func(*winc.Event) {
+			accept()
+		})
+		cancelButton.OnClick().Bind(func(*winc.Event) {
+			closeDialog(selectResult{})
+		})
+		list.OnDoubleClick().Bind(func(*winc.Event) {
+			// A double click picks the item in single selection mode, it toggles the check box otherwise
+			if !options.Multiple && list.SelectedItem() != nil {
+				accept()
+			}
+		})
+
+		setClientSize(dlg, inputWidth+2*inputPadding, y+pickerButtonHeight+inputPadding)
+		dlg.Center()
+		dlg.Show()
+		list.SetFocus()
+	})
+
+	result := <-results
+	return result.values, result.ok
+}
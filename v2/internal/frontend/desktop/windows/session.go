@@ -0,0 +1,23 @@
+//go:build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+)
+
+// sessionChanges maps the WM_WTSSESSION_CHANGE events to the session changes. The console and the remote connections
+// are the same to the application, a session switched from one to the other is disconnected then reconnected.
+var sessionChanges = map[uintptr]frontend.SessionChange{
+	win32.WTS_SESSION_LOCK:       frontend.SessionLock,
+	win32.WTS_SESSION_UNLOCK:     frontend.SessionUnlock,
+	win32.WTS_CONSOLE_DISCONNECT: frontend.SessionDisconnect,
+	win32.WTS_REMOTE_DISCONNECT:  frontend.SessionDisconnect,
+	win32.WTS_CONSOLE_CONNECT:    frontend.SessionReconnect,
+	win32.WTS_REMOTE_CONNECT:     frontend.SessionReconnect,
+}
+
+func (f *Frontend) IsRemoteSession() bool {
+	return win32.IsRemoteSession()
+}
@@ -0,0 +1,183 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+var (
+	iidDataTransferManagerInterop = ole.NewGUID("{3A3DCD6C-3EAB-43DC-BCDE-45671CE800C8}")
+	iidDataTransferManager        = ole.NewGUID("{A5CAEE9B-8708-49D1-8D36-67D25A8DA00C}")
+	// TypedEventHandler<DataTransferManager, DataRequestedEventArgs>
+	iidDataRequestedHandler = ole.NewGUID("{EC6F9CC8-46D0-4E0E-961A-F80B9A5D1EB1}")
+	iidAgileObject          = ole.NewGUID("{94EA2B94-E9CC-49E0-C0FF-EE64CA8F5B90}")
+
+	procWindowsCreateString = syscall.NewLazyDLL("combase.dll").NewProc("WindowsCreateString")
+)
+
+// The vtable indexes of the WinRT methods used for sharing, after the methods of IUnknown and IInspectable
+const (
+	methodGetForWindow                = 3
+	methodShowShareUIForWindow        = 4
+	methodAddDataRequested            = 6
+	methodDataRequestedArgsGetRequest = 6
+	methodDataRequestGetData          = 6
+	methodDataPackageGetProperties    = 7
+	methodDataPackageSetText          = 16
+	methodPropertySetPutTitle         = 7
+)
+
+// newHString creates an HSTRING, which must be deleted with ole.DeleteHString. ole.NewHString passes the number of
+// runes as the length, which truncates strings with characters outside of the BMP.
+func newHString(s string) (ole.HString, error) {
+	u16, err := syscall.UTF16FromString(s)
+	if err != nil {
+		return 0, err
+	}
+	var result ole.HString
+	hr, _, _ := procWindowsCreateString.Call(uintptr(unsafe.Pointer(&u16[0])), uintptr(len(u16)-1), uintptr(unsafe.Pointer(&result)))
+	if hr != 0 {
+		return 0, ole.NewError(hr)
+	}
+	return result, nil
+}
+
+// dataRequestedHandler is the delegate for the DataRequested event. A single handler is used for the lifetime of the
+// application, so it isn't reference counted.
+type dataRequestedHandler struct {
+	vtbl *dataRequestedHandlerVtbl
+}
+
+type dataRequestedHandlerVtbl struct {
+	QueryInterface uintptr
+	AddRef         uintptr
+	Release        uintptr
+	Invoke         uintptr
+}
+
+// share holds the state of the share UI, it is only used on the main thread
+var share struct {
+	handler *dataRequestedHandler
+	manager *winrtObject
+	items   frontend.ShareItems
+}
+
+var initShareHandler sync.Once
+
+func newDataRequestedHandler() *dataRequestedHandler {
+	return &dataRequestedHandler{vtbl: &dataRequestedHandlerVtbl{
+		QueryInterface: syscall.NewCallback(func(this *dataRequestedHandler, iid *ole.GUID, result *unsafe.Pointer) uintptr {
+			if ole.IsEqualGUID(iid, ole.IID_IUnknown) || ole.IsEqualGUID(iid, iidDataRequestedHandler) || ole.IsEqualGUID(iid, iidAgileObject) {
+				*result = unsafe.Pointer(this)
+				return ole.S_OK
+			}
+			*result = nil
+			return ole.E_NOINTERFACE
+		}),
+		AddRef: syscall.NewCallback(func(this *dataRequestedHandler) uintptr {
+			return 1
+		}),
+		Release: syscall.NewCallback(func(this *dataRequestedHandler) uintptr {
+			return 1
+		}),
+		Invoke: syscall.NewCallback(func(this *dataRequestedHandler, sender *winrtObject, args *winrtObject) uintptr {
+			if err := fillDataPackage(args, share.items); err != nil {
+				return ole.E_FAIL
+			}
+			return ole.S_OK
+		}),
+	}}
+}
+
+// fillDataPackage sets the title and the text of the data package of the DataRequestedEventArgs. The URLs are shared
+// as lines of the text.
+func fillDataPackage(args *winrtObject, items frontend.ShareItems) error {
+	var request *winrtObject
+	if err := args.Call(methodDataRequestedArgsGetRequest, uintptr(unsafe.Pointer(&request))); err != nil {
+		return err
+	}
+	defer request.Release()
+	var data *winrtObject
+	if err := request.Call(methodDataRequestGetData, uintptr(unsafe.Pointer(&data))); err != nil {
+		return err
+	}
+	defer data.Release()
+	var properties *winrtObject
+	if err := data.Call(methodDataPackageGetProperties, uintptr(unsafe.Pointer(&properties))); err != nil {
+		return err
+	}
+	defer properties.Release()
+
+	title, err := newHString(items.Title)
+	if err != nil {
+		return err
+	}
+	defer ole.DeleteHString(title)
+	if err := properties.Call(methodPropertySetPutTitle, uintptr(title)); err != nil {
+		return err
+	}
+
+	lines := append([]string{}, items.URLs...)
+	if items.Text != "" {
+		lines = append([]string{items.Text}, lines...)
+	}
+	text, err := newHString(strings.Join(lines, "\n"))
+	if err != nil {
+		return err
+	}
+	defer ole.DeleteHString(text)
+	return data.Call(methodDataPackageSetText, uintptr(text))
+}
+
+// ShowShareMenu shows the share UI of Windows with the DataTransferManager of the window. The system places the UI,
+// so the anchor is ignored.
+func (f *Frontend) ShowShareMenu(items frontend.ShareItems, anchor frontend.Rect) error {
+	if len(items.Files) > 0 {
+		return errors.New("sharing files is not supported on Windows")
+	}
+	if items.Title == "" {
+		items.Title = f.frontendOptions.Title
+	}
+	result := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		result <- f.showShareUI(items)
+	})
+	return <-result
+}
+
+// showShareUI must be called on the main thread
+func (f *Frontend) showShareUI(items frontend.ShareItems) error {
+	factory, err := ole.RoGetActivationFactory("Windows.ApplicationModel.DataTransfer.DataTransferManager", iidDataTransferManagerInterop)
+	if err != nil {
+		return err
+	}
+	interop := (*winrtObject)(unsafe.Pointer(factory))
+	defer interop.Release()
+
+	hwnd := uintptr(f.mainWindow.Handle())
+	if share.manager == nil {
+		initShareHandler.Do(func() {
+			share.handler = newDataRequestedHandler()
+		})
+		var manager *winrtObject
+		if err := interop.Call(methodGetForWindow, hwnd, uintptr(unsafe.Pointer(iidDataTransferManager)), uintptr(unsafe.Pointer(&manager))); err != nil {
+			return err
+		}
+		var token int64
+		if err := manager.Call(methodAddDataRequested, uintptr(unsafe.Pointer(share.handler)), uintptr(unsafe.Pointer(&token))); err != nil {
+			manager.Release()
+			return err
+		}
+		share.manager = manager
+	}
+	share.items = items
+	return interop.Call(methodShowShareUIForWindow, hwnd)
+}
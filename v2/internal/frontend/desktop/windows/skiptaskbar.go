@@ -0,0 +1,41 @@
+//go:build windows
+
+package windows
+
+import (
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+// setSkipTaskbar hides the window from the taskbar and Alt+Tab, it must be called on the main thread
+func (w *Window) setSkipTaskbar(skip bool) {
+	w.skipTaskbar = skip
+	w.updateToolWindowStyle()
+}
+
+// updateToolWindowStyle replaces WS_EX_APPWINDOW with WS_EX_TOOLWINDOW, which hides the window from the taskbar and
+// Alt+Tab, if the window skips the taskbar or is always on bottom. The taskbar only picks up the change when the window
+// is shown, so a visible window is hidden and shown again.
+func (w *Window) updateToolWindowStyle() {
+	toolWindow := w.skipTaskbar || w.alwaysOnBottom
+	exStyle := w32.GetWindowLongPtr(w.Handle(), w32.GWL_EXSTYLE)
+	if (exStyle&w32.WS_EX_TOOLWINDOW != 0) == toolWindow {
+		return
+	}
+	visible := w32.IsWindowVisible(w.Handle())
+	if visible {
+		w32.ShowWindow(w.Handle(), w32.SW_HIDE)
+	}
+	winc.SetExStyle(w.Handle(), !toolWindow, w32.WS_EX_APPWINDOW)
+	winc.SetExStyle(w.Handle(), toolWindow, w32.WS_EX_TOOLWINDOW)
+	if visible {
+		w32.ShowWindow(w.Handle(), w32.SW_SHOWNA)
+	}
+}
+
+// WindowSetSkipTaskbar hides the window from the taskbar and Alt+Tab, or shows it there again
+func (f *Frontend) WindowSetSkipTaskbar(skip bool) {
+	f.mainWindow.Invoke(func() {
+		f.mainWindow.setSkipTaskbar(skip)
+	})
+}
@@ -0,0 +1,349 @@
+//go:build windows
+
+package windows
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+	"golang.org/x/sys/windows"
+)
+
+// wmTaskbarButtonCreated is sent to the window when its taskbar button has been created
+var wmTaskbarButtonCreated = winc.RegisterWindowMessage("TaskbarButtonCreated")
+
+var (
+	clsidTaskbarList = ole.NewGUID("{56FDF344-FD6D-11D0-958A-006097C9A090}")
+	iidTaskbarList3  = ole.NewGUID("{EA1AFB91-9E28-4B86-90E9-9E9F8A5EEFAF}")
+)
+
+// The vtable indexes of ITaskbarList3
+const (
+	methodTaskbarListHrInit                = 3
+	methodTaskbarListSetProgressValue      = 9
+	methodTaskbarListSetProgressState      = 10
+	methodTaskbarListThumbBarAddButtons    = 15
+	methodTaskbarListThumbBarUpdateButtons = 16
+	methodTaskbarListSetOverlayIcon        = 18
+)
+
+// The TBPFLAG values of the progress states
+var taskbarProgressFlags = map[frontend.ProgressState]uintptr{
+	frontend.ProgressStateNone:          0x0,
+	frontend.ProgressStateIndeterminate: 0x1,
+	frontend.ProgressStateNormal:        0x2,
+	frontend.ProgressStateError:         0x4,
+	frontend.ProgressStatePaused:        0x8,
+}
+
+// The THUMBBUTTONMASK and THUMBBUTTONFLAGS values
+const (
+	thbIcon    = 0x2
+	thbTooltip = 0x4
+	thbFlags   = 0x8
+
+	thbfEnabled        = 0x0
+	thbfDisabled       = 0x1
+	thbfDismissOnClick = 0x2
+	thbfHidden         = 0x8
+)
+
+// thbnClicked is the notification code of the WM_COMMAND sent when a thumbnail toolbar button is clicked
+const thbnClicked = 0x1800
+
+type thumbButton struct {
+	mask    uint32
+	id      uint32
+	bitmap  uint32
+	icon    w32.HICON
+	tooltip [260]uint16
+	flags   uint32
+}
+
+// thumbbarButton is a button of the thumbnail toolbar with its icon
+type thumbbarButton struct {
+	frontend.ThumbbarButton
+	icon w32.HICON
+}
+
+// taskbar shows the progress and the thumbnail toolbar on the taskbar button of the window. They are kept so they
+// can be shown again when the taskbar button is created, which is also the case when Explorer restarts. It is only
+// used on the main thread.
+type taskbar struct {
+	list *winrtObject
+	// unavailable is set if the taskbar can't be created, EG on Server Core
+	unavailable bool
+
+	state     frontend.ProgressState
+	completed uint64
+	total     uint64
+
+	buttons []thumbbarButton
+	// buttonsAdded is set once the buttons have been added, they can only be updated afterwards
+	buttonsAdded bool
+
+	overlayIcon        w32.HICON
+	overlayDescription string
+}
+
+// buttonCreated shows the progress and the thumbnail toolbar on a new taskbar button
+func (t *taskbar) buttonCreated(hwnd uintptr) {
+	t.buttonsAdded = false
+	t.applyProgress(hwnd)
+	t.applyButtons(hwnd)
+	if t.overlayIcon != 0 {
+		t.applyOverlayIcon(hwnd)
+	}
+}
+
+// setProgress shows the progress on the taskbar button of the window
+func (t *taskbar) setProgress(hwnd uintptr, state frontend.ProgressState, completed, total uint64) {
+	t.state = state
+	t.completed = min(completed, total)
+	t.total = total
+	t.applyProgress(hwnd)
+}
+
+func (t *taskbar) applyProgress(hwnd uintptr) {
+	if t.state == "" || !t.create() {
+		return
+	}
+	if t.state != frontend.ProgressStateNone && t.state != frontend.ProgressStateIndeterminate && t.total > 0 {
+		// Setting the value shows the normal state, the other states are set afterwards
+		_ = t.list.Call(methodTaskbarListSetProgressValue, hwnd, uintptr(t.completed), uintptr(t.total))
+	}
+	_ = t.list.Call(methodTaskbarListSetProgressState, hwnd, taskbarProgressFlags[t.state])
+}
+
+// setButtons replaces the buttons of the thumbnail toolbar, the icons of the previous buttons are destroyed
+func (t *taskbar) setButtons(hwnd uintptr, buttons []thumbbarButton) {
+	previous := t.buttons
+	t.buttons = buttons
+	t.applyButtons(hwnd)
+	for _, button := range previous {
+		if button.icon != 0 {
+			w32.DestroyIcon(button.icon)
+		}
+	}
+}
+
+// applyButtons shows the buttons of the thumbnail toolbar. The toolbar always has the maximum number of buttons, as
+// buttons can't be added once it has been created, the unused buttons are hidden.
+func (t *taskbar) applyButtons(hwnd uintptr) {
+	if t.buttons == nil || !t.create() {
+		return
+	}
+	var thumbButtons [frontend.MaxThumbbarButtons]thumbButton
+	for index := range thumbButtons {
+		thumbButton := &thumbButtons[index]
+		thumbButton.id = uint32(index)
+		thumbButton.mask = thbFlags
+		if index >= len(t.buttons) {
+			thumbButton.flags = thbfHidden
+			continue
+		}
+		button := t.buttons[index]
+		thumbButton.flags = thbfEnabled
+		if button.Disabled {
+			thumbButton.flags |= thbfDisabled
+		}
+		if button.DismissOnClick {
+			thumbButton.flags |= thbfDismissOnClick
+		}
+		if button.icon != 0 {
+			thumbButton.mask |= thbIcon
+			thumbButton.icon = button.icon
+		}
+		if button.Tooltip != "" {
+			thumbButton.mask |= thbTooltip
+			tooltip, _ := windows.UTF16FromString(button.Tooltip)
+			// The tooltip is truncated, the last character is the terminating null
+			copy(thumbButton.tooltip[:len(thumbButton.tooltip)-1], tooltip)
+		}
+	}
+
+	method := methodTaskbarListThumbBarUpdateButtons
+	if !t.buttonsAdded {
+		method = methodTaskbarListThumbBarAddButtons
+	}
+	// Adding the buttons fails until the taskbar button has been created, they are added when it is
+	if err := t.list.Call(method, hwnd, uintptr(len(thumbButtons)), uintptr(unsafe.Pointer(&thumbButtons[0]))); err == nil {
+		t.buttonsAdded = true
+	}
+}
+
+// buttonClicked calls the OnClick callback of the button
+func (t *taskbar) buttonClicked(id int) {
+	if id < 0 || id >= len(t.buttons) || t.buttons[id].OnClick == nil {
+		return
+	}
+	t.buttons[id].OnClick()
+}
+
+// setOverlayIcon shows the icon over the icon of the taskbar button of the window, an icon of 0 removes it. The
+// previous icon is destroyed.
+func (t *taskbar) setOverlayIcon(hwnd uintptr, icon w32.HICON, description string) {
+	previous := t.overlayIcon
+	t.overlayIcon = icon
+	t.overlayDescription = description
+	t.applyOverlayIcon(hwnd)
+	if previous != 0 {
+		w32.DestroyIcon(previous)
+	}
+}
+
+func (t *taskbar) applyOverlayIcon(hwnd uintptr) {
+	if !t.create() {
+		return
+	}
+	// The description is read by the screen readers
+	description, _ := windows.UTF16PtrFromString(t.overlayDescription)
+	_ = t.list.Call(methodTaskbarListSetOverlayIcon, hwnd, uintptr(t.overlayIcon), uintptr(unsafe.Pointer(description)))
+}
+
+// create creates the taskbar list, it returns false if it isn't available
+func (t *taskbar) create() bool {
+	if t.list != nil {
+		return true
+	}
+	if t.unavailable {
+		return false
+	}
+	unknown, err := ole.CreateInstance(clsidTaskbarList, iidTaskbarList3)
+	if err != nil {
+		t.unavailable = true
+		return false
+	}
+	list := (*winrtObject)(unsafe.Pointer(unknown))
+	if err := list.Call(methodTaskbarListHrInit); err != nil {
+		list.Release()
+		t.unavailable = true
+		return false
+	}
+	t.list = list
+	return true
+}
+
+// icoHeader starts the ICO files, which are a directory of images
+var icoHeader = []byte{0, 0, 1, 0}
+
+// icoImage returns the image of the ICO file closest to the size, the smallest of the larger images or the largest
+// image
+func icoImage(data []byte, size int) ([]byte, error) {
+	if len(data) < 6 {
+		return nil, fmt.Errorf("invalid ICO file")
+	}
+	count := int(binary.LittleEndian.Uint16(data[4:]))
+	var result []byte
+	resultWidth := 0
+	for index := 0; index < count; index++ {
+		entry := data[6+index*16:]
+		if len(entry) < 16 {
+			return nil, fmt.Errorf("invalid ICO file")
+		}
+		// A width of 0 is 256 pixels
+		width := int(entry[0])
+		if width == 0 {
+			width = 256
+		}
+		length := binary.LittleEndian.Uint32(entry[8:])
+		offset := binary.LittleEndian.Uint32(entry[12:])
+		if uint64(offset)+uint64(length) > uint64(len(data)) {
+			return nil, fmt.Errorf("invalid ICO file")
+		}
+		better := result == nil ||
+			width >= size && (resultWidth < size || width < resultWidth) ||
+			width < size && resultWidth < size && width > resultWidth
+		if better {
+			result = data[offset : offset+length]
+			resultWidth = width
+		}
+	}
+	if result == nil {
+		return nil, fmt.Errorf("the ICO file has no image")
+	}
+	return result, nil
+}
+
+// createSmallIcon creates an icon of the size of the small icons from a PNG image or an ICO file
+func createSmallIcon(image []byte) (w32.HICON, error) {
+	size := w32.GetSystemMetrics(w32.SM_CXSMICON)
+	if bytes.HasPrefix(image, icoHeader) {
+		var err error
+		if image, err = icoImage(image, size); err != nil {
+			return 0, err
+		}
+	}
+	if len(image) == 0 {
+		return 0, fmt.Errorf("unable to create icon from an empty image")
+	}
+	icon := w32.CreateIconFromResourceEx(unsafe.Pointer(&image[0]), uint32(len(image)), true, 0x00030000, size, size, w32.LR_DEFAULTCOLOR)
+	if icon == 0 {
+		return 0, fmt.Errorf("unable to create icon from image")
+	}
+	return icon, nil
+}
+
+// WindowSetTaskbarProgress shows the progress on the taskbar button of the window
+func (f *Frontend) WindowSetTaskbarProgress(state frontend.ProgressState, completed, total uint64) {
+	if _, ok := taskbarProgressFlags[state]; !ok {
+		f.logger.Warning("Unknown taskbar progress state: %s", state)
+		return
+	}
+	f.mainWindow.Invoke(func() {
+		f.taskbar.setProgress(f.mainWindow.Handle(), state, completed, total)
+	})
+}
+
+// WindowSetThumbbarButtons replaces the buttons of the thumbnail toolbar of the taskbar button of the window
+func (f *Frontend) WindowSetThumbbarButtons(buttons []frontend.ThumbbarButton) error {
+	if len(buttons) > frontend.MaxThumbbarButtons {
+		return fmt.Errorf("too many thumbnail toolbar buttons: %d, the maximum is %d", len(buttons), frontend.MaxThumbbarButtons)
+	}
+	thumbbarButtons := make([]thumbbarButton, len(buttons))
+	for index, button := range buttons {
+		thumbbarButtons[index].ThumbbarButton = button
+		if len(button.Icon) == 0 {
+			continue
+		}
+		icon, err := createSmallIcon(button.Icon)
+		if err != nil {
+			for _, created := range thumbbarButtons[:index] {
+				if created.icon != 0 {
+					w32.DestroyIcon(created.icon)
+				}
+			}
+			return fmt.Errorf("thumbnail toolbar button %d: %w", index, err)
+		}
+		thumbbarButtons[index].icon = icon
+	}
+	f.mainWindow.Invoke(func() {
+		f.taskbar.setButtons(f.mainWindow.Handle(), thumbbarButtons)
+	})
+	return nil
+}
+
+// WindowSetOverlayIcon shows the icon over the icon of the taskbar button of the window
+func (f *Frontend) WindowSetOverlayIcon(image []byte, description string) error {
+	icon, err := createSmallIcon(image)
+	if err != nil {
+		return fmt.Errorf("invalid overlay icon: %w", err)
+	}
+	f.mainWindow.Invoke(func() {
+		f.taskbar.setOverlayIcon(f.mainWindow.Handle(), icon, description)
+	})
+	return nil
+}
+
+// WindowClearOverlayIcon removes the icon shown over the icon of the taskbar button of the window
+func (f *Frontend) WindowClearOverlayIcon() {
+	f.mainWindow.Invoke(func() {
+		f.taskbar.setOverlayIcon(f.mainWindow.Handle(), 0, "")
+	})
+}
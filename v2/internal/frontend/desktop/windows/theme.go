@@ -34,10 +34,10 @@ func (w *Window) UpdateTheme() {
 	}
 	win32.SetTheme(w.Handle(), isDarkMode)
 
-	// Custom theme processing
+	// Custom theme processing, the theme of the window overrides the one of the options
 	winOptions := w.frontendOptions.Windows
-	var customTheme *windows.ThemeSettings
-	if winOptions != nil {
+	customTheme := w.customTheme
+	if customTheme == nil && winOptions != nil {
 		customTheme = winOptions.CustomTheme
 	}
 	// Custom theme
@@ -64,4 +64,8 @@ func (w *Window) UpdateTheme() {
 			}
 		}
 	}
+	if w.isDarkMode != isDarkMode {
+		w.isDarkMode = isDarkMode
+		w.updateAcrylicTint()
+	}
 }
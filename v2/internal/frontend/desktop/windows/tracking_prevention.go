@@ -0,0 +1,47 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	"github.com/wailsapp/wails/v2/internal/platform/com"
+	"github.com/wailsapp/wails/v2/pkg/options/windows"
+)
+
+var (
+	iidCoreWebView2_13      = ole.NewGUID("{F75F09A8-667E-4983-88D6-C8773F315E84}")
+	iidCoreWebView2Profile3 = ole.NewGUID("{B188E659-5685-4E05-BDBA-FC640E0F1992}")
+)
+
+// The vtable indexes of the methods used to set the tracking prevention, which aren't wrapped by go-webview2
+const (
+	methodWebView13GetProfile                         = 105
+	methodProfile3PutPreferredTrackingPreventionLevel = 14
+)
+
+// setTrackingPreventionLevel sets the PreferredTrackingPreventionLevel of the profile of the webview. The
+// COREWEBVIEW2_TRACKING_PREVENTION_LEVEL values are the levels of the options without the default.
+func setTrackingPreventionLevel(webview *edge.ICoreWebView2, level windows.TrackingPreventionLevel) error {
+	var webview13 *winrtObject
+	if err := (*winrtObject)(unsafe.Pointer(webview)).Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(iidCoreWebView2_13)), uintptr(unsafe.Pointer(&webview13))); err != nil {
+		return err
+	}
+	defer webview13.Release()
+
+	var profile *winrtObject
+	if err := webview13.Call(methodWebView13GetProfile, uintptr(unsafe.Pointer(&profile))); err != nil {
+		return err
+	}
+	defer profile.Release()
+
+	var profile3 *winrtObject
+	if err := profile.Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(iidCoreWebView2Profile3)), uintptr(unsafe.Pointer(&profile3))); err != nil {
+		return err
+	}
+	defer profile3.Release()
+
+	return profile3.Call(methodProfile3PutPreferredTrackingPreventionLevel, uintptr(level-1))
+}
@@ -0,0 +1,67 @@
+//go:build windows
+
+package windows
+
+import (
+	"errors"
+	"unsafe"
+
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	"github.com/wailsapp/wails/v2/pkg/assetserver"
+)
+
+// The vtable index of get_UserAgent of ICoreWebView2Settings2, the getter of go-webview2 doesn't return the string
+const methodSettings2GetUserAgent = 21
+
+// setupUserAgent keeps the default user agent of the webview, to restore it when the user agent is set to an empty
+// string, and sets the user agent if it has been set. wails.io is appended to the user agent of the requests by
+// processRequest unless it is already appended by customUserAgent.
+func (f *Frontend) setupUserAgent(settings *edge.ICoreWebViewSettings) {
+	settings2 := settings.GetICoreWebView2Settings2()
+	if settings2 == nil {
+		if f.userAgent != "" {
+			f.logger.Warning("Unable to set the user agent, WebView2 Runtime 86.0.616.0 or later is required")
+		}
+		return
+	}
+	userAgent, err := getCoTaskString((*winrtObject)(unsafe.Pointer(settings2)), methodSettings2GetUserAgent)
+	if err != nil {
+		f.logger.Error("Unable to get the user agent: %s", err)
+		return
+	}
+	f.defaultUserAgent = userAgent
+	if f.userAgent != "" {
+		if err := settings2.PutUserAgent(customUserAgent(f.userAgent)); err != nil {
+			f.logger.Error("Unable to set the user agent: %s", err)
+		}
+	}
+}
+
+// WindowSetUserAgent sets the user agent of the webview, an empty string restores the default user agent
+func (f *Frontend) WindowSetUserAgent(userAgent string) {
+	f.mainWindow.Invoke(func() {
+		settings, err := f.chromium.GetSettings()
+		if err == nil && f.defaultUserAgent == "" {
+			err = errors.New("WebView2 Runtime 86.0.616.0 or later is required")
+		}
+		if err != nil {
+			f.logger.Error("Unable to set the user agent: %s", err)
+			return
+		}
+		f.userAgent = userAgent
+		if userAgent == "" {
+			userAgent = f.defaultUserAgent
+		} else {
+			userAgent = customUserAgent(userAgent)
+		}
+		if err := settings.GetICoreWebView2Settings2().PutUserAgent(userAgent); err != nil {
+			f.logger.Error("Unable to set the user agent: %s", err)
+		}
+	})
+}
+
+// customUserAgent appends wails.io to a user agent set by the application, as on macOS and Linux, so that it is also
+// part of navigator.userAgent
+func customUserAgent(userAgent string) string {
+	return userAgent + " " + assetserver.WailsUserAgentValue
+}
@@ -0,0 +1,60 @@
+//go:build windows
+
+package windows
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/wailsapp/go-webview2/pkg/edge"
+)
+
+// processFailedReasons are the reasons passed to OnWebView2Crash for the kinds of failed processes
+var processFailedReasons = map[edge.COREWEBVIEW2_PROCESS_FAILED_KIND]string{
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_BROWSER_PROCESS_EXITED:        "browser-process-exited",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_EXITED:         "render-process-exited",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_RENDER_PROCESS_UNRESPONSIVE:   "render-process-unresponsive",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_FRAME_RENDER_PROCESS_EXITED:   "frame-render-process-exited",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_UTILITY_PROCESS_EXITED:        "utility-process-exited",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_SANDBOX_HELPER_PROCESS_EXITED: "sandbox-helper-process-exited",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_GPU_PROCESS_EXITED:            "gpu-process-exited",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_PPAPI_PLUGIN_PROCESS_EXITED:   "ppapi-plugin-process-exited",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_PPAPI_BROKER_PROCESS_EXITED:   "ppapi-broker-process-exited",
+	edge.COREWEBVIEW2_PROCESS_FAILED_KIND_UNKNOWN_PROCESS_EXITED:        "unknown-process-exited",
+}
+
+func processFailedReason(kind edge.COREWEBVIEW2_PROCESS_FAILED_KIND) string {
+	if reason, ok := processFailedReasons[kind]; ok {
+		return reason
+	}
+	return fmt.Sprintf("process-failed-%d", kind)
+}
+
+// webviewCrashed calls OnWebView2Crash, it returns true if the webview should be restarted
+func (f *Frontend) webviewCrashed(kind edge.COREWEBVIEW2_PROCESS_FAILED_KIND) bool {
+	opts := f.frontendOptions.Windows
+	if opts == nil || opts.OnWebView2Crash == nil {
+		return false
+	}
+	return opts.OnWebView2Crash(processFailedReason(kind))
+}
+
+// recreateWebview replaces the webview after its browser process has exited. The old webview can't be used anymore,
+// so a new one is embedded in the window and navigated to the start page. The state of the frontend which isn't
+// bound to the webview, EG the zoom of the origins, is kept.
+func (f *Frontend) recreateWebview() {
+	f.logger.Info("Recreating the WebView2 after its browser process has exited")
+	// The webview is replaced after the event handler of the old webview has returned
+	f.mainWindow.Invoke(func() {
+		f.chromium = edge.NewChromium()
+		f.mainWindow.chromium = f.chromium
+		f.embedChromium()
+		// The same hack as in navigationCompleted to make the new webview visible
+		if err := f.chromium.Hide(); err != nil {
+			log.Fatal(err)
+		}
+		if err := f.chromium.Show(); err != nil {
+			log.Fatal(err)
+		}
+	})
+}
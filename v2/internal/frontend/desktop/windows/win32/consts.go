@@ -29,23 +29,44 @@ var (
 	procEmptyClipboard             = moduser32.NewProc("EmptyClipboard")
 	procGetClipboardData           = moduser32.NewProc("GetClipboardData")
 	procSetClipboardData           = moduser32.NewProc("SetClipboardData")
+	procGetForegroundWindow        = moduser32.NewProc("GetForegroundWindow")
+	procSetForegroundWindow        = moduser32.NewProc("SetForegroundWindow")
+	procAllowSetForegroundWindow   = moduser32.NewProc("AllowSetForegroundWindow")
+	procBringWindowToTop           = moduser32.NewProc("BringWindowToTop")
+	procGetWindowThreadProcessId   = moduser32.NewProc("GetWindowThreadProcessId")
+	procAttachThreadInput          = moduser32.NewProc("AttachThreadInput")
+	procFlashWindowEx              = moduser32.NewProc("FlashWindowEx")
+	procRegisterHotKey             = moduser32.NewProc("RegisterHotKey")
+	procUnregisterHotKey           = moduser32.NewProc("UnregisterHotKey")
+	procSetWindowDisplayAffinity   = moduser32.NewProc("SetWindowDisplayAffinity")
+	procSetLayeredWindowAttributes = moduser32.NewProc("SetLayeredWindowAttributes")
+	procGetSystemMenu              = moduser32.NewProc("GetSystemMenu")
+	procEnableMenuItem             = moduser32.NewProc("EnableMenuItem")
+	procPrintWindow                = moduser32.NewProc("PrintWindow")
 )
 var (
 	moddwmapi                        = syscall.NewLazyDLL("dwmapi.dll")
 	procDwmSetWindowAttribute        = moddwmapi.NewProc("DwmSetWindowAttribute")
 	procDwmExtendFrameIntoClientArea = moddwmapi.NewProc("DwmExtendFrameIntoClientArea")
+	procDwmEnableBlurBehindWindow    = moddwmapi.NewProc("DwmEnableBlurBehindWindow")
+	procDwmGetWindowAttribute        = moddwmapi.NewProc("DwmGetWindowAttribute")
 )
 var (
 	modwingdi            = syscall.NewLazyDLL("gdi32.dll")
 	procCreateSolidBrush = modwingdi.NewProc("CreateSolidBrush")
+	procCreateRectRgn    = modwingdi.NewProc("CreateRectRgn")
+	procCombineRgn       = modwingdi.NewProc("CombineRgn")
+	procDeleteObject     = modwingdi.NewProc("DeleteObject")
 )
 var (
-	kernel32           = syscall.NewLazyDLL("kernel32")
-	kernelGlobalAlloc  = kernel32.NewProc("GlobalAlloc")
-	kernelGlobalFree   = kernel32.NewProc("GlobalFree")
-	kernelGlobalLock   = kernel32.NewProc("GlobalLock")
-	kernelGlobalUnlock = kernel32.NewProc("GlobalUnlock")
-	kernelLstrcpy      = kernel32.NewProc("lstrcpyW")
+	kernel32               = syscall.NewLazyDLL("kernel32")
+	kernelGlobalAlloc      = kernel32.NewProc("GlobalAlloc")
+	kernelGlobalFree       = kernel32.NewProc("GlobalFree")
+	kernelGlobalLock       = kernel32.NewProc("GlobalLock")
+	kernelGlobalUnlock     = kernel32.NewProc("GlobalUnlock")
+	kernelLstrcpy          = kernel32.NewProc("lstrcpyW")
+	procGetLocaleInfoEx    = kernel32.NewProc("GetLocaleInfoEx")
+	procGetCurrentThreadId = kernel32.NewProc("GetCurrentThreadId")
 )
 
 var windowsVersion, _ = operatingsystem.GetWindowsVersionInfo()
@@ -0,0 +1,31 @@
+//go:build windows
+
+package win32
+
+import "syscall"
+
+// The modifiers of RegisterHotKey
+const (
+	MOD_ALT      = 0x1
+	MOD_CONTROL  = 0x2
+	MOD_SHIFT    = 0x4
+	MOD_WIN      = 0x8
+	MOD_NOREPEAT = 0x4000
+)
+
+// ERROR_HOTKEY_ALREADY_REGISTERED is returned by RegisterHotKey if the hotkey is registered by another application
+const ERROR_HOTKEY_ALREADY_REGISTERED syscall.Errno = 1409
+
+// RegisterHotKey registers a system wide hotkey, WM_HOTKEY is sent to the window with the id when it is pressed
+func RegisterHotKey(hwnd uintptr, id int, modifiers uint, key uint) error {
+	ret, _, err := procRegisterHotKey.Call(hwnd, uintptr(id), uintptr(modifiers), uintptr(key))
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+// UnregisterHotKey removes a hotkey registered by RegisterHotKey
+func UnregisterHotKey(hwnd uintptr, id int) {
+	_, _, _ = procUnregisterHotKey.Call(hwnd, uintptr(id))
+}
@@ -0,0 +1,36 @@
+//go:build windows
+
+package win32
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+const (
+	LOCALE_SDECIMAL        = 0x0000000E
+	LOCALE_STHOUSAND       = 0x0000000F
+	LOCALE_SCURRENCY       = 0x00000014
+	LOCALE_SINTLSYMBOL     = 0x00000015
+	LOCALE_SSHORTDATE      = 0x0000001F
+	LOCALE_SLONGDATE       = 0x00000020
+	LOCALE_SNAME           = 0x0000005C
+	LOCALE_STIMEFORMAT     = 0x00001003
+	LOCALE_IFIRSTDAYOFWEEK = 0x0000100C
+)
+
+// GetUserLocaleInfo returns the value of the given LCType for the locale of the user. This honours the customisations
+// of the regional settings.
+func GetUserLocaleInfo(lcType uint32) (string, error) {
+	// LOCALE_NAME_USER_DEFAULT is NULL
+	size, _, err := procGetLocaleInfoEx.Call(0, uintptr(lcType), 0, 0)
+	if size == 0 {
+		return "", err
+	}
+	buf := make([]uint16, size)
+	ret, _, err := procGetLocaleInfoEx.Call(0, uintptr(lcType), uintptr(unsafe.Pointer(&buf[0])), size)
+	if ret == 0 {
+		return "", err
+	}
+	return syscall.UTF16ToString(buf), nil
+}
@@ -0,0 +1,46 @@
+//go:build windows
+
+package win32
+
+import "syscall"
+
+var (
+	modwtsapi32                          = syscall.NewLazyDLL("wtsapi32.dll")
+	procWTSRegisterSessionNotification   = modwtsapi32.NewProc("WTSRegisterSessionNotification")
+	procWTSUnRegisterSessionNotification = modwtsapi32.NewProc("WTSUnRegisterSessionNotification")
+
+	procGetSystemMetrics = moduser32.NewProc("GetSystemMetrics")
+)
+
+// Session
+const (
+	// WM_WTSSESSION_CHANGE - Notifies applications of changes in the session state.
+	WM_WTSSESSION_CHANGE = 0x02B1
+
+	WTS_CONSOLE_CONNECT    = 0x1
+	WTS_CONSOLE_DISCONNECT = 0x2
+	WTS_REMOTE_CONNECT     = 0x3
+	WTS_REMOTE_DISCONNECT  = 0x4
+	WTS_SESSION_LOCK       = 0x7
+	WTS_SESSION_UNLOCK     = 0x8
+
+	NOTIFY_FOR_THIS_SESSION = 0
+
+	SM_REMOTESESSION = 0x1000
+)
+
+// RegisterSessionNotification sends the WM_WTSSESSION_CHANGE messages of the current session to the window
+func RegisterSessionNotification(hwnd uintptr) bool {
+	ret, _, _ := procWTSRegisterSessionNotification.Call(hwnd, NOTIFY_FOR_THIS_SESSION)
+	return ret != 0
+}
+
+func UnRegisterSessionNotification(hwnd uintptr) {
+	_, _, _ = procWTSUnRegisterSessionNotification.Call(hwnd)
+}
+
+// IsRemoteSession returns true if the process runs in a Remote Desktop session
+func IsRemoteSession() bool {
+	ret, _, _ := procGetSystemMetrics.Call(SM_REMOTESESSION)
+	return ret != 0
+}
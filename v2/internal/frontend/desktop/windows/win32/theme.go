@@ -3,6 +3,7 @@
 package win32
 
 import (
+	"fmt"
 	"unsafe"
 
 	"golang.org/x/sys/windows/registry"
@@ -10,16 +11,30 @@ import (
 
 type DWMWINDOWATTRIBUTE int32
 
+const DwmwaTransitionsForceDisabled DWMWINDOWATTRIBUTE = 3
 const DwmwaUseImmersiveDarkModeBefore20h1 DWMWINDOWATTRIBUTE = 19
 const DwmwaUseImmersiveDarkMode DWMWINDOWATTRIBUTE = 20
 const DwmwaBorderColor DWMWINDOWATTRIBUTE = 34
 const DwmwaCaptionColor DWMWINDOWATTRIBUTE = 35
 const DwmwaTextColor DWMWINDOWATTRIBUTE = 36
 const DwmwaSystemBackdropType DWMWINDOWATTRIBUTE = 38
+const DwmwaWindowCornerPreference DWMWINDOWATTRIBUTE = 33
+
+// The values of DWMWA_WINDOW_CORNER_PREFERENCE
+const (
+	DwmwcpDefault    int32 = 0
+	DwmwcpDoNotRound int32 = 1
+	DwmwcpRound      int32 = 2
+	DwmwcpRoundSmall int32 = 3
+)
 
 const SPI_GETHIGHCONTRAST = 0x0042
+const SPI_SETHIGHCONTRAST = 0x0043
 const HCF_HIGHCONTRASTON = 0x00000001
 
+const SPI_GETCLIENTAREAANIMATION = 0x1042
+const SPI_SETCLIENTAREAANIMATION = 0x1043
+
 // BackdropType defines the type of translucency we wish to use
 type BackdropType int32
 
@@ -48,6 +63,17 @@ func SupportsBackdropTypes() bool {
 	return IsWindowsVersionAtLeast(10, 0, 22621)
 }
 
+// SupportsAcrylicTint returns true if the acrylic accent, which takes a tint colour, is available
+func SupportsAcrylicTint() bool {
+	return IsWindowsVersionAtLeast(10, 0, 17134)
+}
+
+// SupportsCornerPreference returns true if the rounding of the corners of the windows can be selected, which
+// requires Windows 11
+func SupportsCornerPreference() bool {
+	return IsWindowsVersionAtLeast(10, 0, 22000)
+}
+
 func SupportsImmersiveDarkMode() bool {
 	return IsWindowsVersionAtLeast(10, 0, 18985)
 }
@@ -74,6 +100,71 @@ func EnableTranslucency(hwnd uintptr, backdrop BackdropType) {
 	}
 }
 
+// SetCornerPreference selects the rounding of the corners of the window, one of the Dwmwcp values
+func SetCornerPreference(hwnd uintptr, preference int32) {
+	if SupportsCornerPreference() {
+		dwmSetWindowAttribute(hwnd, DwmwaWindowCornerPreference, unsafe.Pointer(&preference), unsafe.Sizeof(preference))
+	}
+}
+
+// SetTransitionsEnabled enables or disables the animations of the window, EG when it is minimised or restored. Only
+// the window is affected, not the system setting.
+func SetTransitionsEnabled(hwnd uintptr, enabled bool) {
+	var disabled int32
+	if !enabled {
+		disabled = 1
+	}
+	dwmSetWindowAttribute(hwnd, DwmwaTransitionsForceDisabled, unsafe.Pointer(&disabled), unsafe.Sizeof(disabled))
+}
+
+const (
+	DWM_BB_ENABLE     = 0x00000001
+	DWM_BB_BLURREGION = 0x00000002
+
+	RGN_OR = 2
+)
+
+// https://learn.microsoft.com/en-us/windows/win32/api/dwmapi/ns-dwmapi-dwm_blurbehind
+type dwmBlurBehind struct {
+	dwFlags                uint32
+	fEnable                int32
+	hRgnBlur               uintptr
+	fTransitionOnMaximized int32
+}
+
+// SetBlurRegion limits the blur behind effect of the window to the given regions, which are in physical pixels
+// relative to the client area. An empty list of regions applies the effect to the whole client area.
+func SetBlurRegion(hwnd uintptr, regions []RECT) error {
+	blurBehind := dwmBlurBehind{
+		dwFlags: DWM_BB_ENABLE | DWM_BB_BLURREGION,
+		fEnable: 1,
+	}
+
+	if len(regions) > 0 {
+		rgn, _, _ := procCreateRectRgn.Call(0, 0, 0, 0)
+		if rgn == 0 {
+			return fmt.Errorf("CreateRectRgn failed")
+		}
+		defer procDeleteObject.Call(rgn)
+
+		for _, region := range regions {
+			part, _, _ := procCreateRectRgn.Call(uintptr(region.Left), uintptr(region.Top), uintptr(region.Right), uintptr(region.Bottom))
+			if part == 0 {
+				return fmt.Errorf("CreateRectRgn failed")
+			}
+			procCombineRgn.Call(rgn, rgn, part, RGN_OR)
+			procDeleteObject.Call(part)
+		}
+		blurBehind.hRgnBlur = rgn
+	}
+
+	ret, _, _ := procDwmEnableBlurBehindWindow.Call(hwnd, uintptr(unsafe.Pointer(&blurBehind)))
+	if ret != 0 {
+		return fmt.Errorf("DwmEnableBlurBehindWindow failed with HRESULT 0x%x", ret)
+	}
+	return nil
+}
+
 func SetTitleBarColour(hwnd uintptr, titleBarColour int32) {
 	dwmSetWindowAttribute(hwnd, DwmwaCaptionColor, unsafe.Pointer(&titleBarColour), unsafe.Sizeof(titleBarColour))
 }
@@ -117,3 +208,14 @@ func IsCurrentlyHighContrastMode() bool {
 	r := result.DwFlags&HCF_HIGHCONTRASTON == HCF_HIGHCONTRASTON
 	return r
 }
+
+// IsClientAreaAnimationEnabled returns false if the user has turned off the animations of Windows, which is
+// the "Show animations in Windows" setting
+func IsClientAreaAnimationEnabled() bool {
+	var enabled int32
+	res, _, _ := procSystemParametersInfo.Call(SPI_GETCLIENTAREAANIMATION, 0, uintptr(unsafe.Pointer(&enabled)), 0)
+	if res == 0 {
+		return true
+	}
+	return enabled != 0
+}
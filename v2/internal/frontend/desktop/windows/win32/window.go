@@ -128,6 +128,145 @@ func RestoreWindow(hwnd uintptr) {
 	showWindow(hwnd, SW_RESTORE)
 }
 
+// ASFW_ANY allows any process to set the foreground window
+const ASFW_ANY = ^uintptr(0)
+
+// ForceForeground brings the window to the foreground, this must be called on the thread of the window. Windows only
+// lets the process which received the last input set the foreground window, so the input of the thread of the
+// foreground window is attached to the thread of the window while it is activated. The window is restored if it is
+// minimised.
+func ForceForeground(hwnd uintptr) {
+	if IsWindowMinimised(hwnd) {
+		showWindow(hwnd, SW_RESTORE)
+	} else if !IsVisible(hwnd) {
+		showWindow(hwnd, SW_SHOW)
+	}
+
+	foreground, _, _ := procGetForegroundWindow.Call()
+	if foreground == hwnd {
+		return
+	}
+	currentThread, _, _ := procGetCurrentThreadId.Call()
+	var foregroundThread uintptr
+	if foreground != 0 {
+		foregroundThread, _, _ = procGetWindowThreadProcessId.Call(foreground, 0)
+	}
+	attached := false
+	if foregroundThread != 0 && foregroundThread != currentThread {
+		ret, _, _ := procAttachThreadInput.Call(foregroundThread, currentThread, 1)
+		attached = ret != 0
+	}
+	_, _, _ = procAllowSetForegroundWindow.Call(ASFW_ANY)
+	_, _, _ = procBringWindowToTop.Call(hwnd)
+	_, _, _ = procSetForegroundWindow.Call(hwnd)
+	if attached {
+		_, _, _ = procAttachThreadInput.Call(foregroundThread, currentThread, 0)
+	}
+}
+
+// The flags of FlashWindowEx
+const (
+	FLASHW_STOP      = 0
+	FLASHW_CAPTION   = 1
+	FLASHW_TRAY      = 2
+	FLASHW_ALL       = FLASHW_CAPTION | FLASHW_TRAY
+	FLASHW_TIMER     = 4
+	FLASHW_TIMERNOFG = 12
+)
+
+type FLASHWINFO struct {
+	CbSize    uint32
+	Hwnd      uintptr
+	DwFlags   uint32
+	UCount    uint32
+	DwTimeout uint32
+}
+
+// IsForeground returns true if the window is the foreground window
+func IsForeground(hwnd uintptr) bool {
+	foreground, _, _ := procGetForegroundWindow.Call()
+	return foreground == hwnd
+}
+
+// FlashWindow flashes the caption and the taskbar button of the window once, or until the window is activated if
+// untilFocused is true
+func FlashWindow(hwnd uintptr, untilFocused bool) {
+	info := FLASHWINFO{
+		Hwnd:    hwnd,
+		DwFlags: FLASHW_ALL,
+		UCount:  1,
+	}
+	if untilFocused {
+		info.DwFlags |= FLASHW_TIMERNOFG
+		info.UCount = 0
+	}
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	_, _, _ = procFlashWindowEx.Call(uintptr(unsafe.Pointer(&info)))
+}
+
+// MA_NOACTIVATE is returned for WM_MOUSEACTIVATE so a click doesn't activate the window
+const MA_NOACTIVATE = 3
+
+// WINDOWPOS is the lparam of WM_WINDOWPOSCHANGING
+type WINDOWPOS struct {
+	Hwnd            uintptr
+	HwndInsertAfter uintptr
+	X, Y, Cx, Cy    int32
+	Flags           uint32
+}
+
+// The close command of the window menu and the flags of EnableMenuItem
+const (
+	SC_CLOSE = 0xF060
+
+	MF_BYCOMMAND = 0x0
+	MF_ENABLED   = 0x0
+	MF_GRAYED    = 0x1
+)
+
+// EnableCloseButton enables or grays out the close button of the window, which is the close item of its window menu
+func EnableCloseButton(hwnd uintptr, enabled bool) {
+	menu, _, _ := procGetSystemMenu.Call(hwnd, 0)
+	if menu == 0 {
+		return
+	}
+	flags := uintptr(MF_BYCOMMAND | MF_GRAYED)
+	if enabled {
+		flags = MF_BYCOMMAND | MF_ENABLED
+	}
+	_, _, _ = procEnableMenuItem.Call(menu, SC_CLOSE, flags)
+}
+
+// The affinities of SetWindowDisplayAffinity
+const (
+	WDA_NONE               = 0x0
+	WDA_MONITOR            = 0x1
+	WDA_EXCLUDEFROMCAPTURE = 0x11
+)
+
+// SupportsExcludeFromCapture returns true if WDA_EXCLUDEFROMCAPTURE is supported, which requires Windows 10 2004
+func SupportsExcludeFromCapture() bool {
+	return IsWindowsVersionAtLeast(10, 0, 19041)
+}
+
+// SetWindowDisplayAffinity selects where the content of the window can be displayed
+func SetWindowDisplayAffinity(hwnd uintptr, affinity uint32) error {
+	ret, _, err := procSetWindowDisplayAffinity.Call(hwnd, uintptr(affinity))
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+// LWA_ALPHA uses the alpha of SetLayeredWindowAttributes for the whole window
+const LWA_ALPHA = 0x2
+
+// SetLayeredWindowAttributes sets the opacity of a window with WS_EX_LAYERED, a layered window isn't drawn until it is
+// called
+func SetLayeredWindowAttributes(hwnd uintptr, alpha byte) {
+	_, _, _ = procSetLayeredWindowAttributes.Call(hwnd, 0, uintptr(alpha), LWA_ALPHA)
+}
+
 func ShowWindow(hwnd uintptr) {
 	showWindow(hwnd, SW_SHOW)
 }
@@ -221,3 +360,28 @@ func GetMonitorInfo(hMonitor HMONITOR, lmpi *MONITORINFO) bool {
 	)
 	return ret != 0
 }
+
+// PW_RENDERFULLCONTENT makes PrintWindow capture the content rendered by DirectComposition, EG the webview
+const PW_RENDERFULLCONTENT = 0x2
+
+// PrintWindow draws the window, including its frame, in hdc
+func PrintWindow(hwnd uintptr, hdc uintptr, flags uint32) error {
+	ret, _, err := procPrintWindow.Call(hwnd, hdc, uintptr(flags))
+	if ret == 0 {
+		return err
+	}
+	return nil
+}
+
+// DWMWA_EXTENDED_FRAME_BOUNDS are the bounds of the window without the invisible resize borders
+const DWMWA_EXTENDED_FRAME_BOUNDS = 9
+
+// GetExtendedFrameBounds returns the visible bounds of the window in screen coordinates
+func GetExtendedFrameBounds(hwnd uintptr) (RECT, error) {
+	var result RECT
+	hr, _, _ := procDwmGetWindowAttribute.Call(hwnd, DWMWA_EXTENDED_FRAME_BOUNDS, uintptr(unsafe.Pointer(&result)), unsafe.Sizeof(result))
+	if HRESULT(hr) < 0 {
+		return result, syscall.Errno(hr)
+	}
+	return result, nil
+}
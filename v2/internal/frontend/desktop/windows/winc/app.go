@@ -32,7 +32,7 @@ func init() {
 	initCtrls.DwSize = uint32(unsafe.Sizeof(initCtrls))
 	initCtrls.DwICC =
 		w32.ICC_LISTVIEW_CLASSES | w32.ICC_PROGRESS_CLASS | w32.ICC_TAB_CLASSES |
-			w32.ICC_TREEVIEW_CLASSES | w32.ICC_BAR_CLASSES
+			w32.ICC_TREEVIEW_CLASSES | w32.ICC_BAR_CLASSES | w32.ICC_DATE_CLASSES
 
 	w32.InitCommonControlsEx(&initCtrls)
 }
@@ -170,6 +170,30 @@ func (cba *ControlBase) SetTranslucentBackground() {
 	w32.SetWindowCompositionAttribute(cba.hwnd, &data)
 }
 
+// SetAcrylicBackground draws the acrylic material behind the window, tinted with the colour in the 0xAABBGGRR layout.
+// Requires Windows 10 1803 or later.
+func (cba *ControlBase) SetAcrylicBackground(tint uint32) {
+	cba.setAccent(w32.ACCENT_ENABLE_ACRYLICBLURBEHIND, tint)
+}
+
+// ClearTranslucentBackground removes the background set by SetTranslucentBackground or SetAcrylicBackground
+func (cba *ControlBase) ClearTranslucentBackground() {
+	cba.setAccent(w32.ACCENT_DISABLED, 0)
+}
+
+func (cba *ControlBase) setAccent(state w32.ACCENT_STATE, colour uint32) {
+	var accent = w32.ACCENT_POLICY{
+		AccentState:   state,
+		GradientColor: w32.DWORD(colour),
+	}
+	var data w32.WINDOWCOMPOSITIONATTRIBDATA
+	data.Attrib = w32.WCA_ACCENT_POLICY
+	data.PvData = unsafe.Pointer(&accent)
+	data.CbData = unsafe.Sizeof(accent)
+
+	w32.SetWindowCompositionAttribute(cba.hwnd, &data)
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -219,12 +243,7 @@ func (cba *ControlBase) SetMinSize(width, height int) {
 		cba.maxHeight = max(cba.minHeight, cba.maxHeight)
 	}
 
-	x, y := cba.Pos()
-	currentWidth, currentHeight := cba.Size()
-	clampedWidth, clampedHeight := cba.clampSize(currentWidth, currentHeight)
-	if clampedWidth != currentWidth || clampedHeight != currentHeight {
-		w32.MoveWindow(cba.hwnd, x, y, clampedWidth, clampedHeight, true)
-	}
+	cba.ApplySizeConstraints()
 }
 func (cba *ControlBase) SetMaxSize(width, height int) {
 	cba.maxWidth = width
@@ -238,10 +257,17 @@ func (cba *ControlBase) SetMaxSize(width, height int) {
 		cba.minHeight = min(cba.maxHeight, cba.minHeight)
 	}
 
-	x, y := cba.Pos()
+	cba.ApplySizeConstraints()
+}
+
+// ApplySizeConstraints resizes the window if its size is outside of the min and the max size. The constraints are in
+// logical pixels, they are scaled to the current DPI of the window.
+func (cba *ControlBase) ApplySizeConstraints() {
 	currentWidth, currentHeight := cba.Size()
 	clampedWidth, clampedHeight := cba.clampSize(currentWidth, currentHeight)
 	if clampedWidth != currentWidth || clampedHeight != currentHeight {
+		x, y := cba.Pos()
+		clampedWidth, clampedHeight = cba.scaleWithWindowDPI(clampedWidth, clampedHeight)
 		w32.MoveWindow(cba.hwnd, x, y, clampedWidth, clampedHeight, true)
 	}
 }
@@ -278,6 +304,18 @@ func (cba *ControlBase) SetAlwaysOnTop(b bool) {
 	}
 }
 
+// SetLevel maps a window level to the z-order. Positive levels are topmost, negative levels are placed at the bottom.
+func (cba *ControlBase) SetLevel(level int) {
+	insertAfter := w32.HWND_NOTOPMOST
+	switch {
+	case level > 0:
+		insertAfter = w32.HWND_TOPMOST
+	case level < 0:
+		insertAfter = w32.HWND_BOTTOM
+	}
+	w32.SetWindowPos(cba.hwnd, insertAfter, 0, 0, 0, 0, w32.SWP_NOSIZE|w32.SWP_NOMOVE|w32.SWP_NOACTIVATE)
+}
+
 func (cba *ControlBase) Pos() (x, y int) {
 	rect := w32.GetWindowRect(cba.hwnd)
 	x = int(rect.Left)
@@ -0,0 +1,70 @@
+//go:build windows
+
+package winc
+
+import (
+	"time"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
+
+type DateTimePicker struct {
+	ControlBase
+}
+
+// NewDateTimePicker creates a date picker, or a time picker with the w32.DTS_TIMEFORMAT style
+func NewDateTimePicker(parent Controller, style uint) *DateTimePicker {
+	dtp := new(DateTimePicker)
+
+	dtp.InitControl(w32.DATETIMEPICK_CLASS, parent, 0, w32.WS_CHILD|w32.WS_VISIBLE|w32.WS_TABSTOP|style)
+	RegMsgHandler(dtp)
+
+	dtp.SetFont(DefaultFont)
+	dtp.SetSize(200, 24)
+	return dtp
+}
+
+// Value returns the selected date and time in the local time zone
+func (dtp *DateTimePicker) Value() time.Time {
+	var st w32.SYSTEMTIME
+	w32.SendMessage(dtp.hwnd, w32.DTM_GETSYSTEMTIME, 0, uintptr(unsafe.Pointer(&st)))
+	return time.Date(int(st.Year), time.Month(st.Month), int(st.Day), int(st.Hour), int(st.Minute), int(st.Second), 0, time.Local)
+}
+
+func (dtp *DateTimePicker) SetValue(value time.Time) {
+	st := toSystemTime(value)
+	w32.SendMessage(dtp.hwnd, w32.DTM_SETSYSTEMTIME, w32.GDT_VALID, uintptr(unsafe.Pointer(&st)))
+}
+
+// SetRange limits the selectable values, a zero time leaves that end of the range open
+func (dtp *DateTimePicker) SetRange(min, max time.Time) {
+	var limits [2]w32.SYSTEMTIME
+	var flags uintptr
+	if !min.IsZero() {
+		limits[0] = toSystemTime(min)
+		flags |= w32.GDTR_MIN
+	}
+	if !max.IsZero() {
+		limits[1] = toSystemTime(max)
+		flags |= w32.GDTR_MAX
+	}
+	w32.SendMessage(dtp.hwnd, w32.DTM_SETRANGE, flags, uintptr(unsafe.Pointer(&limits[0])))
+}
+
+func (dtp *DateTimePicker) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
+	return w32.DefWindowProc(dtp.hwnd, msg, wparam, lparam)
+}
+
+func toSystemTime(value time.Time) w32.SYSTEMTIME {
+	value = value.Local()
+	return w32.SYSTEMTIME{
+		Year:      uint16(value.Year()),
+		Month:     uint16(value.Month()),
+		DayOfWeek: uint16(value.Weekday()),
+		Day:       uint16(value.Day()),
+		Hour:      uint16(value.Hour()),
+		Minute:    uint16(value.Minute()),
+		Second:    uint16(value.Second()),
+	}
+}
@@ -7,7 +7,12 @@
 
 package winc
 
-import "github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+)
 
 type Edit struct {
 	ControlBase
@@ -48,6 +53,16 @@ func (ed *Edit) SetPassword(isPassword bool) {
 	}
 }
 
+// SetCueBanner sets the text shown while the edit is empty, including while it has the focus
+func (ed *Edit) SetCueBanner(text string) {
+	w32.SendMessage(ed.hwnd, w32.EM_SETCUEBANNER, w32.TRUE, uintptr(unsafe.Pointer(syscall.StringToUTF16Ptr(text))))
+}
+
+// SelectAll selects the whole text
+func (ed *Edit) SelectAll() {
+	w32.SendMessage(ed.hwnd, w32.EM_SETSEL, 0, ^uintptr(0))
+}
+
 func (ed *Edit) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	switch msg {
 	case w32.WM_COMMAND:
@@ -175,24 +175,33 @@ func (fm *Form) Fullscreen() {
 	if fm.isFullscreen {
 		return
 	}
+	fm.fullscreen(w32.MonitorFromWindow(fm.hwnd, w32.MONITOR_DEFAULTTOPRIMARY), w32.HWND_TOP)
+}
 
-	fm.previousWindowStyle = uint32(w32.GetWindowLongPtr(fm.hwnd, w32.GWL_STYLE))
-	fm.previousWindowExStyle = uint32(w32.GetWindowLong(fm.hwnd, w32.GWL_EXSTYLE))
+// BorderlessFullscreen makes the window fullscreen on the monitor and places it above the windows which aren't
+// topmost, so it covers the taskbar. The window is moved to the monitor if it is already fullscreen.
+func (fm *Form) BorderlessFullscreen(monitor w32.HMONITOR) {
+	fm.fullscreen(monitor, w32.HWND_TOPMOST)
+}
 
-	monitor := w32.MonitorFromWindow(fm.hwnd, w32.MONITOR_DEFAULTTOPRIMARY)
+func (fm *Form) fullscreen(monitor w32.HMONITOR, insertAfter w32.HWND) {
 	var monitorInfo w32.MONITORINFO
 	monitorInfo.CbSize = uint32(unsafe.Sizeof(monitorInfo))
 	if !w32.GetMonitorInfo(monitor, &monitorInfo) {
 		return
 	}
-	if !w32.GetWindowPlacement(fm.hwnd, &fm.previousWindowPlacement) {
-		return
+	if !fm.isFullscreen {
+		fm.previousWindowStyle = uint32(w32.GetWindowLongPtr(fm.hwnd, w32.GWL_STYLE))
+		fm.previousWindowExStyle = uint32(w32.GetWindowLong(fm.hwnd, w32.GWL_EXSTYLE))
+		if !w32.GetWindowPlacement(fm.hwnd, &fm.previousWindowPlacement) {
+			return
+		}
+		// According to https://devblogs.microsoft.com/oldnewthing/20050505-04/?p=35703 one should use w32.WS_POPUP | w32.WS_VISIBLE
+		w32.SetWindowLong(fm.hwnd, w32.GWL_STYLE, fm.previousWindowStyle & ^uint32(w32.WS_OVERLAPPEDWINDOW) | (w32.WS_POPUP|w32.WS_VISIBLE))
+		w32.SetWindowLong(fm.hwnd, w32.GWL_EXSTYLE, fm.previousWindowExStyle & ^uint32(w32.WS_EX_DLGMODALFRAME))
+		fm.isFullscreen = true
 	}
-	// According to https://devblogs.microsoft.com/oldnewthing/20050505-04/?p=35703 one should use w32.WS_POPUP | w32.WS_VISIBLE
-	w32.SetWindowLong(fm.hwnd, w32.GWL_STYLE, fm.previousWindowStyle & ^uint32(w32.WS_OVERLAPPEDWINDOW) | (w32.WS_POPUP|w32.WS_VISIBLE))
-	w32.SetWindowLong(fm.hwnd, w32.GWL_EXSTYLE, fm.previousWindowExStyle & ^uint32(w32.WS_EX_DLGMODALFRAME))
-	fm.isFullscreen = true
-	w32.SetWindowPos(fm.hwnd, w32.HWND_TOP,
+	w32.SetWindowPos(fm.hwnd, insertAfter,
 		int(monitorInfo.RcMonitor.Left),
 		int(monitorInfo.RcMonitor.Top),
 		int(monitorInfo.RcMonitor.Right-monitorInfo.RcMonitor.Left),
@@ -204,12 +213,18 @@ func (fm *Form) UnFullscreen() {
 	if !fm.isFullscreen {
 		return
 	}
+	// The window is only topmost if it was before a borderless fullscreen
+	insertAfter, zorder := w32.HWND(0), uint(w32.SWP_NOZORDER)
+	exStyle := uint32(w32.GetWindowLong(fm.hwnd, w32.GWL_EXSTYLE))
+	if exStyle&w32.WS_EX_TOPMOST != 0 && fm.previousWindowExStyle&w32.WS_EX_TOPMOST == 0 {
+		insertAfter, zorder = w32.HWND_NOTOPMOST, 0
+	}
 	w32.SetWindowLong(fm.hwnd, w32.GWL_STYLE, fm.previousWindowStyle)
 	w32.SetWindowLong(fm.hwnd, w32.GWL_EXSTYLE, fm.previousWindowExStyle)
 	w32.SetWindowPlacement(fm.hwnd, &fm.previousWindowPlacement)
 	fm.isFullscreen = false
-	w32.SetWindowPos(fm.hwnd, 0, 0, 0, 0, 0,
-		w32.SWP_NOMOVE|w32.SWP_NOSIZE|w32.SWP_NOZORDER|w32.SWP_NOOWNERZORDER|w32.SWP_FRAMECHANGED)
+	w32.SetWindowPos(fm.hwnd, insertAfter, 0, 0, 0, 0,
+		w32.SWP_NOMOVE|w32.SWP_NOSIZE|zorder|w32.SWP_NOOWNERZORDER|w32.SWP_FRAMECHANGED)
 }
 
 func (fm *Form) IsFullScreen() bool {
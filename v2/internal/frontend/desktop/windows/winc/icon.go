@@ -37,6 +37,17 @@ func NewIconFromResource(instance w32.HINSTANCE, resId uint16) (*Icon, error) {
 	return ico, err
 }
 
+// NewIconFromResourceWithSize loads the image of the icon resource which fits best for the given size in pixels.
+// The returned icon is not shared and must be destroyed when it is no longer used.
+func NewIconFromResourceWithSize(instance w32.HINSTANCE, resId uint16, size int) (*Icon, error) {
+	ico := new(Icon)
+	var err error
+	if ico.handle = w32.HICON(w32.LoadImageWithResourceID(instance, resId, w32.IMAGE_ICON, size, size, w32.LR_DEFAULTCOLOR)); ico.handle == 0 {
+		err = errors.New(fmt.Sprintf("Cannot load icon from resource with id %v and size %v", resId, size))
+	}
+	return ico, err
+}
+
 func ExtractIcon(fileName string, index int) (*Icon, error) {
 	ico := new(Icon)
 	var err error
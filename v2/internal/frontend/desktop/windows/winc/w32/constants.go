@@ -63,6 +63,13 @@ const (
 	IMAGE_ENHMETAFILE = 3
 )
 
+// LoadImage flags
+const (
+	LR_DEFAULTCOLOR = 0x00000000
+	LR_DEFAULTSIZE  = 0x00000040
+	LR_SHARED       = 0x00008000
+)
+
 // ShowWindow constants
 const (
 	SW_HIDE            = 0
@@ -984,6 +991,24 @@ const (
 	CCS_RIGHT         = 131
 )
 
+// DateTimePicker styles and messages
+const (
+	DATETIMEPICK_CLASS         = "SysDateTimePick32"
+	DTS_UPDOWN                 = 0x0001
+	DTS_SHOWNONE               = 0x0002
+	DTS_SHORTDATEFORMAT        = 0x0000
+	DTS_LONGDATEFORMAT         = 0x0004
+	DTS_SHORTDATECENTURYFORMAT = 0x000C
+	DTS_TIMEFORMAT             = 0x0009
+	DTM_FIRST                  = 0x1000
+	DTM_GETSYSTEMTIME          = DTM_FIRST + 1
+	DTM_SETSYSTEMTIME          = DTM_FIRST + 2
+	DTM_SETRANGE               = DTM_FIRST + 4
+	GDT_VALID                  = 0
+	GDTR_MIN                   = 0x0001
+	GDTR_MAX                   = 0x0002
+)
+
 // ProgressBar messages
 const (
 	PROGRESS_CLASS  = "msctls_progress32"
@@ -2786,6 +2811,23 @@ const (
 	WH_SYSMSGFILTER    = 6
 )
 
+// Hook codes
+const (
+	HC_ACTION = 0
+)
+
+// https://learn.microsoft.com/en-us/windows/win32/winauto/event-constants
+const (
+	EVENT_OBJECT_IME_SHOW   = 0x8027
+	EVENT_OBJECT_IME_HIDE   = 0x8028
+	EVENT_OBJECT_IME_CHANGE = 0x8029
+)
+
+// SetWinEventHook flags
+const (
+	WINEVENT_OUTOFCONTEXT = 0x0000
+)
+
 // ComboBox return values
 const (
 	CB_OKAY     = 0
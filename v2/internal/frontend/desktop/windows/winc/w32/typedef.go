@@ -213,6 +213,7 @@ type (
 	HRGN            = HANDLE
 	HRSRC           = HANDLE
 	HTHUMBNAIL      = HANDLE
+	HWINEVENTHOOK   = HANDLE
 	HWND            = HANDLE
 	LPARAM          = uintptr
 	LPCVOID         = unsafe.Pointer
@@ -1061,6 +1062,8 @@ type KBDLLHOOKSTRUCT struct {
 
 type HOOKPROC func(int, WPARAM, LPARAM) LRESULT
 
+type WINEVENTPROC func(hWinEventHook HWINEVENTHOOK, event DWORD, hwnd HWND, idObject int32, idChild int32, idEventThread DWORD, dwmsEventTime DWORD) uintptr
+
 type WINDOWPLACEMENT struct {
 	Length           uint32
 	Flags            uint32
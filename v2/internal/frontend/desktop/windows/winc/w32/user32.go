@@ -123,7 +123,10 @@ var (
 	procSetCursorPos                  = moduser32.NewProc("SetCursorPos")
 	procSetCursor                     = moduser32.NewProc("SetCursor")
 	procCreateIcon                    = moduser32.NewProc("CreateIcon")
+	procLoadImage                     = moduser32.NewProc("LoadImageW")
 	procDestroyIcon                   = moduser32.NewProc("DestroyIcon")
+	procDestroyCursor                 = moduser32.NewProc("DestroyCursor")
+	procCreateIconFromResourceEx      = moduser32.NewProc("CreateIconFromResourceEx")
 	procMonitorFromPoint              = moduser32.NewProc("MonitorFromPoint")
 	procMonitorFromRect               = moduser32.NewProc("MonitorFromRect")
 	procMonitorFromWindow             = moduser32.NewProc("MonitorFromWindow")
@@ -137,6 +140,8 @@ var (
 	procSetWindowsHookEx              = moduser32.NewProc("SetWindowsHookExW")
 	procUnhookWindowsHookEx           = moduser32.NewProc("UnhookWindowsHookEx")
 	procCallNextHookEx                = moduser32.NewProc("CallNextHookEx")
+	procSetWinEventHook               = moduser32.NewProc("SetWinEventHook")
+	procUnhookWinEvent                = moduser32.NewProc("UnhookWinEvent")
 
 	libuser32, _        = syscall.LoadLibrary("user32.dll")
 	insertMenuItem, _   = syscall.GetProcAddress(libuser32, "InsertMenuItemW")
@@ -190,6 +195,18 @@ func LoadIconWithResourceID(instance HINSTANCE, res uint16) HICON {
 	return HICON(ret)
 }
 
+func LoadImageWithResourceID(instance HINSTANCE, res uint16, imageType uint32, cx, cy int, flags uint32) HANDLE {
+	ret, _, _ := procLoadImage.Call(
+		uintptr(instance),
+		uintptr(res),
+		uintptr(imageType),
+		uintptr(cx),
+		uintptr(cy),
+		uintptr(flags))
+
+	return HANDLE(ret)
+}
+
 func LoadCursor(instance HINSTANCE, cursorName *uint16) HCURSOR {
 	ret, _, _ := procLoadCursor.Call(
 		uintptr(instance),
@@ -1104,6 +1121,26 @@ func DestroyIcon(icon HICON) bool {
 	return ret != 0
 }
 
+func DestroyCursor(cursor HCURSOR) bool {
+	ret, _, _ := procDestroyCursor.Call(
+		uintptr(cursor),
+	)
+	return ret != 0
+}
+
+func CreateIconFromResourceEx(bits unsafe.Pointer, size uint32, icon bool, version uint32, cx, cy int, flags uint32) HICON {
+	ret, _, _ := procCreateIconFromResourceEx.Call(
+		uintptr(bits),
+		uintptr(size),
+		uintptr(BoolToBOOL(icon)),
+		uintptr(version),
+		uintptr(cx),
+		uintptr(cy),
+		uintptr(flags),
+	)
+	return HICON(ret)
+}
+
 func MonitorFromPoint(x, y int, dwFlags uint32) HMONITOR {
 	ret, _, _ := procMonitorFromPoint.Call(
 		uintptr(x),
@@ -1224,6 +1261,26 @@ func CallNextHookEx(hhk HHOOK, nCode int, wParam WPARAM, lParam LPARAM) LRESULT
 	return LRESULT(ret)
 }
 
+func SetWinEventHook(eventMin DWORD, eventMax DWORD, hmodWinEventProc HMODULE, pfnWinEventProc WINEVENTPROC, idProcess DWORD, idThread DWORD, dwFlags DWORD) HWINEVENTHOOK {
+	ret, _, _ := procSetWinEventHook.Call(
+		uintptr(eventMin),
+		uintptr(eventMax),
+		uintptr(hmodWinEventProc),
+		syscall.NewCallback(pfnWinEventProc),
+		uintptr(idProcess),
+		uintptr(idThread),
+		uintptr(dwFlags),
+	)
+	return HWINEVENTHOOK(ret)
+}
+
+func UnhookWinEvent(hWinEventHook HWINEVENTHOOK) bool {
+	ret, _, _ := procUnhookWinEvent.Call(
+		uintptr(hWinEventHook),
+	)
+	return ret != 0
+}
+
 func GetKeyState(nVirtKey int32) int16 {
 	ret, _, _ := syscall.SyscallN(getKeyState,
 		uintptr(nVirtKey))
@@ -8,6 +8,7 @@ import (
 
 	"github.com/wailsapp/go-webview2/pkg/edge"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
 	"github.com/wailsapp/wails/v2/internal/system/operatingsystem"
 
@@ -28,15 +29,74 @@ type Window struct {
 	isActive                                 bool
 	hasBeenShown                             bool
 
+	// ignoreMouseEvents lets the mouse events fall through the window, opacity is the opacity of the window from 0 to
+	// 1. addedLayeredStyle is set if WS_EX_LAYERED has been added for them.
+	ignoreMouseEvents bool
+	opacity           float64
+	addedLayeredStyle bool
+
+	// sizeState is the last SIZE_ type of WM_SIZE, maximisedBeforeMinimise is set if the window was maximised when it
+	// has been minimised
+	sizeState               uintptr
+	maximisedBeforeMinimise bool
+
+	// dpi is the DPI of the window before the last WM_DPICHANGED
+	dpi uint
+
+	// closeButtonDisabled blocks the close commands, allowAltF4 lets Alt+F4 close the window anyway
+	closeButtonDisabled bool
+	allowAltF4          bool
+
+	// alwaysOnBottom keeps the window below the other windows without activating it
+	alwaysOnBottom bool
+	// skipTaskbar hides the window from the taskbar and Alt+Tab
+	skipTaskbar bool
+
 	// Theme
 	theme        winoptions.Theme
 	themeChanged bool
+	// customTheme overrides the CustomTheme of the options if it is set
+	customTheme *winoptions.ThemeSettings
 
 	framelessWithDecorations bool
 
+	// Backdrop
+	backdrop           winoptions.BackdropType
+	acrylicTintOpacity float64
+	acrylicTinted      bool
+
 	OnSuspend func()
 	OnResume  func()
 
+	OnMinimise   func()
+	OnMaximise   func()
+	OnRestore    func()
+	OnUnMaximise func()
+
+	// OnDPIChanged is called when the DPI of the window changes, before the window is resized to the suggested
+	// rectangle
+	OnDPIChanged func(oldDPI, newDPI uint, suggested winoptions.Rect)
+
+	// OnThemeChanged is called when the system theme change has changed the effective theme of the window
+	OnThemeChanged func(isDarkMode bool)
+
+	// OnLocaleChanged is called when the regional settings have been changed
+	OnLocaleChanged func()
+
+	// OnAccessibilityChanged is called when the animation or the high contrast setting has been changed
+	OnAccessibilityChanged func()
+
+	// OnMenuChanged is called when a checkbox or radio item of the application menu has been toggled
+	OnMenuChanged func()
+
+	// OnTaskbarButtonCreated is called on the main thread when the taskbar button of the window has been created
+	OnTaskbarButtonCreated func()
+	// OnThumbbarButtonClicked is called on the main thread when a button of the thumbnail toolbar is clicked
+	OnThumbbarButtonClicked func(id int)
+
+	// OnSessionChange is called when the session of the user is locked, unlocked, disconnected or reconnected
+	OnSessionChange func(change frontend.SessionChange)
+
 	chromium *edge.Chromium
 
 	// isMinimizing indicates whether the window is currently being minimized
@@ -45,6 +105,22 @@ type Window struct {
 	// 此标志用于防止无边框窗口在最小化/恢复过程中的不必要重绘
 	// Reference: https://github.com/wailsapp/wails/issues/3951
 	isMinimizing bool
+
+	// dragConstraints are applied while the window is moved by a constrained drag
+	dragConstraints *frontend.DragConstraints
+	dragStartRect   w32.RECT
+
+	// loadIcon indicates whether the application icon is shown on the window, the icons are reloaded at the
+	// matching size when the DPI changes
+	loadIcon           bool
+	smallIcon, bigIcon *winc.Icon
+
+	// modalParent is the owner window which is disabled while the window is visible
+	modalParent w32.HWND
+
+	// cursor overrides the cursor of the window if set, customCursor is set if it has been created from an image
+	cursor       w32.HCURSOR
+	customCursor bool
 }
 
 func NewWindow(parent winc.Controller, appoptions *options.App, versionInfo *operatingsystem.WindowsVersionInfo, chromium *edge.Chromium) *Window {
@@ -60,6 +136,7 @@ func NewWindow(parent winc.Controller, appoptions *options.App, versionInfo *ope
 		isActive:        true,
 		themeChanged:    true,
 		chromium:        chromium,
+		opacity:         1,
 
 		framelessWithDecorations: appoptions.Frameless && (windowsOptions == nil || !windowsOptions.DisableFramelessWindowDecorations),
 	}
@@ -72,7 +149,7 @@ func NewWindow(parent winc.Controller, appoptions *options.App, versionInfo *ope
 			exStyle |= w32.WS_EX_NOREDIRECTIONBITMAP
 		}
 	}
-	if appoptions.AlwaysOnTop {
+	if appoptions.WindowLevel > options.WindowLevelNormal || (appoptions.WindowLevel == options.WindowLevelNormal && appoptions.AlwaysOnTop) {
 		exStyle |= w32.WS_EX_TOPMOST
 	}
 
@@ -89,16 +166,16 @@ func NewWindow(parent winc.Controller, appoptions *options.App, versionInfo *ope
 	winc.RegMsgHandler(result)
 	result.SetParent(parent)
 
-	loadIcon := true
-	if windowsOptions != nil && windowsOptions.DisableWindowIcon == true {
-		loadIcon = false
-	}
-	if loadIcon {
-		if ico, err := winc.NewIconFromResource(winc.GetAppInstance(), uint16(winc.AppIconID)); err == nil {
-			result.SetIcon(0, ico)
+	if windowsOptions != nil && windowsOptions.ParentWindow != 0 {
+		w32.SetWindowLongPtr(handle, w32.GWLP_HWNDPARENT, windowsOptions.ParentWindow)
+		if windowsOptions.Modal {
+			result.modalParent = w32.HWND(windowsOptions.ParentWindow)
 		}
 	}
 
+	result.loadIcon = windowsOptions == nil || !windowsOptions.DisableWindowIcon
+	result.updateIcons()
+
 	if appoptions.BackgroundColour != nil {
 		win32.SetBackgroundColour(result.Handle(), appoptions.BackgroundColour.R, appoptions.BackgroundColour.G, appoptions.BackgroundColour.B)
 	}
@@ -120,20 +197,49 @@ func NewWindow(parent winc.Controller, appoptions *options.App, versionInfo *ope
 
 	result.UpdateTheme()
 
+	dpi, _ := result.GetWindowDPI()
+	result.dpi = uint(dpi)
+
+	win32.RegisterSessionNotification(result.Handle())
+
 	if windowsOptions != nil {
 		result.OnSuspend = windowsOptions.OnSuspend
 		result.OnResume = windowsOptions.OnResume
+		result.OnMinimise = windowsOptions.OnMinimise
+		result.OnMaximise = windowsOptions.OnMaximise
+		result.OnRestore = windowsOptions.OnRestore
+		result.OnUnMaximise = windowsOptions.OnUnMaximise
+		result.OnDPIChanged = windowsOptions.OnDPIChanged
+		result.OnThemeChanged = windowsOptions.OnThemeChanged
 		if windowsOptions.WindowIsTranslucent {
-			if !win32.SupportsBackdropTypes() {
-				result.SetTranslucentBackground()
-			} else {
-				win32.EnableTranslucency(result.Handle(), win32.BackdropType(windowsOptions.BackdropType))
-			}
+			result.acrylicTintOpacity = clampTintOpacity(windowsOptions.AcrylicTintOpacity)
+			result.setBackdrop(windowsOptions.BackdropType)
+		}
+
+		if preference, ok := cornerPreferences[windowsOptions.CornerPreference]; ok && preference != win32.DwmwcpDefault {
+			win32.SetCornerPreference(result.Handle(), preference)
 		}
 
 		if windowsOptions.DisableWindowIcon {
 			result.DisableIcon()
 		}
+
+		if windowsOptions.DisableMinimiseButton {
+			result.EnableMinButton(false)
+		}
+		if windowsOptions.DisableMaximiseButton {
+			result.EnableMaxButton(false)
+		}
+		result.allowAltF4 = windowsOptions.AllowAltF4
+		if windowsOptions.DisableCloseButton {
+			result.setCloseButtonEnabled(false)
+		}
+		if windowsOptions.SkipTaskbar {
+			result.setSkipTaskbar(true)
+		}
+		if windowsOptions.DisableWindowAnimations {
+			win32.SetTransitionsEnabled(result.Handle(), false)
+		}
 	}
 
 	// Dlg forces display of focus rectangles, as soon as the user starts to type.
@@ -145,9 +251,21 @@ func NewWindow(parent winc.Controller, appoptions *options.App, versionInfo *ope
 		result.SetApplicationMenu(appoptions.Menu)
 	}
 
+	if appoptions.WindowLevel < options.WindowLevelNormal {
+		result.SetLevel(int(appoptions.WindowLevel))
+	}
+
 	return result
 }
 
+// cornerPreferences are the values of DWMWA_WINDOW_CORNER_PREFERENCE of the corner preferences
+var cornerPreferences = map[winoptions.CornerPreference]int32{
+	winoptions.CornerDefault:    win32.DwmwcpDefault,
+	winoptions.CornerRound:      win32.DwmwcpRound,
+	winoptions.CornerRoundSmall: win32.DwmwcpRoundSmall,
+	winoptions.CornerSquare:     win32.DwmwcpDoNotRound,
+}
+
 func (w *Window) Fullscreen() {
 	if w.Form.IsFullScreen() {
 		return
@@ -160,6 +278,15 @@ func (w *Window) Fullscreen() {
 	w.Form.Fullscreen()
 }
 
+func (w *Window) BorderlessFullscreen(monitor w32.HMONITOR) {
+	if w.framelessWithDecorations && !w.Form.IsFullScreen() {
+		win32.ExtendFrameIntoClientArea(w.Handle(), false)
+	}
+	w.Form.SetMaxSize(0, 0)
+	w.Form.SetMinSize(0, 0)
+	w.Form.BorderlessFullscreen(monitor)
+}
+
 func (w *Window) UnFullscreen() {
 	if !w.Form.IsFullScreen() {
 		return
@@ -198,7 +325,25 @@ func (w *Window) IsVisible() bool {
 
 func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 
+	if msg == wmTaskbarButtonCreated && w.OnTaskbarButtonCreated != nil {
+		w.OnTaskbarButtonCreated()
+	}
+
+	if w.closeCommandBlocked(msg, wparam) {
+		return 0
+	}
+	if result, handled := w.keepOnBottom(msg, lparam); handled {
+		return result
+	}
+
 	switch msg {
+	case w32.WM_COMMAND:
+		if w32.HIWORD(uint32(wparam)) == thbnClicked && w.OnThumbbarButtonClicked != nil {
+			w.OnThumbbarButtonClicked(int(w32.LOWORD(uint32(wparam))))
+			return 0
+		}
+	case w32.WM_SIZE:
+		w.sizeStateChanged(wparam)
 	case win32.WM_POWERBROADCAST:
 		switch wparam {
 		case win32.PBT_APMSUSPEND:
@@ -213,14 +358,47 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	case w32.WM_SETTINGCHANGE:
 		settingChanged := w32.UTF16PtrToString((*uint16)(unsafe.Pointer(lparam)))
 		if settingChanged == "ImmersiveColorSet" {
+			wasDarkMode := w.isDarkMode
 			w.themeChanged = true
 			w.UpdateTheme()
+			if w.isDarkMode != wasDarkMode && w.OnThemeChanged != nil {
+				go w.OnThemeChanged(w.isDarkMode)
+			}
+		}
+		if settingChanged == "intl" && w.OnLocaleChanged != nil {
+			go w.OnLocaleChanged()
+		}
+		if (wparam == win32.SPI_SETCLIENTAREAANIMATION || wparam == win32.SPI_SETHIGHCONTRAST) && w.OnAccessibilityChanged != nil {
+			go w.OnAccessibilityChanged()
 		}
 		return 0
 	case w32.WM_NCLBUTTONDOWN:
 		w32.SetFocus(w.Handle())
-	case w32.WM_MOVE, w32.WM_MOVING:
+	case w32.WM_SETCURSOR:
+		if hitTest := w32.LOWORD(uint32(lparam)); w.cursor != 0 && (hitTest == w32.HTCLIENT || hitTest == w32.HTCAPTION) {
+			w32.SetCursor(w.cursor)
+			return 1
+		}
+	case w32.WM_MOVING:
+		if w.dragConstraints != nil {
+			w.applyDragConstraints((*w32.RECT)(unsafe.Pointer(lparam)))
+		}
+		w.chromium.NotifyParentWindowPositionChanged()
+	case w32.WM_MOVE:
 		w.chromium.NotifyParentWindowPositionChanged()
+	case w32.WM_EXITSIZEMOVE:
+		w.dragConstraints = nil
+	case w32.WM_SHOWWINDOW:
+		if w.modalParent != 0 {
+			w32.EnableWindow(w.modalParent, wparam == 0)
+		}
+	case win32.WM_WTSSESSION_CHANGE:
+		if change, ok := sessionChanges[wparam]; ok && w.OnSessionChange != nil {
+			go w.OnSessionChange(change)
+		}
+	case w32.WM_DESTROY:
+		win32.UnRegisterSessionNotification(w.Handle())
+		w.releaseModalParent()
 	case w32.WM_ACTIVATE:
 		//if !w.frontendOptions.Frameless {
 		w.themeChanged = true
@@ -234,14 +412,33 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 		}
 
 	case 0x02E0: //w32.WM_DPICHANGED
+		// The callback can prepare for the new DPI before the window is resized
+		oldDPI, newDPI := w.dpi, uint(w32.LOWORD(uint32(wparam)))
+		w.dpi = newDPI
 		newWindowSize := (*w32.RECT)(unsafe.Pointer(lparam))
-		w32.SetWindowPos(w.Handle(),
-			uintptr(0),
-			int(newWindowSize.Left),
-			int(newWindowSize.Top),
-			int(newWindowSize.Right-newWindowSize.Left),
-			int(newWindowSize.Bottom-newWindowSize.Top),
-			w32.SWP_NOZORDER|w32.SWP_NOACTIVATE)
+		if oldDPI != newDPI && w.OnDPIChanged != nil {
+			w.OnDPIChanged(oldDPI, newDPI, winoptions.Rect{
+				X:      int(newWindowSize.Left),
+				Y:      int(newWindowSize.Top),
+				Width:  int(newWindowSize.Right - newWindowSize.Left),
+				Height: int(newWindowSize.Bottom - newWindowSize.Top),
+			})
+		}
+		// A fullscreen window keeps covering its monitor, it may have been moved to a monitor with another DPI
+		if !w.Form.IsFullScreen() {
+			w32.SetWindowPos(w.Handle(),
+				uintptr(0),
+				int(newWindowSize.Left),
+				int(newWindowSize.Top),
+				int(newWindowSize.Right-newWindowSize.Left),
+				int(newWindowSize.Bottom-newWindowSize.Top),
+				w32.SWP_NOZORDER|w32.SWP_NOACTIVATE)
+			// The min and the max size are scaled to the new DPI, the rounding of the suggested size may break them
+			if !win32.IsWindowMaximised(w.Handle()) {
+				w.Form.ApplySizeConstraints()
+			}
+		}
+		w.updateIcons()
 	}
 
 	if w.frontendOptions.Frameless {
@@ -313,6 +510,47 @@ func (w *Window) WndProc(msg uint32, wparam, lparam uintptr) uintptr {
 	return w.Form.WndProc(msg, wparam, lparam)
 }
 
+// sizeStateChanged calls the callbacks of the transition to the SIZE_ type of a WM_SIZE. A resize sends WM_SIZE with
+// the same type repeatedly, only a change of the type is a transition.
+func (w *Window) sizeStateChanged(state uintptr) {
+	if state != w32.SIZE_RESTORED && state != w32.SIZE_MINIMIZED && state != w32.SIZE_MAXIMIZED {
+		return
+	}
+	previous := w.sizeState
+	if state == previous {
+		return
+	}
+	w.sizeState = state
+	call := func(callback func()) {
+		if callback != nil {
+			callback()
+		}
+	}
+
+	switch state {
+	case w32.SIZE_MINIMIZED:
+		w.maximisedBeforeMinimise = previous == w32.SIZE_MAXIMIZED
+		call(w.OnMinimise)
+	case w32.SIZE_MAXIMIZED:
+		if previous == w32.SIZE_MINIMIZED {
+			call(w.OnRestore)
+			if w.maximisedBeforeMinimise {
+				return
+			}
+		}
+		call(w.OnMaximise)
+	case w32.SIZE_RESTORED:
+		wasMaximised := previous == w32.SIZE_MAXIMIZED
+		if previous == w32.SIZE_MINIMIZED {
+			call(w.OnRestore)
+			wasMaximised = w.maximisedBeforeMinimise
+		}
+		if wasMaximised {
+			call(w.OnUnMaximise)
+		}
+	}
+}
+
 func (w *Window) IsMaximised() bool {
 	return win32.IsWindowMaximised(w.Handle())
 }
@@ -329,14 +567,154 @@ func (w *Window) IsFullScreen() bool {
 	return win32.IsWindowFullScreen(w.Handle())
 }
 
+// SizeToContent resizes the window so its client area has the size in physical pixels. The window is clamped to the
+// work area of its monitor and moved back into it if needed. Maximised and fullscreen windows aren't resized.
+func (w *Window) SizeToContent(width, height int) {
+	if w.IsMaximised() || w.IsFullScreen() {
+		return
+	}
+	windowRect := w32.GetWindowRect(w.Handle())
+	clientRect := w32.GetClientRect(w.Handle())
+	width += int((windowRect.Right - windowRect.Left) - (clientRect.Right - clientRect.Left))
+	height += int((windowRect.Bottom - windowRect.Top) - (clientRect.Bottom - clientRect.Top))
+	x, y := int(windowRect.Left), int(windowRect.Top)
+
+	monitor := w32.MonitorFromWindow(w.Handle(), w32.MONITOR_DEFAULTTONEAREST)
+	var monitorInfo w32.MONITORINFO
+	monitorInfo.CbSize = uint32(unsafe.Sizeof(monitorInfo))
+	if monitor != 0 && w32.GetMonitorInfo(monitor, &monitorInfo) {
+		work := monitorInfo.RcWork
+		width = min(width, int(work.Right-work.Left))
+		height = min(height, int(work.Bottom-work.Top))
+		x = max(int(work.Left), min(x, int(work.Right)-width))
+		y = max(int(work.Top), min(y, int(work.Bottom)-height))
+	}
+	w32.SetWindowPos(w.Handle(), 0, x, y, width, height, w32.SWP_NOZORDER|w32.SWP_NOACTIVATE)
+}
+
+// SetTheme applies the theme to the window, SystemDefault follows the theme of the OS again. The frame is redrawn so
+// the title bar is updated immediately rather than when the window is activated again.
 func (w *Window) SetTheme(theme winoptions.Theme) {
-	w.theme = theme
-	w.themeChanged = true
 	w.Invoke(func() {
+		w.theme = theme
+		w.themeChanged = true
+		w.UpdateTheme()
+		w.redrawFrame()
+	})
+}
+
+// SetCustomTheme sets the colours of the title bar and the border of this window, nil uses the CustomTheme of the
+// options again
+func (w *Window) SetCustomTheme(theme *winoptions.ThemeSettings) {
+	w.Invoke(func() {
+		w.customTheme = theme
+		w.themeChanged = true
 		w.UpdateTheme()
 	})
 }
 
+// releaseModalParent enables the modal parent again. This must happen before the application exits, otherwise the
+// parent stays disabled.
+func (w *Window) releaseModalParent() {
+	if w.modalParent != 0 {
+		w32.EnableWindow(w.modalParent, true)
+		w32.SetForegroundWindow(w.modalParent)
+	}
+}
+
+// updateIcons loads the small and big window icons at the sizes matching the current DPI of the window, so Windows
+// picks the best image of the icon resource instead of scaling the default one.
+func (w *Window) updateIcons() {
+	if !w.loadIcon {
+		return
+	}
+
+	dpix, _ := w.GetWindowDPI()
+	for _, icon := range []struct {
+		iconType int
+		size     int
+		current  **winc.Icon
+	}{
+		{w32.ICON_SMALL, 16, &w.smallIcon},
+		{w32.ICON_BIG, 32, &w.bigIcon},
+	} {
+		ico, err := winc.NewIconFromResourceWithSize(winc.GetAppInstance(), uint16(winc.AppIconID), winc.ScaleWithDPI(icon.size, uint(dpix)))
+		if err != nil {
+			continue
+		}
+		w.SetIcon(icon.iconType, ico)
+		if *icon.current != nil {
+			(*icon.current).Destroy()
+		}
+		*icon.current = ico
+	}
+}
+
+func (w *Window) setDragConstraints(constraints *frontend.DragConstraints) {
+	w.dragConstraints = constraints
+	if constraints != nil {
+		w.dragStartRect = *w32.GetWindowRect(w.Handle())
+	}
+}
+
+// applyDragConstraints adjusts the proposed window rect of a WM_MOVING message to the current drag constraints
+func (w *Window) applyDragConstraints(rect *w32.RECT) {
+	constraints := w.dragConstraints
+	width := rect.Right - rect.Left
+	height := rect.Bottom - rect.Top
+
+	if constraints.LockX {
+		rect.Left = w.dragStartRect.Left
+	}
+	if constraints.LockY {
+		rect.Top = w.dragStartRect.Top
+	}
+
+	monitor := w32.MonitorFromRect(rect, w32.MONITOR_DEFAULTTONEAREST)
+	var monitorInfo w32.MONITORINFO
+	monitorInfo.CbSize = uint32(unsafe.Sizeof(monitorInfo))
+	if monitor != 0 && w32.GetMonitorInfo(monitor, &monitorInfo) {
+		work := monitorInfo.RcWork
+
+		if constraints.SnapDistance > 0 {
+			var dpiX, dpiY uint
+			w32.GetDPIForMonitor(monitor, w32.MDT_EFFECTIVE_DPI, &dpiX, &dpiY)
+			snapX := int32(winc.ScaleWithDPI(constraints.SnapDistance, dpiX))
+			snapY := int32(winc.ScaleWithDPI(constraints.SnapDistance, dpiY))
+
+			if !constraints.LockX {
+				if abs32(rect.Left-work.Left) <= snapX {
+					rect.Left = work.Left
+				} else if abs32(work.Right-(rect.Left+width)) <= snapX {
+					rect.Left = work.Right - width
+				}
+			}
+			if !constraints.LockY {
+				if abs32(rect.Top-work.Top) <= snapY {
+					rect.Top = work.Top
+				} else if abs32(work.Bottom-(rect.Top+height)) <= snapY {
+					rect.Top = work.Bottom - height
+				}
+			}
+		}
+
+		if constraints.KeepInWorkArea {
+			rect.Left = max(work.Left, min(rect.Left, work.Right-width))
+			rect.Top = max(work.Top, min(rect.Top, work.Bottom-height))
+		}
+	}
+
+	rect.Right = rect.Left + width
+	rect.Bottom = rect.Top + height
+}
+
+func abs32(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
 func invokeSync[T any](cba *Window, fn func() (T, error)) (res T, err error) {
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -0,0 +1,193 @@
+//go:build windows
+
+package windows
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/win32"
+	"github.com/wailsapp/wails/v2/internal/frontend/desktop/windows/winc/w32"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// windowStateFileName is the file of the window state saved with RestoreWindowState, in the user data folder
+const windowStateFileName = "wails-window-state.json"
+
+// monitorInfo returns the work area, the bounds and the device name of the monitor
+func monitorInfo(monitor w32.HMONITOR) (w32.MONITORINFOEX, bool) {
+	var info w32.MONITORINFOEX
+	info.CbSize = uint32(unsafe.Sizeof(info))
+	ok := w32.GetMonitorInfo(monitor, &info.MONITORINFO)
+	return info, ok
+}
+
+// workAreas returns the work areas of the connected monitors
+func workAreas() []frontend.WorkArea {
+	var monitors []w32.HMONITOR
+	dc := w32.GetDC(0)
+	defer w32.ReleaseDC(0, dc)
+	if !w32.EnumDisplayMonitors(dc, nil, syscall.NewCallback(collectMonitorsProc), unsafe.Pointer(&monitors)) {
+		return nil
+	}
+	result := make([]frontend.WorkArea, 0, len(monitors))
+	for _, monitor := range monitors {
+		info, ok := monitorInfo(monitor)
+		if !ok {
+			continue
+		}
+		result = append(result, frontend.WorkArea{
+			Screen:    w32.UTF16PtrToString(&info.SzDevice[0]),
+			IsPrimary: info.DwFlags&w32.MONITORINFOF_PRIMARY != 0,
+			X:         int(info.RcWork.Left),
+			Y:         int(info.RcWork.Top),
+			Width:     int(info.RcWork.Right - info.RcWork.Left),
+			Height:    int(info.RcWork.Bottom - info.RcWork.Top),
+		})
+	}
+	return result
+}
+
+// workspaceOffset returns the offset of the workspace coordinates of WINDOWPLACEMENT, which start at the work area of
+// the monitor, from the screen coordinates
+func workspaceOffset(info w32.MONITORINFOEX) (int32, int32) {
+	return info.RcWork.Left - info.RcMonitor.Left, info.RcWork.Top - info.RcMonitor.Top
+}
+
+// windowState returns the state of the window, it must be called on the main thread
+func (w *Window) windowState() frontend.WindowState {
+	var placement w32.WINDOWPLACEMENT
+	placement.Length = uint32(unsafe.Sizeof(placement))
+	if !w32.GetWindowPlacement(w.Handle(), &placement) {
+		return frontend.WindowState{}
+	}
+	// The normal position is the position of the window when it isn't maximised or minimised
+	bounds := placement.RcNormalPosition
+	var screen string
+	if info, ok := monitorInfo(w32.MonitorFromWindow(w.Handle(), w32.MONITOR_DEFAULTTONEAREST)); ok {
+		offsetX, offsetY := workspaceOffset(info)
+		bounds.Left += offsetX
+		bounds.Right += offsetX
+		bounds.Top += offsetY
+		bounds.Bottom += offsetY
+		screen = w32.UTF16PtrToString(&info.SzDevice[0])
+	}
+	return frontend.WindowState{
+		X:         int(bounds.Left),
+		Y:         int(bounds.Top),
+		Width:     int(bounds.Right - bounds.Left),
+		Height:    int(bounds.Bottom - bounds.Top),
+		Maximised: w.IsMaximised(),
+		Screen:    screen,
+	}
+}
+
+// setWindowState moves the window to the state fitted to the connected monitors, it must be called on the main thread.
+// A hidden window stays hidden and is maximised when it is shown.
+func (f *Frontend) setWindowState(state frontend.WindowState) {
+	if state.Width <= 0 || state.Height <= 0 {
+		return
+	}
+	state = frontend.FitWindowState(state, workAreas())
+	maximised := state.Maximised && !f.frontendOptions.DisableResize
+	hwnd := f.mainWindow.Handle()
+
+	var placement w32.WINDOWPLACEMENT
+	placement.Length = uint32(unsafe.Sizeof(placement))
+	if !w32.GetWindowPlacement(hwnd, &placement) {
+		return
+	}
+	bounds := w32.RECT{
+		Left:   int32(state.X),
+		Top:    int32(state.Y),
+		Right:  int32(state.X + state.Width),
+		Bottom: int32(state.Y + state.Height),
+	}
+	if info, ok := monitorInfo(w32.MonitorFromRect(&bounds, w32.MONITOR_DEFAULTTONEAREST)); ok {
+		offsetX, offsetY := workspaceOffset(info)
+		bounds.Left -= offsetX
+		bounds.Right -= offsetX
+		bounds.Top -= offsetY
+		bounds.Bottom -= offsetY
+	}
+	placement.Flags = 0
+	placement.RcNormalPosition = bounds
+	switch {
+	case !win32.IsVisible(hwnd):
+		placement.ShowCmd = w32.SW_HIDE
+		if !f.mainWindow.hasBeenShown {
+			if maximised {
+				f.frontendOptions.WindowStartState = options.Maximised
+			} else if f.frontendOptions.WindowStartState == options.Maximised {
+				f.frontendOptions.WindowStartState = options.Normal
+			}
+		}
+	case maximised:
+		placement.ShowCmd = w32.SW_SHOWMAXIMIZED
+	default:
+		placement.ShowCmd = w32.SW_SHOWNORMAL
+	}
+	w32.SetWindowPlacement(hwnd, &placement)
+}
+
+// windowStatePath returns the file of the window state, or an empty string if RestoreWindowState isn't set
+func (f *Frontend) windowStatePath() string {
+	if opts := f.frontendOptions.Windows; opts == nil || !opts.RestoreWindowState {
+		return ""
+	}
+	return filepath.Join(f.webviewUserDataPath(), windowStateFileName)
+}
+
+// restoreWindowState restores the state saved when the application was closed, it must be called on the main thread
+func (f *Frontend) restoreWindowState() {
+	path := f.windowStatePath()
+	if path == "" {
+		return
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	var state frontend.WindowState
+	if err := json.Unmarshal(data, &state); err != nil {
+		f.logger.Warning("Unable to read the window state: %s", err.Error())
+		return
+	}
+	f.setWindowState(state)
+}
+
+// saveWindowState saves the state of the window when the application is closed, it must be called on the main thread
+func (f *Frontend) saveWindowState() {
+	path := f.windowStatePath()
+	if path == "" {
+		return
+	}
+	data, err := json.Marshal(f.mainWindow.windowState())
+	if err != nil {
+		return
+	}
+	_ = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		f.logger.Warning("Unable to save the window state: %s", err.Error())
+	}
+}
+
+// WindowGetState returns the position, the size and the maximised state of the window
+func (f *Frontend) WindowGetState() frontend.WindowState {
+	results := make(chan frontend.WindowState, 1)
+	f.mainWindow.Invoke(func() {
+		results <- f.mainWindow.windowState()
+	})
+	return <-results
+}
+
+// WindowSetState restores the position, the size and the maximised state of the window
+func (f *Frontend) WindowSetState(state frontend.WindowState) {
+	f.mainWindow.Invoke(func() {
+		f.setWindowState(state)
+	})
+}
@@ -0,0 +1,203 @@
+//go:build windows
+
+package windows
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	"golang.org/x/sys/windows"
+)
+
+// The range of the zoom factor of WebView2
+const (
+	minZoomFactor = 0.25
+	maxZoomFactor = 5.0
+)
+
+// methodWebViewGetSource is the vtable index of ICoreWebView2::get_Source, which isn't wrapped by go-webview2
+const methodWebViewGetSource = 4
+
+// originZoom restores the zoom of the webview for every origin. WebView2 keeps the zoom of the controller across
+// navigations, so the zoom of the previous origin is read when another origin has been loaded. It is only used on
+// the main thread.
+type originZoom struct {
+	// persist records the zoom changes of the user and stores the levels in path
+	persist bool
+	path    string
+
+	defaultZoom float64
+	current     string
+	levels      map[string]float64
+}
+
+func newOriginZoom(persist bool, dataPath string, defaultZoom float64) *originZoom {
+	if defaultZoom <= 0 {
+		defaultZoom = 1.0
+	}
+	result := &originZoom{
+		persist:     persist,
+		defaultZoom: defaultZoom,
+		levels:      map[string]float64{},
+	}
+	if persist {
+		result.path = filepath.Join(dataPath, "wails-zoom.json")
+		if data, err := os.ReadFile(result.path); err == nil {
+			_ = json.Unmarshal(data, &result.levels)
+		}
+	}
+	return result
+}
+
+// originOf returns the scheme, host and port of the URL
+func originOf(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return strings.ToLower(rawURL)
+	}
+	return strings.ToLower(parsed.Scheme + "://" + parsed.Host)
+}
+
+// webviewSource returns the URL of the page of the webview
+func webviewSource(webview *edge.ICoreWebView2) string {
+	var source *uint16
+	if err := (*winrtObject)(unsafe.Pointer(webview)).Call(methodWebViewGetSource, uintptr(unsafe.Pointer(&source))); err != nil || source == nil {
+		return ""
+	}
+	defer ole.CoTaskMemFree(uintptr(unsafe.Pointer(source)))
+	return windows.UTF16PtrToString(source)
+}
+
+// navigated applies the zoom of the origin which has been loaded
+func (z *originZoom) navigated(webview *edge.ICoreWebView2, controller *edge.ICoreWebView2Controller) {
+	origin := originOf(webviewSource(webview))
+	if origin == "" || origin == z.current {
+		return
+	}
+	previous := z.current
+	z.current = origin
+	if !z.persist && len(z.levels) == 0 {
+		// The zoom is left to WebView2 until an origin has a zoom
+		return
+	}
+	z.record(previous, controller)
+	level, ok := z.levels[origin]
+	if !ok {
+		level = z.defaultZoom
+	}
+	_ = controller.PutZoomFactor(level)
+}
+
+// record stores the zoom of the webview for the origin if the changes of the user are persisted
+func (z *originZoom) record(origin string, controller *edge.ICoreWebView2Controller) {
+	if !z.persist || origin == "" {
+		return
+	}
+	zoom, err := controller.GetZoomFactor()
+	if err != nil {
+		return
+	}
+	if level, ok := z.levels[origin]; ok && level == zoom {
+		return
+	}
+	if zoom == z.defaultZoom {
+		if _, ok := z.levels[origin]; !ok {
+			return
+		}
+		delete(z.levels, origin)
+	} else {
+		z.levels[origin] = zoom
+	}
+	z.save()
+}
+
+// close stores the zoom of the current origin, this must be called before the webview is destroyed
+func (z *originZoom) close(controller *edge.ICoreWebView2Controller) {
+	z.record(z.current, controller)
+}
+
+// set stores the zoom of the origin and applies it if the origin is loaded
+func (z *originZoom) set(origin string, zoom float64, controller *edge.ICoreWebView2Controller) {
+	origin = originOf(origin)
+	z.levels[origin] = zoom
+	if origin == z.current {
+		_ = controller.PutZoomFactor(zoom)
+	}
+	z.save()
+}
+
+func (z *originZoom) save() {
+	if !z.persist {
+		return
+	}
+	data, err := json.Marshal(z.levels)
+	if err != nil {
+		return
+	}
+	_ = os.MkdirAll(filepath.Dir(z.path), 0o755)
+	_ = os.WriteFile(z.path, data, 0o644)
+}
+
+// WindowSetZoomForOrigin sets the zoom of the pages of the origin, it is applied immediately if the origin is loaded
+func (f *Frontend) WindowSetZoomForOrigin(origin string, zoom float64) {
+	if zoom <= 0 {
+		return
+	}
+	f.mainWindow.Invoke(func() {
+		f.originZoom.set(origin, zoom, f.chromium.GetController())
+	})
+}
+
+// WindowSetZoomFactor sets the zoom of the webview clamped to the range of WebView2. The zoom is persisted for the
+// origin with PersistZoomPerOrigin.
+func (f *Frontend) WindowSetZoomFactor(factor float64) error {
+	if opts := f.frontendOptions.Windows; opts == nil || !opts.IsZoomControlEnabled {
+		return errors.New("the zoom factor can only be changed when Windows.IsZoomControlEnabled is enabled")
+	}
+	factor = min(max(factor, minZoomFactor), maxZoomFactor)
+	results := make(chan error, 1)
+	f.mainWindow.Invoke(func() {
+		controller := f.chromium.GetController()
+		if err := controller.PutZoomFactor(factor); err != nil {
+			results <- err
+			return
+		}
+		f.originZoom.record(f.originZoom.current, controller)
+		results <- nil
+	})
+	return <-results
+}
+
+// WindowGetZoomFactor returns the zoom of the webview, which is 1.0 if it can't be read
+func (f *Frontend) WindowGetZoomFactor() float64 {
+	results := make(chan float64, 1)
+	f.mainWindow.Invoke(func() {
+		zoom, err := f.chromium.GetController().GetZoomFactor()
+		if err != nil {
+			zoom = 1.0
+		}
+		results <- zoom
+	})
+	return <-results
+}
+
+// WindowGetDPI returns the DPI of the window, 96 is a scale of 100%
+func (f *Frontend) WindowGetDPI() (uint, error) {
+	results := make(chan uint, 1)
+	f.mainWindow.Invoke(func() {
+		dpi, _ := f.mainWindow.GetWindowDPI()
+		results <- uint(dpi)
+	})
+	dpi := <-results
+	if dpi == 0 {
+		return 0, errors.New("the DPI of the window is not available")
+	}
+	return dpi, nil
+}
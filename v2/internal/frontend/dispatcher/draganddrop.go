@@ -4,6 +4,8 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
 )
 
 func (d *Dispatcher) processDragAndDropMessage(message string) (string, error) {
@@ -29,7 +31,7 @@ func (d *Dispatcher) processDragAndDropMessage(message string) (string, error) {
 			return "", errors.New("Invalid drag and drop Message: " + message)
 		}
 
-		d.events.Emit("wails:file-drop", x, y, paths)
+		d.events.Emit(frontend.FileDropEvent, x, y, paths)
 	default:
 		return "", errors.New("Invalid drag and drop Message: " + message)
 	}
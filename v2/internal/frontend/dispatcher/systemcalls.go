@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/wailsapp/wails/v2/pkg/options/windows"
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 
 	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/rpc"
 )
 
 const systemCallPrefix = ":wails:"
@@ -44,8 +47,318 @@ func (d *Dispatcher) processSystemCall(payload callMessage, sender frontend.Fron
 		return sender.WindowIsNormal(), nil
 	case "WindowIsFullscreen":
 		return sender.WindowIsFullscreen(), nil
+	case "WindowSetBorderlessFullscreen":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set borderless fullscreen")
+		}
+		var screen int
+		if err := json.Unmarshal(payload.Args[0], &screen); err != nil {
+			return false, err
+		}
+		if err := sender.WindowSetBorderlessFullscreen(screen); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "WindowSetBackdropType":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set backdrop type")
+		}
+		var backdrop windows.BackdropType
+		if err := json.Unmarshal(payload.Args[0], &backdrop); err != nil {
+			return false, err
+		}
+		if err := sender.WindowSetBackdropType(backdrop); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "WindowSetZoomFactor":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set zoom factor")
+		}
+		var factor float64
+		if err := json.Unmarshal(payload.Args[0], &factor); err != nil {
+			return false, err
+		}
+		if err := sender.WindowSetZoomFactor(factor); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "WindowSetTaskbarProgress":
+		if len(payload.Args) < 3 {
+			return false, errors.New("not enough arguments, cannot set taskbar progress")
+		}
+		var state frontend.ProgressState
+		if err := json.Unmarshal(payload.Args[0], &state); err != nil {
+			return false, err
+		}
+		var completed, total uint64
+		if err := json.Unmarshal(payload.Args[1], &completed); err != nil {
+			return false, err
+		}
+		if err := json.Unmarshal(payload.Args[2], &total); err != nil {
+			return false, err
+		}
+		sender.WindowSetTaskbarProgress(state, completed, total)
+		return true, nil
+	case "WindowGetState":
+		return sender.WindowGetState(), nil
+	case "WindowSetState":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set window state")
+		}
+		var state frontend.WindowState
+		if err := json.Unmarshal(payload.Args[0], &state); err != nil {
+			return false, err
+		}
+		sender.WindowSetState(state)
+		return true, nil
+	case "WindowGetZoomFactor":
+		return sender.WindowGetZoomFactor(), nil
+	case "WindowGetDPI":
+		return sender.WindowGetDPI()
+	case "WindowSetSizeToContent":
+		sender.WindowSetSizeToContent()
+		return true, nil
+	case "IMEIsComposing":
+		return sender.IMEIsComposing(), nil
+	case "IsRemoteSession":
+		return sender.IsRemoteSession(), nil
+	case "SystemPrefersReducedMotion":
+		return sender.SystemPrefersReducedMotion(), nil
+	case "SystemHighContrastActive":
+		return sender.SystemHighContrastActive(), nil
 	case "Environment":
 		return runtime.Environment(d.ctx), nil
+	case "LocaleInfo":
+		return sender.GetLocaleInfo()
+	case "MenuGetStructure":
+		return frontend.MenuStructure(sender.MenuGetApplicationMenu()), nil
+	case "MenuTrigger":
+		if len(payload.Args) < 1 {
+			return false, errors.New("empty argument, cannot trigger menu item")
+		}
+		var id string
+		if err := json.Unmarshal(payload.Args[0], &id); err != nil {
+			return false, err
+		}
+		appMenu := sender.MenuGetApplicationMenu()
+		if err := frontend.MenuTrigger(appMenu, id); err != nil {
+			return false, err
+		}
+		sender.MenuUpdateApplicationMenu()
+		frontend.MenuChanged(d.ctx, appMenu)
+		return true, nil
+	case "NetworkStatus":
+		return runtime.GetNetworkStatus(d.ctx)
+	case "WindowFocus":
+		sender.WindowFocus()
+		return nil, nil
+	case "WindowSetContentProtection":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set content protection")
+		}
+		var enabled bool
+		if err := json.Unmarshal(payload.Args[0], &enabled); err != nil {
+			return false, err
+		}
+		if err := sender.WindowSetContentProtection(enabled); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "WindowSetIgnoreMouseEvents":
+		if len(payload.Args) < 2 {
+			return false, errors.New("not enough arguments, cannot set ignore mouse events")
+		}
+		var ignore, forward bool
+		if err := json.Unmarshal(payload.Args[0], &ignore); err != nil {
+			return false, err
+		}
+		if err := json.Unmarshal(payload.Args[1], &forward); err != nil {
+			return false, err
+		}
+		sender.WindowSetIgnoreMouseEvents(ignore, forward)
+		return true, nil
+	case "WindowSetMinimiseButtonEnabled":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot enable minimise button")
+		}
+		var enabled bool
+		if err := json.Unmarshal(payload.Args[0], &enabled); err != nil {
+			return false, err
+		}
+		sender.WindowSetMinimiseButtonEnabled(enabled)
+		return true, nil
+	case "WindowSetMaximiseButtonEnabled":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot enable maximise button")
+		}
+		var enabled bool
+		if err := json.Unmarshal(payload.Args[0], &enabled); err != nil {
+			return false, err
+		}
+		sender.WindowSetMaximiseButtonEnabled(enabled)
+		return true, nil
+	case "WindowSetCloseButtonEnabled":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot enable close button")
+		}
+		var enabled bool
+		if err := json.Unmarshal(payload.Args[0], &enabled); err != nil {
+			return false, err
+		}
+		sender.WindowSetCloseButtonEnabled(enabled)
+		return true, nil
+	case "WindowSetAlwaysOnBottom":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set always on bottom")
+		}
+		var onBottom bool
+		if err := json.Unmarshal(payload.Args[0], &onBottom); err != nil {
+			return false, err
+		}
+		if err := sender.WindowSetAlwaysOnBottom(onBottom); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "WindowSetSkipTaskbar":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set skip taskbar")
+		}
+		var skip bool
+		if err := json.Unmarshal(payload.Args[0], &skip); err != nil {
+			return false, err
+		}
+		sender.WindowSetSkipTaskbar(skip)
+		return true, nil
+	case "WindowSetOpacity":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set opacity")
+		}
+		var opacity float64
+		if err := json.Unmarshal(payload.Args[0], &opacity); err != nil {
+			return false, err
+		}
+		return sender.WindowSetOpacity(opacity), nil
+	case "WindowSetAnimationsEnabled":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot enable animations")
+		}
+		var enabled bool
+		if err := json.Unmarshal(payload.Args[0], &enabled); err != nil {
+			return false, err
+		}
+		sender.WindowSetAnimationsEnabled(enabled)
+		return true, nil
+	case "WindowOpenDevTools":
+		if err := sender.WindowOpenDevTools(); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "WindowCloseDevTools":
+		if err := sender.WindowCloseDevTools(); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "WindowSetUserAgent":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set user agent")
+		}
+		var userAgent string
+		if err := json.Unmarshal(payload.Args[0], &userAgent); err != nil {
+			return false, err
+		}
+		sender.WindowSetUserAgent(userAgent)
+		return true, nil
+	case "WindowSetTheme":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot set theme")
+		}
+		var theme windows.Theme
+		if err := json.Unmarshal(payload.Args[0], &theme); err != nil {
+			return false, err
+		}
+		switch theme {
+		case windows.Dark:
+			sender.WindowSetDarkTheme()
+		case windows.Light:
+			sender.WindowSetLightTheme()
+		default:
+			sender.WindowSetSystemDefaultTheme()
+		}
+		return true, nil
+	case "WebviewClearCache":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot clear cache")
+		}
+		var kinds frontend.CacheKind
+		if err := json.Unmarshal(payload.Args[0], &kinds); err != nil {
+			return false, err
+		}
+		// The time range is optional, its bounds are in milliseconds since the epoch
+		var times [2]time.Time
+		for index, arg := range payload.Args[1:min(len(payload.Args), 3)] {
+			var milliseconds int64
+			if err := json.Unmarshal(arg, &milliseconds); err != nil {
+				return false, err
+			}
+			if milliseconds != 0 {
+				times[index] = time.UnixMilli(milliseconds)
+			}
+		}
+		if err := frontend.ValidateClearCache(kinds, times[0], times[1]); err != nil {
+			return false, err
+		}
+		if err := sender.WebviewClearCache(kinds, times[0], times[1]); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "WebviewPrintToPDFData":
+		// Printing to a path isn't exposed, the page could write to any file of the user
+		var settings frontend.PrintSettings
+		if len(payload.Args) > 0 {
+			if err := json.Unmarshal(payload.Args[0], &settings); err != nil {
+				return false, err
+			}
+		}
+		// The PDF is encoded in base64 in the JSON response
+		return sender.WebviewPrintToPDFData(settings)
+	case "WebviewCapturePreview":
+		format, err := imageFormatArg(payload.Args)
+		if err != nil {
+			return false, err
+		}
+		// The image is encoded in base64 in the JSON response
+		return sender.WebviewCapturePreview(format)
+	case "WindowCapture":
+		format, err := imageFormatArg(payload.Args)
+		if err != nil {
+			return false, err
+		}
+		return sender.WindowCapture(format)
+	case "WindowFlash":
+		if len(payload.Args) == 0 {
+			return false, errors.New("empty argument, cannot flash window")
+		}
+		var untilFocused bool
+		if err := json.Unmarshal(payload.Args[0], &untilFocused); err != nil {
+			return false, err
+		}
+		sender.WindowFlash(untilFocused)
+		return true, nil
+	case "WasLaunchedAtLogin":
+		return runtime.WasLaunchedAtLogin(d.ctx), nil
+	case "AppInfo":
+		return runtime.GetAppInfo(d.ctx), nil
+	case "Haptic":
+		if len(payload.Args) < 1 {
+			return false, errors.New("empty argument, cannot perform haptic")
+		}
+		var pattern string
+		if err := json.Unmarshal(payload.Args[0], &pattern); err != nil {
+			return false, err
+		}
+		sender.PerformHaptic(pattern)
+		return true, nil
 	case "ClipboardGetText":
 		t, err := sender.ClipboardGetText()
 		return t, err
@@ -61,7 +374,99 @@ func (d *Dispatcher) processSystemCall(payload callMessage, sender frontend.Fron
 			return false, err
 		}
 		return true, nil
+	case "RPC":
+		if len(payload.Args) < 1 {
+			return nil, errors.New("empty argument, cannot call rpc method")
+		}
+		var method string
+		if err := json.Unmarshal(payload.Args[0], &method); err != nil {
+			return nil, err
+		}
+		var params json.RawMessage
+		if len(payload.Args) > 1 {
+			params = payload.Args[1]
+		}
+		return rpc.Call(d.ctx, payload.CallbackID, method, params)
+	case "RPCCancel":
+		if len(payload.Args) < 1 {
+			return false, errors.New("empty argument, cannot cancel rpc call")
+		}
+		var id string
+		if err := json.Unmarshal(payload.Args[0], &id); err != nil {
+			return false, err
+		}
+		return rpc.Cancel(id), nil
+	case "ProgressCancel":
+		if len(payload.Args) < 1 {
+			return false, errors.New("empty argument, cannot cancel progress")
+		}
+		var id string
+		if err := json.Unmarshal(payload.Args[0], &id); err != nil {
+			return false, err
+		}
+		return runtime.CancelProgress(d.ctx, id), nil
+	case "WindowSetCursor":
+		if len(payload.Args) < 1 {
+			return false, errors.New("empty argument, cannot set cursor")
+		}
+		var cursor string
+		if err := json.Unmarshal(payload.Args[0], &cursor); err != nil {
+			return false, err
+		}
+		sender.WindowSetCursor(cursor)
+		return true, nil
+	case "FindInPage":
+		if len(payload.Args) < 1 {
+			return false, errors.New("empty argument, cannot find in page")
+		}
+		var text string
+		if err := json.Unmarshal(payload.Args[0], &text); err != nil {
+			return false, err
+		}
+		var options frontend.FindOptions
+		if len(payload.Args) > 1 {
+			if err := json.Unmarshal(payload.Args[1], &options); err != nil {
+				return false, err
+			}
+		}
+		sender.FindInPage(text, options)
+		return true, nil
+	case "ShowShareMenu":
+		if len(payload.Args) < 1 {
+			return false, errors.New("empty argument, cannot show share menu")
+		}
+		var items frontend.ShareItems
+		if err := json.Unmarshal(payload.Args[0], &items); err != nil {
+			return false, err
+		}
+		var anchor frontend.Rect
+		if len(payload.Args) > 1 {
+			if err := json.Unmarshal(payload.Args[1], &anchor); err != nil {
+				return false, err
+			}
+		}
+		if items.IsEmpty() {
+			return false, errors.New("there is nothing to share")
+		}
+		if err := sender.ShowShareMenu(items, anchor); err != nil {
+			return false, err
+		}
+		return true, nil
+	case "StopFind":
+		sender.StopFind()
+		return true, nil
 	default:
 		return nil, fmt.Errorf("unknown systemcall message: %s", payload.Name)
 	}
 }
+
+// imageFormatArg returns the image format of the first argument, which is PNG if it is missing
+func imageFormatArg(args []json.RawMessage) (frontend.ImageFormat, error) {
+	format := frontend.ImagePNG
+	if len(args) > 0 {
+		if err := json.Unmarshal(args[0], &format); err != nil {
+			return format, err
+		}
+	}
+	return format, nil
+}
@@ -18,6 +18,14 @@ func (d *Dispatcher) mustAtoI(input string) int {
 	return result
 }
 
+// logWindowError runs a window operation of a message, which has no result, and logs its error, EG if it isn't
+// supported by a Wayland compositor
+func (d *Dispatcher) logWindowError(operation func() error) {
+	if err := operation(); err != nil {
+		d.log.Error("%s", err.Error())
+	}
+}
+
 func (d *Dispatcher) processWindowMessage(message string, sender frontend.Frontend) (string, error) {
 	if len(message) < 2 {
 		return "", errors.New("Invalid Window Message: " + message)
@@ -33,14 +41,11 @@ func (d *Dispatcher) processWindowMessage(message string, sender frontend.Fronte
 		case "DT":
 			go sender.WindowSetDarkTheme()
 		case "TP:0", "TP:1":
-			if message[2:] == "TP:0" {
-				go sender.WindowSetAlwaysOnTop(false)
-			} else if message[2:] == "TP:1" {
-				go sender.WindowSetAlwaysOnTop(true)
-			}
+			onTop := message[2:] == "TP:1"
+			go d.logWindowError(func() error { return sender.WindowSetAlwaysOnTop(onTop) })
 		}
 	case 'c':
-		go sender.WindowCenter()
+		go d.logWindowError(sender.WindowCenter)
 	case 'T':
 		title := message[2:]
 		go sender.WindowSetTitle(title)
@@ -57,7 +62,7 @@ func (d *Dispatcher) processWindowMessage(message string, sender frontend.Fronte
 		parts := strings.Split(message[3:], ":")
 		x := d.mustAtoI(parts[0])
 		y := d.mustAtoI(parts[1])
-		go sender.WindowSetPosition(x, y)
+		go d.logWindowError(func() error { return sender.WindowSetPosition(x, y) })
 	case 'H':
 		go sender.WindowHide()
 	case 'S':
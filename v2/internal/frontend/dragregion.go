@@ -0,0 +1,20 @@
+package frontend
+
+// DragRegionDoubleClickScript toggles the maximised state of the window when a drag region is double-clicked, like
+// the title bar of the native windows. The drag regions are the elements with the CSSDragProperty set to the
+// CSSDragValue, an element inside a drag region is excluded if it has another value, EG "no-drag".
+const DragRegionDoubleClickScript = `(function() {
+	if (window._wailsDragRegionDoubleClickInstalled) {
+		return;
+	}
+	window._wailsDragRegionDoubleClickInstalled = true;
+	window.addEventListener("dblclick", function(event) {
+		if (event.button !== 0 || !(event.target instanceof Element)) {
+			return;
+		}
+		var value = window.getComputedStyle(event.target).getPropertyValue(window.wails.flags.cssDragProperty);
+		if (value && value.trim() === window.wails.flags.cssDragValue) {
+			window.WailsInvoke("Wt");
+		}
+	});
+})();`
@@ -0,0 +1,74 @@
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// FindOptions contains the options for FindInPage
+type FindOptions struct {
+	CaseSensitive bool `json:"caseSensitive"`
+	// Backwards selects the previous match instead of the next one
+	Backwards bool `json:"backwards"`
+}
+
+// FindResultEvent is emitted with a FindResult each time FindInPage has searched the page
+const FindResultEvent = "wails:find:result"
+
+// FindResult is the result of a FindInPage call
+type FindResult struct {
+	Text string `json:"text"`
+	// Matches is the number of matches in the page, 0 if the text was not found
+	Matches int `json:"matches"`
+}
+
+// FindInPageScript returns the script used to search the page on platforms without a native find API. The script
+// selects the next match, wrapping around at the end of the page, and evaluates to the FindResult as JSON. The result
+// is read from the webview rather than sent as a message, which the scripts of the page could forge.
+func FindInPageScript(text string, options FindOptions) string {
+	encoded, _ := json.Marshal(text)
+	return fmt.Sprintf(`(function(text, caseSensitive, backwards) {
+	var selection = window.getSelection();
+	if (window._wailsFindText !== text && selection.rangeCount > 0) {
+		selection.collapseToStart();
+	}
+	window._wailsFindText = text;
+	var matches = 0;
+	if (text !== "" && window.find(text, caseSensitive, backwards, true, false, true, false)) {
+		var content = document.body.innerText;
+		if (!caseSensitive) {
+			content = content.toLowerCase();
+			text = text.toLowerCase();
+		}
+		for (var index = content.indexOf(text); index !== -1; index = content.indexOf(text, index + text.length)) {
+			matches++;
+		}
+	}
+	return JSON.stringify({text: window._wailsFindText, matches: matches});
+})(%s, %t, %t);`, encoded, options.CaseSensitive, options.Backwards)
+}
+
+// StopFindScript clears the match selected by the FindInPageScript
+const StopFindScript = `window._wailsFindText = undefined; window.getSelection().removeAllRanges();`
+
+// FindWithScript searches the page by evaluating the FindInPageScript with eval and emits the FindResultEvent
+func FindWithScript(ctx context.Context, eval func(script string) (string, error), text string, options FindOptions) error {
+	value, err := eval(FindInPageScript(text, options))
+	if err != nil {
+		return err
+	}
+	var result FindResult
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		return err
+	}
+	FindCompleted(ctx, result)
+	return nil
+}
+
+// FindCompleted emits the FindResultEvent with the result of a search
+func FindCompleted(ctx context.Context, result FindResult) {
+	if events, ok := ctx.Value("events").(Events); ok {
+		events.Emit(FindResultEvent, result)
+	}
+}
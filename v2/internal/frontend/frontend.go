@@ -2,11 +2,19 @@ package frontend
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/wailsapp/wails/v2/pkg/menu"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
 	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
 
+// ErrNotSupportedByWayland is wrapped by the errors of the window operations which Wayland compositors don't allow
+// clients to perform
+var ErrNotSupportedByWayland = errors.New("not supported by Wayland compositors")
+
 // FileFilter defines a filter for dialog boxes
 type FileFilter struct {
 	DisplayName string // Filter information EG: "Image Files (*.jpg, *.png)"
@@ -65,6 +73,76 @@ type ScreenSize struct {
 	Height int `json:"height"`
 }
 
+// Rect describes a rectangular area of the window in logical pixels, relative to the top-left corner of the
+// client area
+type Rect struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// DragConstraints restrict the movement of the window while it is being dragged
+type DragConstraints struct {
+	// LockX keeps the horizontal position of the window, so it can only be moved vertically
+	LockX bool
+	// LockY keeps the vertical position of the window, so it can only be moved horizontally
+	LockY bool
+	// KeepInWorkArea prevents the window from being moved outside the work area of the current monitor
+	KeepInWorkArea bool
+	// SnapDistance is the distance in logical pixels at which the window snaps to the edges of the work area.
+	// 0 disables snapping.
+	SnapDistance int
+}
+
+// Haptic feedback patterns for PerformHaptic
+const (
+	HapticGeneric     = "generic"
+	HapticAlignment   = "alignment"
+	HapticLevelChange = "levelChange"
+)
+
+// FileDropEvent is emitted with the position and the paths of the files dropped on the window. The other events
+// emitted by Wails are named "wails:<subject>:<event>", EG "wails:network:change", this one predates the scheme.
+const FileDropEvent = "wails:file-drop"
+
+// FullscreenEnterEvent and FullscreenLeaveEvent are emitted when an element of the page enters or leaves fullscreen
+const (
+	FullscreenEnterEvent = "wails:fullscreen:enter"
+	FullscreenLeaveEvent = "wails:fullscreen:leave"
+)
+
+// FullscreenChanged emits the FullscreenEnterEvent or the FullscreenLeaveEvent. The changes are reported by the native
+// code of the webview, not by a message which the scripts of the page could send.
+func FullscreenChanged(ctx context.Context, fullscreen bool) {
+	if events, ok := ctx.Value("events").(Events); ok {
+		if fullscreen {
+			events.Emit(FullscreenEnterEvent)
+		} else {
+			events.Emit(FullscreenLeaveEvent)
+		}
+	}
+}
+
+// LocaleChangeEvent is emitted with the new LocaleInfo when the user changes the regional settings of the OS
+const LocaleChangeEvent = "wails:locale:change"
+
+// LocaleInfo contains the regional settings of the OS
+type LocaleInfo struct {
+	// Locale is the BCP 47 language tag of the locale, EG: "en-US"
+	Locale             string `json:"locale"`
+	DecimalSeparator   string `json:"decimalSeparator"`
+	ThousandsSeparator string `json:"thousandsSeparator"`
+	// The date and time formats use the native pattern syntax of the platform
+	ShortDateFormat string `json:"shortDateFormat"`
+	LongDateFormat  string `json:"longDateFormat"`
+	TimeFormat      string `json:"timeFormat"`
+	// FirstDayOfWeek is the first day of the week, 0 is Sunday
+	FirstDayOfWeek int    `json:"firstDayOfWeek"`
+	CurrencySymbol string `json:"currencySymbol"`
+	CurrencyCode   string `json:"currencyCode"`
+}
+
 // MessageDialogOptions contains the options for the Message dialogs, EG Info, Warning, etc runtime methods
 type MessageDialogOptions struct {
 	Type          DialogType
@@ -76,6 +154,50 @@ type MessageDialogOptions struct {
 	Icon          []byte
 }
 
+// DateTimePickerMode selects what can be picked with the DateTimePickerDialog
+type DateTimePickerMode string
+
+const (
+	DateTimePickerDateTime DateTimePickerMode = "datetime"
+	DateTimePickerDate     DateTimePickerMode = "date"
+	DateTimePickerTime     DateTimePickerMode = "time"
+)
+
+// DateTimePickerOptions contains the options for the DateTimePickerDialog runtime method
+type DateTimePickerOptions struct {
+	Title string
+	Mode  DateTimePickerMode
+	// Default is the initially selected date and time
+	Default time.Time
+	// Min and Max limit the selectable range, a zero time leaves that end of the range open
+	Min time.Time
+	Max time.Time
+}
+
+// InputDialogOptions contains the options for the InputDialog runtime method
+type InputDialogOptions struct {
+	Title   string
+	Message string
+	// Default is the initial value of the input
+	Default string
+	// Placeholder is shown while the input is empty
+	Placeholder string
+	// Password masks the input
+	Password bool
+}
+
+// SelectDialogOptions contains the options for the SelectDialog runtime method
+type SelectDialogOptions struct {
+	Title   string
+	Message string
+	// Items are the items the user selects from
+	Items []string
+	// Default are the items which are initially selected
+	Default []string
+	// Multiple allows selecting more than one item
+	Multiple bool
+}
+
 type Frontend interface {
 	Run(ctx context.Context) error
 	RunMainLoop()
@@ -90,26 +212,43 @@ type Frontend interface {
 	OpenDirectoryDialog(dialogOptions OpenDialogOptions) (string, error)
 	SaveFileDialog(dialogOptions SaveDialogOptions) (string, error)
 	MessageDialog(dialogOptions MessageDialogOptions) (string, error)
+	DateTimePickerDialog(dialogOptions DateTimePickerOptions) (time.Time, bool)
+	InputDialog(dialogOptions InputDialogOptions) (string, bool)
+	SelectDialog(dialogOptions SelectDialogOptions) ([]string, bool)
 
 	// Window
 	WindowSetTitle(title string)
 	WindowShow()
 	WindowHide()
-	WindowCenter()
+	WindowFocus()
+	WindowFlash(untilFocused bool)
+	WindowCenter() error
 	WindowToggleMaximise()
 	WindowMaximise()
 	WindowUnmaximise()
 	WindowMinimise()
 	WindowUnminimise()
-	WindowSetAlwaysOnTop(b bool)
-	WindowSetPosition(x int, y int)
+	WindowSetAlwaysOnTop(b bool) error
+	WindowSetAlwaysOnBottom(b bool) error
+	WindowSetSkipTaskbar(skip bool)
+	WindowSetOpacity(opacity float64) float64
+	WindowSetAnimationsEnabled(enabled bool)
+	WindowOpenDevTools() error
+	WindowCloseDevTools() error
+	WindowSetUserAgent(userAgent string)
+	WindowSetLevel(level options.WindowLevel) error
+	WindowSetPosition(x int, y int) error
 	WindowGetPosition() (int, int)
 	WindowSetSize(width int, height int)
+	WindowSetSizeToContent()
 	WindowGetSize() (int, int)
+	WindowGetState() WindowState
+	WindowSetState(state WindowState)
 	WindowSetMinSize(width int, height int)
 	WindowSetMaxSize(width int, height int)
 	WindowFullscreen()
 	WindowUnfullscreen()
+	WindowSetBorderlessFullscreen(screen int) error
 	WindowSetBackgroundColour(col *options.RGBA)
 	WindowReload()
 	WindowReloadApp()
@@ -122,13 +261,79 @@ type Frontend interface {
 	WindowIsFullscreen() bool
 	WindowClose()
 	WindowPrint()
+	WindowSetCursor(cursor string)
+	WindowSetCursorImage(image []byte, hotspotX int, hotspotY int)
+	WindowSetBlurRegion(rects []Rect)
+	WindowSetBackdropType(backdrop windows.BackdropType) error
+	WindowSetCustomTheme(theme *windows.ThemeSettings)
+	WindowSetContentProtection(enabled bool) error
+	WindowSetIgnoreMouseEvents(ignore bool, forward bool)
+	WindowSetMinimiseButtonEnabled(enabled bool)
+	WindowSetMaximiseButtonEnabled(enabled bool)
+	WindowSetCloseButtonEnabled(enabled bool)
+	WindowSetZoomForOrigin(origin string, zoom float64)
+	WindowSetZoomFactor(factor float64) error
+	WindowSetTaskbarProgress(state ProgressState, completed, total uint64)
+	WindowSetThumbbarButtons(buttons []ThumbbarButton) error
+	WindowSetOverlayIcon(image []byte, description string) error
+	WindowClearOverlayIcon()
+	WindowGetZoomFactor() float64
+	WindowGetDPI() (uint, error)
+	WindowStartConstrainedDrag(constraints DragConstraints)
 
 	// Screen
 	ScreenGetAll() ([]Screen, error)
+	GetDisplayServer() string
+
+	// Locale
+	GetLocaleInfo() (LocaleInfo, error)
+
+	// Session
+	IsRemoteSession() bool
+
+	// Accessibility
+	SystemPrefersReducedMotion() bool
+	SystemHighContrastActive() bool
+
+	// Haptics
+	PerformHaptic(pattern string)
+
+	// Touch Bar
+	TouchBarSet(items []TouchBarItem)
+
+	// Sharing
+	ShowShareMenu(items ShareItems, anchor Rect) error
+
+	// Find in page
+	FindInPage(text string, options FindOptions)
+	StopFind()
+
+	// Browsing data
+	WebviewClearCache(kinds CacheKind, start, end time.Time) error
+
+	// Preload scripts
+	WebviewAddPreloadScript(script string) (string, error)
+	WebviewRemovePreloadScript(id string) error
+
+	// Printing
+	WebviewPrintToPDF(path string, settings PrintSettings) error
+	WebviewPrintToPDFData(settings PrintSettings) ([]byte, error)
+
+	// Capture
+	WebviewCapturePreview(format ImageFormat) ([]byte, error)
+	WindowCapture(format ImageFormat) ([]byte, error)
+
+	// Input methods
+	IMEIsComposing() bool
+
+	// Hotkeys
+	RegisterGlobalHotkey(accelerator *keys.Accelerator, handler func()) (int, error)
+	UnregisterGlobalHotkey(id int)
 
 	// Menus
 	MenuSetApplicationMenu(menu *menu.Menu)
 	MenuUpdateApplicationMenu()
+	MenuGetApplicationMenu() *menu.Menu
 
 	// Events
 	Notify(name string, data ...interface{})
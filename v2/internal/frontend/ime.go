@@ -0,0 +1,108 @@
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+)
+
+// IMECompositionEvent is emitted with an IMEComposition when an input method composition starts, changes or ends
+const IMECompositionEvent = "wails:ime:composition"
+
+// IMECompositionPhase is the phase of an input method composition
+type IMECompositionPhase string
+
+const (
+	IMECompositionStart  IMECompositionPhase = "start"
+	IMECompositionUpdate IMECompositionPhase = "update"
+	IMECompositionEnd    IMECompositionPhase = "end"
+)
+
+// IMEComposition describes a change of the input method composition
+type IMEComposition struct {
+	Phase IMECompositionPhase `json:"phase"`
+	// Text is the text being composed, or the committed text when the composition ends. It is empty when the
+	// composition has been reported by the operating system, which doesn't provide the text of the webview.
+	Text string `json:"text"`
+}
+
+// IMECompositionMessage is the prefix of the message sent by the IMECompositionScript
+const IMECompositionMessage = "wails:ime:"
+
+// IMECompositionScript forwards the composition events of the page with the IMECompositionMessage followed by the
+// IMEComposition as JSON. The listeners are registered in the capture phase, so the page can't stop them.
+const IMECompositionScript = `(function() {
+	if (window._wailsIMECompositionInstalled) {
+		return;
+	}
+	window._wailsIMECompositionInstalled = true;
+	var phases = {compositionstart: "start", compositionupdate: "update", compositionend: "end"};
+	Object.keys(phases).forEach(function(name) {
+		window.addEventListener(name, function(event) {
+			window.WailsInvoke("` + IMECompositionMessage + `" + JSON.stringify({phase: phases[name], text: event.data || ""}));
+		}, true);
+	});
+})();`
+
+// ParseIMECompositionMessage returns the IMEComposition of a message sent by the IMECompositionScript
+func ParseIMECompositionMessage(message string) (IMEComposition, error) {
+	var result IMEComposition
+	err := json.Unmarshal([]byte(strings.TrimPrefix(message, IMECompositionMessage)), &result)
+	return result, err
+}
+
+// IMECompositionState tracks whether a composition is in progress. A composition may be reported by both the page and
+// the operating system, a start is only emitted when no composition is in progress and an end only when one is.
+type IMECompositionState struct {
+	mu        sync.Mutex
+	composing bool
+}
+
+// IsComposing returns true while a composition is in progress
+func (s *IMECompositionState) IsComposing() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.composing
+}
+
+// Update applies the composition and emits the IMECompositionEvent if the state has changed or the text has been updated
+func (s *IMECompositionState) Update(ctx context.Context, composition IMEComposition) {
+	changes := s.apply(composition)
+	if events, ok := ctx.Value("events").(Events); ok {
+		for _, change := range changes {
+			events.Emit(IMECompositionEvent, change)
+		}
+	}
+}
+
+// apply updates the state and returns the compositions to emit
+func (s *IMECompositionState) apply(composition IMEComposition) []IMEComposition {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch composition.Phase {
+	case IMECompositionStart:
+		if s.composing {
+			return nil
+		}
+		s.composing = true
+	case IMECompositionUpdate:
+		if !s.composing {
+			// The start has been missed, which happens when the page has been loaded during a composition
+			s.composing = true
+			return []IMEComposition{{Phase: IMECompositionStart}, composition}
+		}
+		if composition.Text == "" {
+			// The operating system reports updates without the text, the page reports them with the text
+			return nil
+		}
+	case IMECompositionEnd:
+		if !s.composing {
+			return nil
+		}
+		s.composing = false
+	default:
+		return nil
+	}
+	return []IMEComposition{composition}
+}
@@ -0,0 +1,40 @@
+package frontend
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIMECompositionState_apply(t *testing.T) {
+	start := IMEComposition{Phase: IMECompositionStart}
+	update := IMEComposition{Phase: IMECompositionUpdate, Text: "に"}
+	nativeUpdate := IMEComposition{Phase: IMECompositionUpdate}
+	end := IMEComposition{Phase: IMECompositionEnd, Text: "日本"}
+	tests := []struct {
+		name          string
+		composing     bool
+		composition   IMEComposition
+		want          []IMEComposition
+		wantComposing bool
+	}{
+		{name: "start", composition: start, want: []IMEComposition{start}, wantComposing: true},
+		{name: "start while composing", composing: true, composition: start, wantComposing: true},
+		{name: "update", composing: true, composition: update, want: []IMEComposition{update}, wantComposing: true},
+		{name: "update without the text", composing: true, composition: nativeUpdate, wantComposing: true},
+		{name: "update without a start", composition: update, want: []IMEComposition{start, update}, wantComposing: true},
+		{name: "end", composing: true, composition: end, want: []IMEComposition{end}},
+		{name: "end without a composition", composition: end},
+		{name: "unknown phase", composing: true, composition: IMEComposition{Phase: "cancel"}, wantComposing: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &IMECompositionState{composing: tt.composing}
+			if got := s.apply(tt.composition); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("apply() = %+v, want %+v", got, tt.want)
+			}
+			if got := s.IsComposing(); got != tt.wantComposing {
+				t.Errorf("IsComposing() = %v, want %v", got, tt.wantComposing)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// LaunchEvent is emitted with the LaunchData when the application is asked to open URLs or files while it runs, EG
+// by a deep link, a file association or a second instance
+const LaunchEvent = "wails:app:launch"
+
+// LaunchData contains the URLs and the files the application has been asked to open
+type LaunchData struct {
+	URLs  []string `json:"urls"`
+	Files []string `json:"files"`
+}
+
+// IsEmpty returns true if there are no URLs and no files
+func (d LaunchData) IsEmpty() bool {
+	return len(d.URLs) == 0 && len(d.Files) == 0
+}
+
+// ParseLaunchArgs returns the URLs and the existing files of the command line arguments. The flags are ignored, the
+// relative paths are relative to the working directory, which is the current directory if it is empty.
+func ParseLaunchArgs(args []string, workingDirectory string) LaunchData {
+	result := LaunchData{URLs: []string{}, Files: []string{}}
+	for _, arg := range args {
+		if arg == "" || strings.HasPrefix(arg, "-") {
+			continue
+		}
+		// A scheme of a single letter is the drive of a Windows path
+		if parsed, err := url.Parse(arg); err == nil && len(parsed.Scheme) > 1 {
+			result.URLs = append(result.URLs, arg)
+			continue
+		}
+		path := arg
+		if !filepath.IsAbs(path) && workingDirectory != "" {
+			path = filepath.Join(workingDirectory, path)
+		}
+		if absolute, err := filepath.Abs(path); err == nil {
+			path = absolute
+		}
+		if _, err := os.Stat(path); err == nil {
+			result.Files = append(result.Files, path)
+		}
+	}
+	return result
+}
+
+// LaunchBuffer buffers the URLs and the files the application has been asked to open, so they are available to the
+// frontend when it boots even if they arrived before the page was loaded. The buffer is cleared once the data has been
+// delivered to a page, so reloading the page doesn't open them again.
+type LaunchBuffer struct {
+	lock sync.Mutex
+	data LaunchData
+}
+
+// NewLaunchBuffer returns a buffer with the data the application was launched with
+func NewLaunchBuffer(data LaunchData) *LaunchBuffer {
+	result := &LaunchBuffer{data: LaunchData{URLs: []string{}, Files: []string{}}}
+	result.Add(data)
+	return result
+}
+
+// Add appends the URLs and the files to the buffer, it returns false if there are none
+func (b *LaunchBuffer) Add(data LaunchData) bool {
+	if data.IsEmpty() {
+		return false
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.data.URLs = append(b.data.URLs, data.URLs...)
+	b.data.Files = append(b.data.Files, data.Files...)
+	return true
+}
+
+// Script returns the script setting window.__wails_launch__ to the buffered LaunchData and clears the buffer. It is
+// prepended to the runtime so the global is set before the scripts of the frontend run.
+func (b *LaunchBuffer) Script() string {
+	b.lock.Lock()
+	data := b.data
+	b.data = LaunchData{URLs: []string{}, Files: []string{}}
+	b.lock.Unlock()
+	return launchScript(data)
+}
+
+// Launched sets window.__wails_launch__ to the data with execJS and emits the LaunchEvent. The data is buffered until
+// the page is loaded if execJS is nil.
+func (b *LaunchBuffer) Launched(ctx context.Context, data LaunchData, execJS func(string)) {
+	if data.IsEmpty() {
+		return
+	}
+	if execJS == nil {
+		b.Add(data)
+	} else {
+		execJS(launchScript(data))
+	}
+	if events, ok := ctx.Value("events").(Events); ok {
+		events.Emit(LaunchEvent, data)
+	}
+}
+
+func launchScript(data LaunchData) string {
+	if data.URLs == nil {
+		data.URLs = []string{}
+	}
+	if data.Files == nil {
+		data.Files = []string{}
+	}
+	encoded, _ := json.Marshal(data)
+	return "window.__wails_launch__ = Object.freeze(" + string(encoded) + ");\n"
+}
@@ -0,0 +1,158 @@
+package frontend
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseLaunchArgs(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "document.txt")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	workingDirectory, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name             string
+		args             []string
+		workingDirectory string
+		want             LaunchData
+	}{
+		{
+			name: "no arguments",
+			want: LaunchData{URLs: []string{}, Files: []string{}},
+		},
+		{
+			name: "urls",
+			args: []string{"myapp://open?id=1", "https://wails.io"},
+			want: LaunchData{URLs: []string{"myapp://open?id=1", "https://wails.io"}, Files: []string{}},
+		},
+		{
+			name: "flags and empty arguments are ignored",
+			args: []string{"-debug", "--flag=myapp://open", ""},
+			want: LaunchData{URLs: []string{}, Files: []string{}},
+		},
+		{
+			name: "absolute file",
+			args: []string{file},
+			want: LaunchData{URLs: []string{}, Files: []string{file}},
+		},
+		{
+			name:             "file relative to the working directory",
+			args:             []string{"document.txt"},
+			workingDirectory: dir,
+			want:             LaunchData{URLs: []string{}, Files: []string{file}},
+		},
+		{
+			name: "file relative to the current directory",
+			args: []string{"launch_test.go"},
+			want: LaunchData{URLs: []string{}, Files: []string{filepath.Join(workingDirectory, "launch_test.go")}},
+		},
+		{
+			name:             "missing file",
+			args:             []string{"missing.txt"},
+			workingDirectory: dir,
+			want:             LaunchData{URLs: []string{}, Files: []string{}},
+		},
+		{
+			name:             "urls and files",
+			args:             []string{"document.txt", "myapp://open"},
+			workingDirectory: dir,
+			want:             LaunchData{URLs: []string{"myapp://open"}, Files: []string{file}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseLaunchArgs(tt.args, tt.workingDirectory); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseLaunchArgs() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+type testEvents struct {
+	Events
+	emitted []interface{}
+}
+
+func (e *testEvents) Emit(eventName string, data ...interface{}) {
+	e.emitted = append(e.emitted, data...)
+}
+
+func TestLaunchBuffer(t *testing.T) {
+	initial := `window.__wails_launch__ = Object.freeze({"urls":["myapp://start"],"files":[]});` + "\n"
+	empty := `window.__wails_launch__ = Object.freeze({"urls":[],"files":[]});` + "\n"
+
+	buffer := NewLaunchBuffer(LaunchData{URLs: []string{"myapp://start"}, Files: []string{}})
+	if got := buffer.Script(); got != initial {
+		t.Errorf("Script() = %q, want %q", got, initial)
+	}
+	if got := buffer.Script(); got != empty {
+		t.Errorf("Script() after the delivery = %q, want %q", got, empty)
+	}
+}
+
+func TestLaunchBufferLaunched(t *testing.T) {
+	events := &testEvents{}
+	ctx := context.WithValue(context.Background(), "events", events)
+
+	tests := []struct {
+		name        string
+		data        LaunchData
+		running     bool
+		wantExecJS  []string
+		wantEmitted []interface{}
+		wantScript  string
+	}{
+		{
+			name:       "empty data",
+			data:       LaunchData{},
+			running:    true,
+			wantScript: `window.__wails_launch__ = Object.freeze({"urls":[],"files":[]});` + "\n",
+		},
+		{
+			name:        "running",
+			data:        LaunchData{URLs: []string{"myapp://open"}},
+			running:     true,
+			wantExecJS:  []string{`window.__wails_launch__ = Object.freeze({"urls":["myapp://open"],"files":[]});` + "\n"},
+			wantEmitted: []interface{}{LaunchData{URLs: []string{"myapp://open"}}},
+			wantScript:  `window.__wails_launch__ = Object.freeze({"urls":[],"files":[]});` + "\n",
+		},
+		{
+			name:        "buffered until the page is loaded",
+			data:        LaunchData{Files: []string{"/document.txt"}},
+			wantEmitted: []interface{}{LaunchData{Files: []string{"/document.txt"}}},
+			wantScript:  `window.__wails_launch__ = Object.freeze({"urls":[],"files":["/document.txt"]});` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events.emitted = nil
+			buffer := NewLaunchBuffer(LaunchData{})
+			var executed []string
+			var execJS func(string)
+			if tt.running {
+				execJS = func(js string) {
+					executed = append(executed, js)
+				}
+			}
+			buffer.Launched(ctx, tt.data, execJS)
+			if !reflect.DeepEqual(executed, tt.wantExecJS) {
+				t.Errorf("executed = %q, want %q", executed, tt.wantExecJS)
+			}
+			if !reflect.DeepEqual(events.emitted, tt.wantEmitted) {
+				t.Errorf("emitted = %+v, want %+v", events.emitted, tt.wantEmitted)
+			}
+			if got := buffer.Script(); got != tt.wantScript {
+				t.Errorf("Script() = %q, want %q", got, tt.wantScript)
+			}
+		})
+	}
+}
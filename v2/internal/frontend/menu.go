@@ -0,0 +1,141 @@
+package frontend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	goruntime "runtime"
+	"strconv"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/menu"
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+// MenuChangeEvent is emitted with the []MenuNode of the application menu when the menu has been set or updated, or
+// when a checkbox or radio item has been toggled
+const MenuChangeEvent = "wails:menu:change"
+
+// MenuNode is a node of the application menu tree, used to render the menu in the frontend
+type MenuNode struct {
+	// ID identifies the item for MenuTrigger. It is the path of indexes to the item, EG "0.2", and stays the same
+	// when items are hidden.
+	ID    string    `json:"id"`
+	Label string    `json:"label"`
+	Type  menu.Type `json:"type"`
+	// Role is the name of the predefined menu of the item, EG "editMenu"
+	Role string `json:"role,omitempty"`
+	// Accelerator is the key combination of the item as shown in a native menu of the platform, EG "Ctrl+Shift+S"
+	Accelerator string     `json:"accelerator,omitempty"`
+	Disabled    bool       `json:"disabled"`
+	Checked     bool       `json:"checked"`
+	Children    []MenuNode `json:"children,omitempty"`
+}
+
+var menuRoleNames = map[menu.Role]string{
+	menu.AppMenuRole:    "appMenu",
+	menu.EditMenuRole:   "editMenu",
+	menu.WindowMenuRole: "windowMenu",
+}
+
+// MenuStructure returns the tree of the visible items of the menu
+func MenuStructure(appMenu *menu.Menu) []MenuNode {
+	return menuStructure(appMenu, "")
+}
+
+func menuStructure(appMenu *menu.Menu, parentID string) []MenuNode {
+	if appMenu == nil {
+		return []MenuNode{}
+	}
+	result := make([]MenuNode, 0, len(appMenu.Items))
+	for index, item := range appMenu.Items {
+		if item.Hidden {
+			continue
+		}
+		node := MenuNode{
+			ID:       parentID + strconv.Itoa(index),
+			Label:    item.Label,
+			Type:     item.Type,
+			Role:     menuRoleNames[item.Role],
+			Disabled: item.Disabled,
+			Checked:  item.Checked,
+		}
+		if item.Accelerator != nil {
+			node.Accelerator = keys.Stringify(item.Accelerator, goruntime.GOOS)
+		}
+		if item.SubMenu != nil {
+			node.Type = menu.SubmenuType
+			node.Children = menuStructure(item.SubMenu, node.ID+".")
+		}
+		result = append(result, node)
+	}
+	return result
+}
+
+// MenuChanged emits the MenuChangeEvent. The structure is read before returning and emitted on a new goroutine, so
+// this may be called on the main thread.
+func MenuChanged(ctx context.Context, appMenu *menu.Menu) {
+	events, ok := ctx.Value("events").(Events)
+	if !ok {
+		return
+	}
+	structure := MenuStructure(appMenu)
+	go events.Emit(MenuChangeEvent, structure)
+}
+
+// MenuTrigger invokes the item with the ID of a MenuNode as if it had been clicked. Checkbox items are toggled and
+// radio items are checked, unchecking the other items of their group. The callback of the item runs on a new
+// goroutine, like for the native menus. The native menu has to be updated afterwards.
+func MenuTrigger(appMenu *menu.Menu, id string) error {
+	parent, index, err := findMenuItem(appMenu, id)
+	if err != nil {
+		return err
+	}
+	item := parent.Items[index]
+	switch {
+	case item.Hidden:
+		return fmt.Errorf("menu item %s is hidden", id)
+	case item.Disabled:
+		return fmt.Errorf("menu item %s is disabled", id)
+	case item.Type == menu.SeparatorType || item.SubMenu != nil:
+		return fmt.Errorf("menu item %s can't be triggered", id)
+	}
+
+	switch item.Type {
+	case menu.CheckboxType:
+		item.Checked = !item.Checked
+	case menu.RadioType:
+		// A radio group is a run of adjacent radio items
+		for i := index - 1; i >= 0 && parent.Items[i].Type == menu.RadioType; i-- {
+			parent.Items[i].Checked = false
+		}
+		for i := index + 1; i < len(parent.Items) && parent.Items[i].Type == menu.RadioType; i++ {
+			parent.Items[i].Checked = false
+		}
+		item.Checked = true
+	}
+	if item.Click != nil {
+		go item.Click(&menu.CallbackData{MenuItem: item})
+	}
+	return nil
+}
+
+// findMenuItem returns the menu containing the item with the ID and the index of the item in the menu
+func findMenuItem(appMenu *menu.Menu, id string) (*menu.Menu, int, error) {
+	if appMenu == nil {
+		return nil, 0, errors.New("no application menu has been set")
+	}
+	parts := strings.Split(id, ".")
+	current := appMenu
+	for i, part := range parts {
+		index, err := strconv.Atoi(part)
+		if err != nil || current == nil || index < 0 || index >= len(current.Items) {
+			return nil, 0, fmt.Errorf("unknown menu item: %s", id)
+		}
+		if i == len(parts)-1 {
+			return current, index, nil
+		}
+		current = current.Items[index].SubMenu
+	}
+	return nil, 0, fmt.Errorf("unknown menu item: %s", id)
+}
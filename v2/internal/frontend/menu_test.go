@@ -0,0 +1,77 @@
+package frontend
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/pkg/menu"
+)
+
+func testMenu() *menu.Menu {
+	return menu.NewMenuFromItems(
+		menu.SubMenu("File", menu.NewMenuFromItems(
+			menu.Text("Open", nil, nil),
+			menu.Separator(),
+			&menu.MenuItem{Label: "Hidden", Type: menu.TextType, Hidden: true},
+			menu.Text("Quit", nil, nil),
+		)),
+		&menu.MenuItem{Label: "Disabled", Type: menu.TextType, Disabled: true},
+	)
+}
+
+func Test_findMenuItem(t *testing.T) {
+	appMenu := testMenu()
+	tests := []struct {
+		name      string
+		appMenu   *menu.Menu
+		id        string
+		wantLabel string
+		wantErr   bool
+	}{
+		{name: "top level item", appMenu: appMenu, id: "1", wantLabel: "Disabled"},
+		{name: "submenu item", appMenu: appMenu, id: "0.3", wantLabel: "Quit"},
+		{name: "hidden items keep their index", appMenu: appMenu, id: "0.2", wantLabel: "Hidden"},
+		{name: "no menu", appMenu: nil, id: "0", wantErr: true},
+		{name: "empty id", appMenu: appMenu, id: "", wantErr: true},
+		{name: "not a number", appMenu: appMenu, id: "0.a", wantErr: true},
+		{name: "negative index", appMenu: appMenu, id: "-1", wantErr: true},
+		{name: "index out of range", appMenu: appMenu, id: "0.4", wantErr: true},
+		{name: "item without submenu", appMenu: appMenu, id: "1.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parent, index, err := findMenuItem(tt.appMenu, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("findMenuItem() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := parent.Items[index].Label; got != tt.wantLabel {
+				t.Errorf("findMenuItem() item = %v, want %v", got, tt.wantLabel)
+			}
+		})
+	}
+}
+
+func TestMenuStructure(t *testing.T) {
+	want := []MenuNode{
+		{
+			ID:    "0",
+			Label: "File",
+			Type:  menu.SubmenuType,
+			Children: []MenuNode{
+				{ID: "0.0", Label: "Open", Type: menu.TextType},
+				{ID: "0.1", Type: menu.SeparatorType},
+				{ID: "0.3", Label: "Quit", Type: menu.TextType},
+			},
+		},
+		{ID: "1", Label: "Disabled", Type: menu.TextType, Disabled: true},
+	}
+	if got := MenuStructure(testMenu()); !reflect.DeepEqual(got, want) {
+		t.Errorf("MenuStructure() = %+v, want %+v", got, want)
+	}
+	if got := MenuStructure(nil); len(got) != 0 {
+		t.Errorf("MenuStructure(nil) = %+v, want an empty slice", got)
+	}
+}
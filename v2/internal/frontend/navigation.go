@@ -0,0 +1,15 @@
+package frontend
+
+import "context"
+
+// NavigationProgressEvent is emitted with the estimated progress of the page load, between 0 and 1. The progress is
+// 1 once the page has loaded.
+const NavigationProgressEvent = "wails:navigation:progress"
+
+// NavigationProgressChanged emits the NavigationProgressEvent with the progress clamped between 0 and 1. The progress
+// is reported by the native code of the webview, not by a message which the scripts of the page could send.
+func NavigationProgressChanged(ctx context.Context, progress float64) {
+	if events, ok := ctx.Value("events").(Events); ok {
+		events.Emit(NavigationProgressEvent, min(max(progress, 0), 1))
+	}
+}
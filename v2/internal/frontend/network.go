@@ -0,0 +1,19 @@
+package frontend
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/system/network"
+)
+
+// NetworkChangeEvent is emitted with the new network.Status when the network status reported by the OS changes
+const NetworkChangeEvent = "wails:network:change"
+
+// WatchNetworkStatus emits the NetworkChangeEvent for each change of the network status until the context is done
+func WatchNetworkStatus(ctx context.Context) error {
+	return network.Watch(ctx, func(status network.Status) {
+		if events, ok := ctx.Value("events").(Events); ok {
+			events.Emit(NetworkChangeEvent, status)
+		}
+	})
+}
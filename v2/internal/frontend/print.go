@@ -0,0 +1,56 @@
+package frontend
+
+import "errors"
+
+// PrintOrientation is the orientation of the pages printed by WebviewPrintToPDF
+type PrintOrientation string
+
+const (
+	PrintPortrait  PrintOrientation = "portrait"
+	PrintLandscape PrintOrientation = "landscape"
+)
+
+// PrintMargins are the margins of the pages in inches
+type PrintMargins struct {
+	Top    float64 `json:"top"`
+	Bottom float64 `json:"bottom"`
+	Left   float64 `json:"left"`
+	Right  float64 `json:"right"`
+}
+
+// PrintSettings are the settings of WebviewPrintToPDF, the zero values are the defaults of the webview
+type PrintSettings struct {
+	// Orientation is portrait if it is empty
+	Orientation PrintOrientation `json:"orientation"`
+	// ScaleFactor is the scale of the content between 0.1 and 2, 0 is 1
+	ScaleFactor float64 `json:"scaleFactor"`
+	// PageWidth and PageHeight are the size of the pages in inches, 0 is the size of a letter
+	PageWidth  float64 `json:"pageWidth"`
+	PageHeight float64 `json:"pageHeight"`
+	// Margins are the default margins of the webview if they are nil
+	Margins *PrintMargins `json:"margins"`
+	// PrintBackgrounds prints the background colours and images of the page
+	PrintBackgrounds bool `json:"printBackgrounds"`
+	// HeaderAndFooter prints the title and the date in the header, and the URL and the page number in the footer
+	HeaderAndFooter bool `json:"headerAndFooter"`
+	// HeaderTitle replaces the title of the document in the header, FooterURI replaces the URL in the footer
+	HeaderTitle string `json:"headerTitle"`
+	FooterURI   string `json:"footerURI"`
+}
+
+// Validate returns an error if a setting is out of range
+func (s PrintSettings) Validate() error {
+	if s.Orientation != "" && s.Orientation != PrintPortrait && s.Orientation != PrintLandscape {
+		return errors.New("the orientation must be portrait or landscape")
+	}
+	if s.ScaleFactor != 0 && (s.ScaleFactor < 0.1 || s.ScaleFactor > 2) {
+		return errors.New("the scale factor must be between 0.1 and 2")
+	}
+	if s.PageWidth < 0 || s.PageHeight < 0 {
+		return errors.New("the size of the pages must not be negative")
+	}
+	if m := s.Margins; m != nil && (m.Top < 0 || m.Bottom < 0 || m.Left < 0 || m.Right < 0) {
+		return errors.New("the margins must not be negative")
+	}
+	return nil
+}
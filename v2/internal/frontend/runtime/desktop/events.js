@@ -212,3 +212,26 @@ export function EventsOff(eventName, ...additionalEventNames) {
         removeListener(eventName);
     }
 }
+
+/**
+ * Events are the names of the events emitted by Wails. They are named "wails:<subject>:<event>", except FileDrop
+ * which predates the scheme.
+ */
+export const Events = Object.freeze({
+    FileDrop: "wails:file-drop",
+    FullscreenEnter: "wails:fullscreen:enter",
+    FullscreenLeave: "wails:fullscreen:leave",
+    LocaleChange: "wails:locale:change",
+    AccessibilityChange: "wails:accessibility:change",
+    NetworkChange: "wails:network:change",
+    SessionChange: "wails:session:change",
+    MenuChange: "wails:menu:change",
+    FindResult: "wails:find:result",
+    IMEComposition: "wails:ime:composition",
+    NavigationProgress: "wails:navigation:progress",
+    Launch: "wails:app:launch",
+    ServiceInvoke: "wails:service:invoke",
+    Progress: "wails:task:progress",
+    ProcessOutput: "wails:process:output",
+    ProcessExit: "wails:process:exit",
+});
@@ -9,7 +9,7 @@ The electron alternative for Go
 */
 /* jshint esversion: 9 */
 import * as Log from './log';
-import {eventListeners, EventsEmit, EventsNotify, EventsOff, EventsOn, EventsOnce, EventsOnMultiple} from './events';
+import {eventListeners, Events, EventsEmit, EventsNotify, EventsOff, EventsOn, EventsOnce, EventsOnMultiple} from './events';
 import {Call, Callback, callbacks} from './calls';
 import {SetBindings} from "./bindings";
 import * as Window from "./window";
@@ -18,6 +18,8 @@ import * as Browser from "./browser";
 import * as Clipboard from "./clipboard";
 import * as DragAndDrop from "./draganddrop";
 import * as ContextMenu from "./contextmenu";
+import * as System from "./system";
+import * as RPC from "./rpc";
 
 export function Quit() {
     window.WailsInvoke('Q');
@@ -43,6 +45,9 @@ window.runtime = {
     ...Screen,
     ...Clipboard,
     ...DragAndDrop,
+    ...System,
+    ...RPC,
+    Events,
     EventsOn,
     EventsOnce,
     EventsOnMultiple,
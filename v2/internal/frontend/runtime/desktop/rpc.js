@@ -0,0 +1,84 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+
+import {Call, callbacks} from "./calls";
+
+/**
+ * RPCCall calls the Go handler registered with runtime.RegisterRPCHandler for the given method.
+ * The returned promise is resolved with the result of the handler or rejected with its error.
+ *
+ * @export
+ * @param {string} method - The name of the registered method
+ * @param {any} [params] - The params passed to the handler as JSON
+ * @param {{signal?: AbortSignal, timeout?: number}} [options] - An AbortSignal to cancel the call and a timeout in milliseconds
+ * @return {Promise<any>}
+ */
+export function RPCCall(method, params, options) {
+    const {signal, timeout} = options || {};
+
+    return new Promise(function (resolve, reject) {
+        if (signal && signal.aborted) {
+            reject(signal.reason);
+            return;
+        }
+
+        let callbackID;
+        do {
+            callbackID = ":wails:RPC-" + method + "-" + Math.random() * 9007199254740991;
+        } while (callbacks[callbackID]);
+
+        // A cancelled call stays registered to swallow the late response of the backend
+        const cancel = function (reason) {
+            const callback = callbacks[callbackID];
+            if (!callback) {
+                return;
+            }
+            clearTimeout(callback.timeoutHandle);
+            callback.resolve = callback.reject = function () {};
+            Call(":wails:RPCCancel", [callbackID]).catch(function () {});
+            reject(reason);
+        };
+
+        const onAbort = function () {
+            cancel(signal.reason);
+        };
+        if (signal) {
+            signal.addEventListener("abort", onAbort, {once: true});
+        }
+        const done = function () {
+            if (signal) {
+                signal.removeEventListener("abort", onAbort);
+            }
+        };
+
+        callbacks[callbackID] = {
+            timeoutHandle: timeout > 0 ? setTimeout(function () {
+                cancel(Error("RPC call to " + method + " timed out"));
+            }, timeout) : undefined,
+            resolve: function (result) {
+                done();
+                resolve(result);
+            },
+            reject: function (error) {
+                done();
+                reject(error);
+            },
+        };
+
+        window.WailsInvoke("C" + JSON.stringify({
+            name: ":wails:RPC",
+            args: params === undefined ? [method] : [method, params],
+            callbackID,
+        }));
+    });
+}
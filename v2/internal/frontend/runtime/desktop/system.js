@@ -0,0 +1,246 @@
+/*
+ _	   __	  _ __
+| |	 / /___ _(_) /____
+| | /| / / __ `/ / / ___/
+| |/ |/ / /_/ / / (__  )
+|__/|__/\__,_/_/_/____/
+The electron alternative for Go
+(c) Lea Anthony 2019-present
+*/
+
+/* jshint esversion: 9 */
+
+
+import {Call} from "./calls";
+import {Events, EventsOn} from "./events";
+
+/**
+ * systemCall calls a system method of the backend and returns a promise with the result
+ *
+ * @export
+ * @param {string} name - The name of the system method
+ * @param {any[]} [args] - The arguments of the method
+ * @return {Promise<any>}
+ */
+export function systemCall(name, args) {
+    return Call(":wails:" + name, args);
+}
+
+/**
+ * GetAppInfo returns the name, version, build date, git commit and build type of the application.
+ *
+ * @export
+ * @return {Promise<{name: string, version: string, buildDate: string, gitCommit: string, buildType: string}>}
+ */
+export function GetAppInfo() {
+    return Call(":wails:AppInfo");
+}
+
+/**
+ * GetLocaleInfo returns the regional settings of the OS, like the number separators and date formats.
+ *
+ * @export
+ * @return {Promise<object>}
+ */
+export function GetLocaleInfo() {
+    return Call(":wails:LocaleInfo");
+}
+
+/**
+ * PerformHaptic performs a haptic feedback pattern on the Force Touch trackpad. Only supported on macOS.
+ *
+ * @export
+ * @param {"generic"|"alignment"|"levelChange"} pattern
+ * @return {Promise<boolean>}
+ */
+export function PerformHaptic(pattern) {
+    return Call(":wails:Haptic", [pattern]);
+}
+
+/**
+ * OnProgress registers a listener for the progress of the tasks started with `runtime.WithProgress` in Go.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({id: string, value: number, done: boolean, error?: string})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnProgress(callback) {
+    return EventsOn(Events.Progress, callback);
+}
+
+/**
+ * CancelProgress cancels the task with the given id, which has been started with `runtime.WithProgress` in Go.
+ * Resolves to false if no such task is running.
+ *
+ * @export
+ * @param {string} id
+ * @return {Promise<boolean>}
+ */
+export function CancelProgress(id) {
+    return Call(":wails:ProgressCancel", [id]);
+}
+
+/**
+ * MenuGetStructure returns the tree of the visible items of the application menu.
+ *
+ * @export
+ * @return {Promise<Array<{id: string, label: string, type: string, role?: string, accelerator?: string, disabled: boolean, checked: boolean, children?: Array}>>}
+ */
+export function MenuGetStructure() {
+    return Call(":wails:MenuGetStructure");
+}
+
+/**
+ * MenuTrigger invokes the item of the application menu with the id of a node returned by MenuGetStructure, as if
+ * it had been clicked.
+ *
+ * @export
+ * @param {string} id
+ * @return {Promise<boolean>}
+ */
+export function MenuTrigger(id) {
+    return Call(":wails:MenuTrigger", [id]);
+}
+
+/**
+ * OnMenuChange registers a listener for changes of the application menu, which is called with the new structure.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function(Array)} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnMenuChange(callback) {
+    return EventsOn(Events.MenuChange, callback);
+}
+
+/**
+ * ShowShareMenu shows the native share menu with the items. On macOS the menu is shown at the anchor, which can be
+ * the bounding rect of an element.
+ *
+ * @export
+ * @param {{title?: string, text?: string, urls?: string[], files?: string[]}} items
+ * @param {{x: number, y: number, width: number, height: number}} [anchor]
+ * @return {Promise<boolean>}
+ */
+export function ShowShareMenu(items, anchor) {
+    const rect = anchor || {x: 0, y: 0, width: 0, height: 0};
+    return Call(":wails:ShowShareMenu", [items, {
+        x: Math.round(rect.x),
+        y: Math.round(rect.y),
+        width: Math.round(rect.width),
+        height: Math.round(rect.height),
+    }]);
+}
+
+/**
+ * WasLaunchedAtLogin returns true if the application has been launched at login by the registration of
+ * SetLaunchAtLogin, e.g. to stay in the tray rather than showing the window.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WasLaunchedAtLogin() {
+    return Call(":wails:WasLaunchedAtLogin");
+}
+
+/**
+ * GetNetworkStatus returns the network status reported by the OS, which is reliable unlike navigator.onLine.
+ *
+ * @export
+ * @return {Promise<{online: boolean, connectionType: string}>}
+ */
+export function GetNetworkStatus() {
+    return Call(":wails:NetworkStatus");
+}
+
+/**
+ * OnNetworkChange registers a listener for changes of the network status reported by the OS.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({online: boolean, connectionType: string})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnNetworkChange(callback) {
+    return EventsOn(Events.NetworkChange, callback);
+}
+
+/**
+ * IsRemoteSession returns true if the application runs in a remote desktop session.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function IsRemoteSession() {
+    return Call(":wails:IsRemoteSession");
+}
+
+/**
+ * OnSessionChange registers a listener for the locks, unlocks, disconnections and reconnections of the session of
+ * the user. It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function("lock"|"unlock"|"disconnect"|"reconnect")} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnSessionChange(callback) {
+    return EventsOn(Events.SessionChange, callback);
+}
+
+/**
+ * SystemPrefersReducedMotion returns true if the user has asked the OS for less animations.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function SystemPrefersReducedMotion() {
+    return Call(":wails:SystemPrefersReducedMotion");
+}
+
+/**
+ * SystemHighContrastActive returns true if a high contrast theme of the OS is active.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function SystemHighContrastActive() {
+    return Call(":wails:SystemHighContrastActive");
+}
+
+/**
+ * OnAccessibilityChange registers a listener for changes of the reduced motion and the high contrast settings of the
+ * OS. It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({reducedMotion: boolean, highContrast: boolean})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnAccessibilityChange(callback) {
+    return EventsOn(Events.AccessibilityChange, callback);
+}
+
+/**
+ * LaunchData returns the URLs and the files the application has been asked to open, including the ones it was
+ * launched with. It is available synchronously when the frontend boots, the data isn't delivered again when the page
+ * is reloaded.
+ *
+ * @export
+ * @return {{urls: string[], files: string[]}}
+ */
+export function LaunchData() {
+    return window.__wails_launch__ || {urls: [], files: []};
+}
+
+/**
+ * OnLaunch registers a listener for the URLs and the files the application is asked to open while it runs. It returns
+ * a function to cancel the listener.
+ *
+ * @export
+ * @param {function({urls: string[], files: string[]})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnLaunch(callback) {
+    return EventsOn(Events.Launch, callback);
+}
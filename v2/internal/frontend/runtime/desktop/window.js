@@ -12,6 +12,7 @@ The electron alternative for Go
 
 
 import {Call} from "./calls";
+import {Events, EventsOn} from "./events";
 
 export function WindowReload() {
     window.location.reload();
@@ -267,3 +268,403 @@ export function WindowSetBackgroundColour(R, G, B, A) {
     window.WailsInvoke('Wr:' + rgba);
 }
 
+/**
+ * WindowSetAlwaysOnBottom keeps the window below the normal windows, e.g. for a desktop widget.
+ *
+ * @export
+ * @param {boolean} b
+ * @return {Promise<boolean>}
+ */
+export function WindowSetAlwaysOnBottom(b) {
+    return Call(":wails:WindowSetAlwaysOnBottom", [b]);
+}
+
+/**
+ * WindowSetOpacity sets the opacity of the whole window from 0 to 1 and returns the previous opacity.
+ *
+ * @export
+ * @param {number} opacity
+ * @return {Promise<number>}
+ */
+export function WindowSetOpacity(opacity) {
+    return Call(":wails:WindowSetOpacity", [opacity]);
+}
+
+/**
+ * WindowSetAnimationsEnabled enables or disables the animations of the window, e.g. when it is minimised. Windows only.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetAnimationsEnabled(enabled) {
+    return Call(":wails:WindowSetAnimationsEnabled", [enabled]);
+}
+
+/**
+ * WindowOpenDevTools opens the dev tools of the webview, it fails if the dev tools are disabled.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WindowOpenDevTools() {
+    return Call(":wails:WindowOpenDevTools");
+}
+
+/**
+ * WindowCloseDevTools closes the dev tools of the webview, it fails if the dev tools are disabled.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WindowCloseDevTools() {
+    return Call(":wails:WindowCloseDevTools");
+}
+
+/**
+ * WindowSetUserAgent sets the user agent of the webview, an empty string restores the default user agent.
+ *
+ * @export
+ * @param {string} userAgent
+ * @return {Promise<boolean>}
+ */
+export function WindowSetUserAgent(userAgent) {
+    return Call(":wails:WindowSetUserAgent", [userAgent]);
+}
+
+/**
+ * WindowSetTheme switches the theme of the window: 0 follows the theme of the OS, 1 is dark and 2 is light.
+ *
+ * @export
+ * @param {number} theme
+ * @return {Promise<boolean>}
+ */
+export function WindowSetTheme(theme) {
+    return Call(":wails:WindowSetTheme", [theme]);
+}
+
+/**
+ * WebviewClearCache clears the browsing data of the webview, the promise resolves once the data has been cleared.
+ * The kinds are combined with |: 1 cookies, 2 disk cache, 4 DOM storage, 8 IndexedDB, 16 other data, 31 all.
+ * The optional start and end restrict the data to the data modified in this time range. Not supported on macOS.
+ *
+ * @export
+ * @param {number} kinds
+ * @param {Date} [start]
+ * @param {Date} [end]
+ * @return {Promise<boolean>}
+ */
+export function WebviewClearCache(kinds, start, end) {
+    return Call(":wails:WebviewClearCache", [kinds, start ? start.getTime() : 0, end ? end.getTime() : 0]);
+}
+
+/**
+ * WebviewPrintToPDFData prints the page to a PDF without showing the print dialog, the promise resolves with the
+ * content of the PDF in base64. Not supported on macOS.
+ *
+ * @export
+ * @param {{orientation?: "portrait"|"landscape", scaleFactor?: number, pageWidth?: number, pageHeight?: number, margins?: {top: number, bottom: number, left: number, right: number}, printBackgrounds?: boolean, headerAndFooter?: boolean, headerTitle?: string, footerURI?: string}} [settings]
+ * @return {Promise<string>}
+ */
+export function WebviewPrintToPDFData(settings) {
+    return Call(":wails:WebviewPrintToPDFData", [settings || {}]);
+}
+
+/**
+ * WebviewCapturePreview captures the visible content of the webview, the promise resolves with the image in base64.
+ *
+ * @export
+ * @param {"png"|"jpeg"} [format] - Defaults to "png"
+ * @return {Promise<string>}
+ */
+export function WebviewCapturePreview(format) {
+    return Call(":wails:WebviewCapturePreview", [format || "png"]);
+}
+
+/**
+ * WindowCapture captures the whole window including its frame, the promise resolves with the image in base64.
+ * Windows only.
+ *
+ * @export
+ * @param {"png"|"jpeg"} [format] - Defaults to "png"
+ * @return {Promise<string>}
+ */
+export function WindowCapture(format) {
+    return Call(":wails:WindowCapture", [format || "png"]);
+}
+
+/**
+ * WindowSetSkipTaskbar hides the window from the taskbar and Alt+Tab. Not supported on macOS.
+ *
+ * @export
+ * @param {boolean} skip
+ * @return {Promise<boolean>}
+ */
+export function WindowSetSkipTaskbar(skip) {
+    return Call(":wails:WindowSetSkipTaskbar", [skip]);
+}
+
+/**
+ * WindowSetBorderlessFullscreen makes the window cover the screen at the index of ScreenGetAll, without any window
+ * chrome and above the taskbar. It is left with WindowUnfullscreen.
+ *
+ * @export
+ * @param {number} screen
+ * @return {Promise<boolean>}
+ */
+export function WindowSetBorderlessFullscreen(screen) {
+    return Call(":wails:WindowSetBorderlessFullscreen", [screen]);
+}
+
+/**
+ * WindowSetBackdropType changes the translucent backdrop of the window: 0 Auto, 1 None, 2 Mica, 3 Acrylic or 4 Tabbed.
+ * Windows only. Requires Windows 11 22621 or later and WindowIsTranslucent to be enabled, the promise is rejected
+ * otherwise.
+ *
+ * @export
+ * @param {number} backdrop
+ * @return {Promise<boolean>}
+ */
+export function WindowSetBackdropType(backdrop) {
+    return Call(":wails:WindowSetBackdropType", [backdrop]);
+}
+
+/**
+ * WindowSetZoomFactor sets the zoom factor of the webview, clamped to 0.25-5.0. Windows only. Requires
+ * IsZoomControlEnabled to be enabled, the promise is rejected otherwise.
+ *
+ * @export
+ * @param {number} factor
+ * @return {Promise<boolean>}
+ */
+export function WindowSetZoomFactor(factor) {
+    return Call(":wails:WindowSetZoomFactor", [factor]);
+}
+
+/**
+ * WindowGetZoomFactor returns the zoom factor of the webview. It is 1 on the platforms other than Windows.
+ *
+ * @export
+ * @return {Promise<number>}
+ */
+export function WindowGetZoomFactor() {
+    return Call(":wails:WindowGetZoomFactor");
+}
+
+/**
+ * WindowGetDPI returns the DPI of the window, 96 is a scale of 100%. Windows only.
+ *
+ * @export
+ * @return {Promise<number>}
+ */
+export function WindowGetDPI() {
+    return Call(":wails:WindowGetDPI");
+}
+
+/**
+ * WindowSetTaskbarProgress shows the progress of a long running operation on the taskbar button of the window. The
+ * state is "normal", "indeterminate", "error", "paused" or "none" to hide the progress. Windows only.
+ *
+ * @export
+ * @param {string} state
+ * @param {number} completed
+ * @param {number} total
+ * @return {Promise<boolean>}
+ */
+export function WindowSetTaskbarProgress(state, completed, total) {
+    return Call(":wails:WindowSetTaskbarProgress", [state, completed, total]);
+}
+
+/**
+ * WindowSetSizeToContent resizes the window to the rendered size of the page, clamped to the work area of its monitor.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WindowSetSizeToContent() {
+    return Call(":wails:WindowSetSizeToContent");
+}
+
+/**
+ * WindowFocus brings the window to the foreground and gives it the keyboard focus
+ *
+ * @export
+ * @return {Promise<void>}
+ */
+export function WindowFocus() {
+    return Call(":wails:WindowFocus");
+}
+
+/**
+ * WindowSetContentProtection excludes the window from screenshots, screen recording and screen sharing. Windows only.
+ * The promise is rejected on the Windows 10 versions before 2004, which show the window black in the captures instead.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetContentProtection(enabled) {
+    return Call(":wails:WindowSetContentProtection", [enabled]);
+}
+
+/**
+ * WindowGetState returns the position, the size and the maximised state of the window, which can be saved to restore
+ * the window with WindowSetState.
+ *
+ * @export
+ * @return {Promise<{x: number, y: number, width: number, height: number, maximised: boolean, screen?: string}>}
+ */
+export function WindowGetState() {
+    return Call(":wails:WindowGetState");
+}
+
+/**
+ * WindowSetState restores the position, the size and the maximised state of the window returned by WindowGetState.
+ *
+ * @export
+ * @param {{x: number, y: number, width: number, height: number, maximised: boolean, screen?: string}} state
+ * @return {Promise<boolean>}
+ */
+export function WindowSetState(state) {
+    return Call(":wails:WindowSetState", [state]);
+}
+
+/**
+ * WindowSetIgnoreMouseEvents lets the mouse events fall through the window. If forward is true the mouse moves are
+ * still dispatched to the page as mousemove events. Windows only.
+ *
+ * @export
+ * @param {boolean} ignore
+ * @param {boolean} forward
+ * @return {Promise<boolean>}
+ */
+export function WindowSetIgnoreMouseEvents(ignore, forward) {
+    return Call(":wails:WindowSetIgnoreMouseEvents", [ignore, forward]);
+}
+
+/**
+ * WindowSetMinimiseButtonEnabled enables or disables the minimise button of the window. Windows only.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetMinimiseButtonEnabled(enabled) {
+    return Call(":wails:WindowSetMinimiseButtonEnabled", [enabled]);
+}
+
+/**
+ * WindowSetMaximiseButtonEnabled enables or disables the maximise button of the window. Windows only.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetMaximiseButtonEnabled(enabled) {
+    return Call(":wails:WindowSetMaximiseButtonEnabled", [enabled]);
+}
+
+/**
+ * WindowSetCloseButtonEnabled enables or disables the close button of the window. The close item of the window menu and Alt+F4 are disabled too. Windows only.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetCloseButtonEnabled(enabled) {
+    return Call(":wails:WindowSetCloseButtonEnabled", [enabled]);
+}
+
+/**
+ * WindowFlash flashes the taskbar button of the window, once or until the window is activated if untilFocused is
+ * true. Windows only.
+ *
+ * @export
+ * @param {boolean} untilFocused
+ * @return {Promise<boolean>}
+ */
+export function WindowFlash(untilFocused) {
+    return Call(":wails:WindowFlash", [untilFocused]);
+}
+
+/**
+ * WindowSetCursor overrides the cursor of the window and the page, which also applies while the window is dragged.
+ * An empty cursor removes the override.
+ *
+ * @export
+ * @param {string} cursor - A CSS cursor name, EG "crosshair"
+ * @return {Promise<boolean>}
+ */
+export function WindowSetCursor(cursor) {
+    return Call(":wails:WindowSetCursor", [cursor]);
+}
+
+/**
+ * FindInPage selects the next match of the text in the page. The number of matches is reported to the listeners
+ * registered with OnFindResult.
+ *
+ * @export
+ * @param {string} text
+ * @param {{caseSensitive?: boolean, backwards?: boolean}} [options]
+ * @return {Promise<boolean>}
+ */
+export function FindInPage(text, options) {
+    return Call(":wails:FindInPage", [text, options || {}]);
+}
+
+/**
+ * StopFind clears the matches of FindInPage.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function StopFind() {
+    return Call(":wails:StopFind");
+}
+
+/**
+ * OnFindResult registers a listener for the results of FindInPage.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({text: string, matches: number})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnFindResult(callback) {
+    return EventsOn(Events.FindResult, callback);
+}
+
+/**
+ * IMEIsComposing returns true while an input method composition is in progress, EG while Japanese or Chinese text
+ * is being typed.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function IMEIsComposing() {
+    return Call(":wails:IMEIsComposing");
+}
+
+/**
+ * OnIMEComposition registers a listener for the start, the updates and the end of input method compositions.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({phase: string, text: string})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnIMEComposition(callback) {
+    return EventsOn(Events.IMEComposition, callback);
+}
+
+/**
+ * OnNavigationProgress registers a listener for the estimated progress of the page loads, between 0 and 1.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function(number)} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnNavigationProgress(callback) {
+    return EventsOn(Events.NavigationProgress, callback);
+}
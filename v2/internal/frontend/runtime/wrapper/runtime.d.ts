@@ -56,6 +56,27 @@ export function EventsOff(eventName: string, ...additionalEventNames: string[]):
 // unregisters all listeners.
 export function EventsOffAll(): void;
 
+// Events are the names of the events emitted by Wails. They are named "wails:<subject>:<event>", except FileDrop
+// which predates the scheme.
+export const Events: {
+    readonly FileDrop: "wails:file-drop";
+    readonly FullscreenEnter: "wails:fullscreen:enter";
+    readonly FullscreenLeave: "wails:fullscreen:leave";
+    readonly LocaleChange: "wails:locale:change";
+    readonly AccessibilityChange: "wails:accessibility:change";
+    readonly NetworkChange: "wails:network:change";
+    readonly SessionChange: "wails:session:change";
+    readonly MenuChange: "wails:menu:change";
+    readonly FindResult: "wails:find:result";
+    readonly IMEComposition: "wails:ime:composition";
+    readonly NavigationProgress: "wails:navigation:progress";
+    readonly Launch: "wails:app:launch";
+    readonly ServiceInvoke: "wails:service:invoke";
+    readonly Progress: "wails:task:progress";
+    readonly ProcessOutput: "wails:process:output";
+    readonly ProcessExit: "wails:process:exit";
+};
+
 // [LogPrint](https://wails.io/docs/reference/runtime/log#logprint)
 // logs the given message as a raw message
 export function LogPrint(message: string): void;
@@ -97,6 +118,68 @@ export function WindowReloadApp(): void;
 // Sets the window AlwaysOnTop or not on top.
 export function WindowSetAlwaysOnTop(b: boolean): void;
 
+// [WindowSetAlwaysOnBottom](https://wails.io/docs/reference/runtime/window#windowsetalwaysonbottom)
+// Keeps the window below the normal windows, e.g. for a desktop widget.
+export function WindowSetAlwaysOnBottom(b: boolean): Promise<boolean>;
+
+// [WindowSetOpacity](https://wails.io/docs/reference/runtime/window#windowsetopacity)
+// Sets the opacity of the whole window from 0 to 1 and returns the previous opacity.
+export function WindowSetOpacity(opacity: number): Promise<number>;
+
+// [WindowSetAnimationsEnabled](https://wails.io/docs/reference/runtime/window#windowsetanimationsenabled)
+// Enables or disables the animations of the window, e.g. when it is minimised. Windows only.
+export function WindowSetAnimationsEnabled(enabled: boolean): Promise<boolean>;
+
+// [WindowOpenDevTools](https://wails.io/docs/reference/runtime/window#windowopendevtools)
+// Opens the dev tools of the webview, it fails if the dev tools are disabled.
+export function WindowOpenDevTools(): Promise<boolean>;
+
+// [WindowCloseDevTools](https://wails.io/docs/reference/runtime/window#windowclosedevtools)
+// Closes the dev tools of the webview, it fails if the dev tools are disabled.
+export function WindowCloseDevTools(): Promise<boolean>;
+
+// [WindowSetUserAgent](https://wails.io/docs/reference/runtime/window#windowsetuseragent)
+// Sets the user agent of the webview, an empty string restores the default user agent.
+export function WindowSetUserAgent(userAgent: string): Promise<boolean>;
+
+// [WindowSetTheme](https://wails.io/docs/reference/runtime/window#windowsettheme)
+// Switches the theme of the window: 0 follows the theme of the OS, 1 is dark and 2 is light.
+export function WindowSetTheme(theme: number): Promise<boolean>;
+
+// [WebviewClearCache](https://wails.io/docs/reference/runtime/window#webviewclearcache)
+// Clears the browsing data of the webview modified between start and end, the kinds are combined with |:
+// 1 cookies, 2 disk cache, 4 DOM storage, 8 IndexedDB, 16 other data, 31 all. Not supported on macOS.
+export function WebviewClearCache(kinds: number, start?: Date, end?: Date): Promise<boolean>;
+
+export interface PrintSettings {
+    orientation?: "portrait" | "landscape";
+    scaleFactor?: number;
+    pageWidth?: number;
+    pageHeight?: number;
+    margins?: {top: number; bottom: number; left: number; right: number};
+    printBackgrounds?: boolean;
+    headerAndFooter?: boolean;
+    headerTitle?: string;
+    footerURI?: string;
+}
+
+// [WebviewPrintToPDF](https://wails.io/docs/reference/runtime/window#webviewprinttopdf)
+// Prints the page to a PDF without showing the print dialog. Resolves with the content of the PDF in base64.
+// Not supported on macOS.
+export function WebviewPrintToPDFData(settings?: PrintSettings): Promise<string>;
+
+// [WebviewCapturePreview](https://wails.io/docs/reference/runtime/window#webviewcapturepreview)
+// Captures the visible content of the webview. Resolves with the image in base64.
+export function WebviewCapturePreview(format?: "png" | "jpeg"): Promise<string>;
+
+// [WindowCapture](https://wails.io/docs/reference/runtime/window#windowcapture)
+// Captures the whole window including its frame. Resolves with the image in base64. Windows only.
+export function WindowCapture(format?: "png" | "jpeg"): Promise<string>;
+
+// [WindowSetSkipTaskbar](https://wails.io/docs/reference/runtime/window#windowsetskiptaskbar)
+// Hides the window from the taskbar and Alt+Tab. Not supported on macOS.
+export function WindowSetSkipTaskbar(skip: boolean): Promise<boolean>;
+
 // [WindowSetSystemDefaultTheme](https://wails.io/docs/next/reference/runtime/window#windowsetsystemdefaulttheme)
 // *Windows only*
 // Sets window theme to system default (dark/light).
@@ -128,6 +211,10 @@ export function WindowFullscreen(): void;
 // Restores the previous window dimensions and position prior to full screen.
 export function WindowUnfullscreen(): void;
 
+// [WindowSetBorderlessFullscreen](https://wails.io/docs/reference/runtime/window#windowsetborderlessfullscreen)
+// Makes the window cover the screen at the index of ScreenGetAll, without any window chrome and above the taskbar.
+export function WindowSetBorderlessFullscreen(screen: number): Promise<boolean>;
+
 // [WindowIsFullscreen](https://wails.io/docs/reference/runtime/window#windowisfullscreen)
 // Returns the state of the window, i.e. whether the window is in full screen mode or not.
 export function WindowIsFullscreen(): Promise<boolean>;
@@ -136,6 +223,30 @@ export function WindowIsFullscreen(): Promise<boolean>;
 // Sets the width and height of the window.
 export function WindowSetSize(width: number, height: number): void;
 
+// [WindowSetBackdropType](https://wails.io/docs/reference/runtime/window#windowsetbackdroptype)
+// Changes the translucent backdrop of the window: 0 Auto, 1 None, 2 Mica, 3 Acrylic or 4 Tabbed. Windows only.
+export function WindowSetBackdropType(backdrop: 0 | 1 | 2 | 3 | 4): Promise<boolean>;
+
+// [WindowSetZoomFactor](https://wails.io/docs/reference/runtime/window#windowsetzoomfactor)
+// Sets the zoom factor of the webview, clamped to 0.25-5.0. Windows only.
+export function WindowSetZoomFactor(factor: number): Promise<boolean>;
+
+// [WindowGetZoomFactor](https://wails.io/docs/reference/runtime/window#windowgetzoomfactor)
+// Returns the zoom factor of the webview.
+export function WindowGetZoomFactor(): Promise<number>;
+
+// [WindowGetDPI](https://wails.io/docs/reference/runtime/window#windowgetdpi)
+// Returns the DPI of the window, 96 is a scale of 100%. Windows only.
+export function WindowGetDPI(): Promise<number>;
+
+// [WindowSetTaskbarProgress](https://wails.io/docs/reference/runtime/window#windowsettaskbarprogress)
+// Shows the progress of a long running operation on the taskbar button of the window. Windows only.
+export function WindowSetTaskbarProgress(state: "none" | "indeterminate" | "normal" | "error" | "paused", completed: number, total: number): Promise<boolean>;
+
+// [WindowSetSizeToContent](https://wails.io/docs/reference/runtime/window#windowsetsizetocontent)
+// Resizes the window to the rendered size of the page, clamped to the work area of its monitor.
+export function WindowSetSizeToContent(): Promise<boolean>;
+
 // [WindowGetSize](https://wails.io/docs/reference/runtime/window#windowgetsize)
 // Gets the width and height of the window.
 export function WindowGetSize(): Promise<Size>;
@@ -166,6 +277,51 @@ export function WindowHide(): void;
 // Shows the window, if it is currently hidden.
 export function WindowShow(): void;
 
+// [WindowFocus](https://wails.io/docs/reference/runtime/window#windowfocus)
+// Brings the window to the foreground and gives it the keyboard focus.
+export function WindowFocus(): Promise<void>;
+
+// [WindowSetContentProtection](https://wails.io/docs/reference/runtime/window#windowsetcontentprotection)
+// Excludes the window from screenshots, screen recording and screen sharing. Windows only.
+export function WindowSetContentProtection(enabled: boolean): Promise<boolean>;
+
+export interface WindowState {
+    x: number;
+    y: number;
+    width: number;
+    height: number;
+    maximised: boolean;
+    screen?: string;
+}
+
+// [WindowGetState](https://wails.io/docs/reference/runtime/window#windowgetstate)
+// Returns the position, the size and the maximised state of the window.
+export function WindowGetState(): Promise<WindowState>;
+
+// [WindowSetState](https://wails.io/docs/reference/runtime/window#windowsetstate)
+// Restores the position, the size and the maximised state of the window returned by WindowGetState.
+export function WindowSetState(state: WindowState): Promise<boolean>;
+
+// [WindowSetIgnoreMouseEvents](https://wails.io/docs/reference/runtime/window#windowsetignoremouseevents)
+// Lets the mouse events fall through the window, the mouse moves are dispatched to the page if forward is true. Windows only.
+export function WindowSetIgnoreMouseEvents(ignore: boolean, forward: boolean): Promise<boolean>;
+
+// [WindowSetMinimiseButtonEnabled](https://wails.io/docs/reference/runtime/window#windowsetminimisebuttonenabled)
+// Enables or disables the minimise button of the window. Windows only.
+export function WindowSetMinimiseButtonEnabled(enabled: boolean): Promise<boolean>;
+
+// [WindowSetMaximiseButtonEnabled](https://wails.io/docs/reference/runtime/window#windowsetmaximisebuttonenabled)
+// Enables or disables the maximise button of the window. Windows only.
+export function WindowSetMaximiseButtonEnabled(enabled: boolean): Promise<boolean>;
+
+// [WindowSetCloseButtonEnabled](https://wails.io/docs/reference/runtime/window#windowsetclosebuttonenabled)
+// Enables or disables the close button of the window. Windows only.
+export function WindowSetCloseButtonEnabled(enabled: boolean): Promise<boolean>;
+
+// [WindowFlash](https://wails.io/docs/reference/runtime/window#windowflash)
+// Flashes the taskbar button of the window, once or until the window is activated. Windows only.
+export function WindowFlash(untilFocused: boolean): Promise<boolean>;
+
 // [WindowMaximise](https://wails.io/docs/reference/runtime/window#windowmaximise)
 // Maximises the window to fill the screen.
 export function WindowMaximise(): void;
@@ -246,4 +402,218 @@ export function OnFileDropOff() :void
 export function CanResolveFilePaths(): boolean;
 
 // Resolves file paths for an array of files
-export function ResolveFilePaths(files: File[]): void
\ No newline at end of file
+export function ResolveFilePaths(files: File[]): void
+
+export interface AppInfo {
+    name: string;
+    version: string;
+    buildDate: string;
+    gitCommit: string;
+    buildType: string;
+}
+
+// [GetAppInfo](https://wails.io/docs/reference/runtime/intro#getappinfo)
+// Returns the name, version, build date, git commit and build type of the application
+export function GetAppInfo(): Promise<AppInfo>;
+
+export interface LocaleInfo {
+    locale: string;
+    decimalSeparator: string;
+    thousandsSeparator: string;
+    shortDateFormat: string;
+    longDateFormat: string;
+    timeFormat: string;
+    firstDayOfWeek: number;
+    currencySymbol: string;
+    currencyCode: string;
+}
+
+// [GetLocaleInfo](https://wails.io/docs/reference/runtime/intro#getlocaleinfo)
+// Returns the regional settings of the OS
+export function GetLocaleInfo(): Promise<LocaleInfo>;
+
+export interface Progress {
+    id: string;
+    // Between 0 and 1
+    value: number;
+    done: boolean;
+    // The error of a failed or cancelled task
+    error?: string;
+}
+
+// [OnProgress](https://wails.io/docs/reference/runtime/events#onprogress)
+// Registers a listener for the progress of the tasks started with `runtime.WithProgress`.
+// Returns a function to cancel the listener.
+export function OnProgress(callback: (progress: Progress) => void): () => void;
+
+// [CancelProgress](https://wails.io/docs/reference/runtime/events#cancelprogress)
+// Cancels the task with the given id. Resolves to false if no such task is running.
+export function CancelProgress(id: string): Promise<boolean>;
+
+export type HapticPattern = "generic" | "alignment" | "levelChange";
+
+// [PerformHaptic](https://wails.io/docs/reference/runtime/intro#performhaptic)
+// Performs a haptic feedback pattern on the Force Touch trackpad. Only supported on macOS.
+export function PerformHaptic(pattern: HapticPattern): Promise<boolean>;
+
+export type Cursor = "default" | "pointer" | "text" | "crosshair" | "wait" | "progress" | "move" | "not-allowed" |
+    "grab" | "grabbing" | "help" | "ew-resize" | "ns-resize" | "nesw-resize" | "nwse-resize" | "";
+
+// [WindowSetCursor](https://wails.io/docs/reference/runtime/window#windowsetcursor)
+// Overrides the cursor of the window and the page. An empty cursor removes the override.
+export function WindowSetCursor(cursor: Cursor): Promise<boolean>;
+
+export interface FindOptions {
+    caseSensitive?: boolean;
+    // Selects the previous match instead of the next one
+    backwards?: boolean;
+}
+
+export interface FindResult {
+    text: string;
+    // 0 if the text was not found
+    matches: number;
+}
+
+// [FindInPage](https://wails.io/docs/reference/runtime/window#findinpage)
+// Selects the next match of the text in the page, the number of matches is reported to OnFindResult.
+export function FindInPage(text: string, options?: FindOptions): Promise<boolean>;
+
+// [StopFind](https://wails.io/docs/reference/runtime/window#stopfind)
+// Clears the matches of FindInPage.
+export function StopFind(): Promise<boolean>;
+
+// [OnFindResult](https://wails.io/docs/reference/runtime/window#onfindresult)
+// Registers a listener for the results of FindInPage. Returns a function to cancel the listener.
+export function OnFindResult(callback: (result: FindResult) => void): () => void;
+
+export interface MenuNode {
+    // The id to pass to MenuTrigger, the path of indexes to the item, e.g. "0.2"
+    id: string;
+    label: string;
+    type: "Text" | "Separator" | "Submenu" | "Checkbox" | "Radio";
+    role?: "appMenu" | "editMenu" | "windowMenu";
+    // The key combination as shown in a native menu of the platform, e.g. "Ctrl+Shift+S"
+    accelerator?: string;
+    disabled: boolean;
+    checked: boolean;
+    children?: MenuNode[];
+}
+
+// [MenuGetStructure](https://wails.io/docs/reference/runtime/menu#menugetstructure)
+// Returns the tree of the visible items of the application menu.
+export function MenuGetStructure(): Promise<MenuNode[]>;
+
+// [MenuTrigger](https://wails.io/docs/reference/runtime/menu#menutrigger)
+// Invokes the item of the application menu with the id as if it had been clicked.
+export function MenuTrigger(id: string): Promise<boolean>;
+
+// [OnMenuChange](https://wails.io/docs/reference/runtime/menu#onmenuchange)
+// Registers a listener for changes of the application menu. Returns a function to cancel the listener.
+export function OnMenuChange(callback: (structure: MenuNode[]) => void): () => void;
+
+export interface ShareItems {
+    // Required by Windows, defaults to the title of the application
+    title?: string;
+    text?: string;
+    urls?: string[];
+    // Absolute paths, which can't be shared on Windows
+    files?: string[];
+}
+
+// [ShowShareMenu](https://wails.io/docs/reference/runtime/intro#showsharemenu)
+// Shows the native share menu. On macOS it is shown at the anchor, e.g. the bounding rect of the share button.
+export function ShowShareMenu(items: ShareItems, anchor?: {x: number, y: number, width: number, height: number}): Promise<boolean>;
+
+// [WasLaunchedAtLogin](https://wails.io/docs/reference/runtime/intro#waslaunchedatlogin)
+// Returns true if the application has been launched at login by the registration of SetLaunchAtLogin.
+export function WasLaunchedAtLogin(): Promise<boolean>;
+
+export interface NetworkStatus {
+    // True when the OS reports a connection to the internet
+    online: boolean;
+    connectionType: "none" | "wifi" | "ethernet" | "cellular" | "unknown";
+}
+
+// [GetNetworkStatus](https://wails.io/docs/reference/runtime/intro#getnetworkstatus)
+// Returns the network status reported by the OS.
+export function GetNetworkStatus(): Promise<NetworkStatus>;
+
+// [OnNetworkChange](https://wails.io/docs/reference/runtime/intro#onnetworkchange)
+// Registers a listener for changes of the network status. Returns a function to cancel the listener.
+export function OnNetworkChange(callback: (status: NetworkStatus) => void): () => void;
+
+// [IsRemoteSession](https://wails.io/docs/reference/runtime/intro#isremotesession)
+// Returns true if the application runs in a remote desktop session.
+export function IsRemoteSession(): Promise<boolean>;
+
+// [OnSessionChange](https://wails.io/docs/reference/runtime/intro#onsessionchange)
+// Registers a listener for changes of the session of the user. Returns a function to cancel the listener.
+export function OnSessionChange(callback: (change: "lock" | "unlock" | "disconnect" | "reconnect") => void): () => void;
+
+export interface AccessibilitySettings {
+    reducedMotion: boolean;
+    highContrast: boolean;
+}
+
+// [SystemPrefersReducedMotion](https://wails.io/docs/reference/runtime/intro#systemprefersreducedmotion)
+// Returns true if the user has asked the OS for less animations.
+export function SystemPrefersReducedMotion(): Promise<boolean>;
+
+// [SystemHighContrastActive](https://wails.io/docs/reference/runtime/intro#systemhighcontrastactive)
+// Returns true if a high contrast theme of the OS is active.
+export function SystemHighContrastActive(): Promise<boolean>;
+
+// [OnAccessibilityChange](https://wails.io/docs/reference/runtime/intro#onaccessibilitychange)
+// Registers a listener for changes of the accessibility settings. Returns a function to cancel the listener.
+export function OnAccessibilityChange(callback: (settings: AccessibilitySettings) => void): () => void;
+
+export interface LaunchData {
+    urls: string[];
+    files: string[];
+}
+
+// [LaunchData](https://wails.io/docs/reference/runtime/intro#launchdata)
+// Returns the URLs and the files the application has been asked to open, available synchronously when the frontend boots.
+export function LaunchData(): LaunchData;
+
+// [OnLaunch](https://wails.io/docs/reference/runtime/intro#onlaunch)
+// Registers a listener for the URLs and the files the application is asked to open while it runs. Returns a function to cancel the listener.
+export function OnLaunch(callback: (data: LaunchData) => void): () => void;
+
+export interface IMEComposition {
+    phase: "start" | "update" | "end";
+    // The text being composed, or the committed text when the composition ends. Empty when reported by the OS.
+    text: string;
+}
+
+// [IMEIsComposing](https://wails.io/docs/reference/runtime/window#imeiscomposing)
+// Returns true while an input method composition is in progress.
+export function IMEIsComposing(): Promise<boolean>;
+
+// [OnIMEComposition](https://wails.io/docs/reference/runtime/window#onimecomposition)
+// Registers a listener for input method compositions. Returns a function to cancel the listener.
+export function OnIMEComposition(callback: (composition: IMEComposition) => void): () => void;
+
+// [OnNavigationProgress](https://wails.io/docs/reference/runtime/window#onnavigationprogress)
+// Registers a listener for the estimated progress of page loads, between 0 and 1. Returns a function to cancel the listener.
+export function OnNavigationProgress(callback: (progress: number) => void): () => void;
+
+// systemCall calls a system method of the backend, EG "GetAppInfo", and returns a promise with the result.
+// The runtime functions wrap the system methods, use them instead where available.
+export function systemCall(name: string, args?: any[]): Promise<any>;
+
+export interface RPCCallOptions {
+    // Cancels the call when aborted, the context of the Go handler is cancelled
+    signal?: AbortSignal;
+    // Cancels the call after the given milliseconds
+    timeout?: number;
+}
+
+// [RPCCall](https://wails.io/docs/reference/runtime/rpc#rpccall)
+// Calls the Go handler registered with runtime.RegisterRPCHandler for the given method
+export function RPCCall(method: string, params?: any, options?: RPCCallOptions): Promise<any>;
+
+export const rpc: {
+    call: typeof RPCCall;
+};
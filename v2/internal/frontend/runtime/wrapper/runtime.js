@@ -57,6 +57,29 @@ export function EventsEmit(eventName) {
     return window.runtime.EventsEmit.apply(null, args);
 }
 
+/**
+ * Events are the names of the events emitted by Wails. They are named "wails:<subject>:<event>", except FileDrop
+ * which predates the scheme.
+ */
+export const Events = Object.freeze({
+    FileDrop: "wails:file-drop",
+    FullscreenEnter: "wails:fullscreen:enter",
+    FullscreenLeave: "wails:fullscreen:leave",
+    LocaleChange: "wails:locale:change",
+    AccessibilityChange: "wails:accessibility:change",
+    NetworkChange: "wails:network:change",
+    SessionChange: "wails:session:change",
+    MenuChange: "wails:menu:change",
+    FindResult: "wails:find:result",
+    IMEComposition: "wails:ime:composition",
+    NavigationProgress: "wails:navigation:progress",
+    Launch: "wails:app:launch",
+    ServiceInvoke: "wails:service:invoke",
+    Progress: "wails:task:progress",
+    ProcessOutput: "wails:process:output",
+    ProcessExit: "wails:process:exit",
+});
+
 export function WindowReload() {
     window.runtime.WindowReload();
 }
@@ -69,6 +92,142 @@ export function WindowSetAlwaysOnTop(b) {
     window.runtime.WindowSetAlwaysOnTop(b);
 }
 
+/**
+ * WindowSetAlwaysOnBottom keeps the window below the normal windows, e.g. for a desktop widget.
+ *
+ * @export
+ * @param {boolean} b
+ * @return {Promise<boolean>}
+ */
+export function WindowSetAlwaysOnBottom(b) {
+    return window.runtime.WindowSetAlwaysOnBottom(b);
+}
+
+/**
+ * WindowSetOpacity sets the opacity of the whole window from 0 to 1 and returns the previous opacity.
+ *
+ * @export
+ * @param {number} opacity
+ * @return {Promise<number>}
+ */
+export function WindowSetOpacity(opacity) {
+    return window.runtime.WindowSetOpacity(opacity);
+}
+
+/**
+ * WindowSetAnimationsEnabled enables or disables the animations of the window, e.g. when it is minimised. Windows only.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetAnimationsEnabled(enabled) {
+    return window.runtime.WindowSetAnimationsEnabled(enabled);
+}
+
+/**
+ * WindowOpenDevTools opens the dev tools of the webview, it fails if the dev tools are disabled.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WindowOpenDevTools() {
+    return window.runtime.WindowOpenDevTools();
+}
+
+/**
+ * WindowCloseDevTools closes the dev tools of the webview, it fails if the dev tools are disabled.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WindowCloseDevTools() {
+    return window.runtime.WindowCloseDevTools();
+}
+
+/**
+ * WindowSetUserAgent sets the user agent of the webview, an empty string restores the default user agent.
+ *
+ * @export
+ * @param {string} userAgent
+ * @return {Promise<boolean>}
+ */
+export function WindowSetUserAgent(userAgent) {
+    return window.runtime.WindowSetUserAgent(userAgent);
+}
+
+/**
+ * WindowSetTheme switches the theme of the window: 0 follows the theme of the OS, 1 is dark and 2 is light.
+ *
+ * @export
+ * @param {number} theme
+ * @return {Promise<boolean>}
+ */
+export function WindowSetTheme(theme) {
+    return window.runtime.WindowSetTheme(theme);
+}
+
+/**
+ * WebviewClearCache clears the browsing data of the webview, the promise resolves once the data has been cleared.
+ * The kinds are combined with |: 1 cookies, 2 disk cache, 4 DOM storage, 8 IndexedDB, 16 other data, 31 all.
+ * The optional start and end restrict the data to the data modified in this time range. Not supported on macOS.
+ *
+ * @export
+ * @param {number} kinds
+ * @param {Date} [start]
+ * @param {Date} [end]
+ * @return {Promise<boolean>}
+ */
+export function WebviewClearCache(kinds, start, end) {
+    return window.runtime.WebviewClearCache(kinds, start, end);
+}
+
+/**
+ * WebviewPrintToPDFData prints the page to a PDF without showing the print dialog, the promise resolves with the
+ * content of the PDF in base64. Not supported on macOS.
+ *
+ * @export
+ * @param {{orientation?: "portrait"|"landscape", scaleFactor?: number, pageWidth?: number, pageHeight?: number, margins?: {top: number, bottom: number, left: number, right: number}, printBackgrounds?: boolean, headerAndFooter?: boolean, headerTitle?: string, footerURI?: string}} [settings]
+ * @return {Promise<string>}
+ */
+export function WebviewPrintToPDFData(settings) {
+    return window.runtime.WebviewPrintToPDFData(settings);
+}
+
+/**
+ * WebviewCapturePreview captures the visible content of the webview, the promise resolves with the image in base64.
+ *
+ * @export
+ * @param {"png"|"jpeg"} [format] - Defaults to "png"
+ * @return {Promise<string>}
+ */
+export function WebviewCapturePreview(format) {
+    return window.runtime.WebviewCapturePreview(format);
+}
+
+/**
+ * WindowCapture captures the whole window including its frame, the promise resolves with the image in base64.
+ * Windows only.
+ *
+ * @export
+ * @param {"png"|"jpeg"} [format] - Defaults to "png"
+ * @return {Promise<string>}
+ */
+export function WindowCapture(format) {
+    return window.runtime.WindowCapture(format);
+}
+
+/**
+ * WindowSetSkipTaskbar hides the window from the taskbar and Alt+Tab. Not supported on macOS.
+ *
+ * @export
+ * @param {boolean} skip
+ * @return {Promise<boolean>}
+ */
+export function WindowSetSkipTaskbar(skip) {
+    return window.runtime.WindowSetSkipTaskbar(skip);
+}
+
 export function WindowSetSystemDefaultTheme() {
     window.runtime.WindowSetSystemDefaultTheme();
 }
@@ -97,10 +256,91 @@ export function WindowUnfullscreen() {
     window.runtime.WindowUnfullscreen();
 }
 
+/**
+ * WindowSetBorderlessFullscreen makes the window cover the screen at the index of ScreenGetAll, without any window
+ * chrome and above the taskbar. It is left with WindowUnfullscreen.
+ *
+ * @export
+ * @param {number} screen
+ * @return {Promise<boolean>}
+ */
+export function WindowSetBorderlessFullscreen(screen) {
+    return window.runtime.WindowSetBorderlessFullscreen(screen);
+}
+
 export function WindowIsFullscreen() {
     return window.runtime.WindowIsFullscreen();
 }
 
+/**
+ * WindowSetBackdropType changes the translucent backdrop of the window: 0 Auto, 1 None, 2 Mica, 3 Acrylic or 4 Tabbed.
+ * Windows only. Requires Windows 11 22621 or later and WindowIsTranslucent to be enabled, the promise is rejected
+ * otherwise.
+ *
+ * @export
+ * @param {number} backdrop
+ * @return {Promise<boolean>}
+ */
+export function WindowSetBackdropType(backdrop) {
+    return window.runtime.WindowSetBackdropType(backdrop);
+}
+
+/**
+ * WindowSetZoomFactor sets the zoom factor of the webview, clamped to 0.25-5.0. Windows only. Requires
+ * IsZoomControlEnabled to be enabled, the promise is rejected otherwise.
+ *
+ * @export
+ * @param {number} factor
+ * @return {Promise<boolean>}
+ */
+export function WindowSetZoomFactor(factor) {
+    return window.runtime.WindowSetZoomFactor(factor);
+}
+
+/**
+ * WindowGetZoomFactor returns the zoom factor of the webview. It is 1 on the platforms other than Windows.
+ *
+ * @export
+ * @return {Promise<number>}
+ */
+export function WindowGetZoomFactor() {
+    return window.runtime.WindowGetZoomFactor();
+}
+
+/**
+ * WindowGetDPI returns the DPI of the window, 96 is a scale of 100%. Windows only.
+ *
+ * @export
+ * @return {Promise<number>}
+ */
+export function WindowGetDPI() {
+    return window.runtime.WindowGetDPI();
+}
+
+/**
+ * WindowSetTaskbarProgress shows the progress of a long running operation on the taskbar button of the window. The
+ * state is "normal", "indeterminate", "error", "paused" or "none" to hide the progress. Windows only.
+ *
+ * @export
+ * @param {string} state
+ * @param {number} completed
+ * @param {number} total
+ * @return {Promise<boolean>}
+ */
+export function WindowSetTaskbarProgress(state, completed, total) {
+    return window.runtime.WindowSetTaskbarProgress(state, completed, total);
+}
+
+/**
+ * WindowSetSizeToContent resizes the window to the rendered size of the page, clamped to the work area of its monitor.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WindowSetSizeToContent() {
+    return window.runtime.WindowSetSizeToContent();
+}
+
 export function WindowGetSize() {
     return window.runtime.WindowGetSize();
 }
@@ -133,6 +373,108 @@ export function WindowShow() {
     window.runtime.WindowShow();
 }
 
+/**
+ * WindowFocus brings the window to the foreground and gives it the keyboard focus
+ *
+ * @export
+ * @return {Promise<void>}
+ */
+export function WindowFocus() {
+    return window.runtime.WindowFocus();
+}
+
+/**
+ * WindowSetContentProtection excludes the window from screenshots, screen recording and screen sharing. Windows only.
+ * The promise is rejected on the Windows 10 versions before 2004, which show the window black in the captures instead.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetContentProtection(enabled) {
+    return window.runtime.WindowSetContentProtection(enabled);
+}
+
+/**
+ * WindowGetState returns the position, the size and the maximised state of the window, which can be saved to restore
+ * the window with WindowSetState.
+ *
+ * @export
+ * @return {Promise<{x: number, y: number, width: number, height: number, maximised: boolean, screen?: string}>}
+ */
+export function WindowGetState() {
+    return window.runtime.WindowGetState();
+}
+
+/**
+ * WindowSetState restores the position, the size and the maximised state of the window returned by WindowGetState.
+ *
+ * @export
+ * @param {{x: number, y: number, width: number, height: number, maximised: boolean, screen?: string}} state
+ * @return {Promise<boolean>}
+ */
+export function WindowSetState(state) {
+    return window.runtime.WindowSetState(state);
+}
+
+/**
+ * WindowSetIgnoreMouseEvents lets the mouse events fall through the window. If forward is true the mouse moves are
+ * still dispatched to the page as mousemove events. Windows only.
+ *
+ * @export
+ * @param {boolean} ignore
+ * @param {boolean} forward
+ * @return {Promise<boolean>}
+ */
+export function WindowSetIgnoreMouseEvents(ignore, forward) {
+    return window.runtime.WindowSetIgnoreMouseEvents(ignore, forward);
+}
+
+/**
+ * WindowSetMinimiseButtonEnabled enables or disables the minimise button of the window. Windows only.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetMinimiseButtonEnabled(enabled) {
+    return window.runtime.WindowSetMinimiseButtonEnabled(enabled);
+}
+
+/**
+ * WindowSetMaximiseButtonEnabled enables or disables the maximise button of the window. Windows only.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetMaximiseButtonEnabled(enabled) {
+    return window.runtime.WindowSetMaximiseButtonEnabled(enabled);
+}
+
+/**
+ * WindowSetCloseButtonEnabled enables or disables the close button of the window. The close item of the window menu and Alt+F4 are disabled too. Windows only.
+ *
+ * @export
+ * @param {boolean} enabled
+ * @return {Promise<boolean>}
+ */
+export function WindowSetCloseButtonEnabled(enabled) {
+    return window.runtime.WindowSetCloseButtonEnabled(enabled);
+}
+
+/**
+ * WindowFlash flashes the taskbar button of the window, once or until the window is activated if untilFocused is
+ * true. Windows only.
+ *
+ * @export
+ * @param {boolean} untilFocused
+ * @return {Promise<boolean>}
+ */
+export function WindowFlash(untilFocused) {
+    return window.runtime.WindowFlash(untilFocused);
+}
+
 export function WindowMaximise() {
     window.runtime.WindowMaximise();
 }
@@ -235,4 +577,328 @@ export function CanResolveFilePaths() {
 
 export function ResolveFilePaths(files) {
     return window.runtime.ResolveFilePaths(files);
-}
\ No newline at end of file
+}
+/**
+ * GetAppInfo returns the name, version, build date, git commit and build type of the application.
+ *
+ * @export
+ * @return {Promise<{name: string, version: string, buildDate: string, gitCommit: string, buildType: string}>}
+ */
+export function GetAppInfo() {
+    return window.runtime.GetAppInfo();
+}
+
+/**
+ * GetLocaleInfo returns the regional settings of the OS, like the number separators and date formats.
+ *
+ * @export
+ * @return {Promise<object>}
+ */
+export function GetLocaleInfo() {
+    return window.runtime.GetLocaleInfo();
+}
+
+/**
+ * PerformHaptic performs a haptic feedback pattern on the Force Touch trackpad. Only supported on macOS.
+ *
+ * @export
+ * @param {"generic"|"alignment"|"levelChange"} pattern
+ * @return {Promise<boolean>}
+ */
+export function PerformHaptic(pattern) {
+    return window.runtime.PerformHaptic(pattern);
+}
+
+/**
+ * OnProgress registers a listener for the progress of the tasks started with `runtime.WithProgress` in Go.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({id: string, value: number, done: boolean, error?: string})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnProgress(callback) {
+    return window.runtime.OnProgress(callback);
+}
+
+/**
+ * CancelProgress cancels the task with the given id, which has been started with `runtime.WithProgress` in Go.
+ * Resolves to false if no such task is running.
+ *
+ * @export
+ * @param {string} id
+ * @return {Promise<boolean>}
+ */
+export function CancelProgress(id) {
+    return window.runtime.CancelProgress(id);
+}
+
+/**
+ * WindowSetCursor overrides the cursor of the window and the page, which also applies while the window is dragged.
+ * An empty cursor removes the override.
+ *
+ * @export
+ * @param {string} cursor - A CSS cursor name, EG "crosshair"
+ * @return {Promise<boolean>}
+ */
+export function WindowSetCursor(cursor) {
+    return window.runtime.WindowSetCursor(cursor);
+}
+
+/**
+ * FindInPage selects the next match of the text in the page. The number of matches is reported to the listeners
+ * registered with OnFindResult.
+ *
+ * @export
+ * @param {string} text
+ * @param {{caseSensitive?: boolean, backwards?: boolean}} [options]
+ * @return {Promise<boolean>}
+ */
+export function FindInPage(text, options) {
+    return window.runtime.FindInPage(text, options);
+}
+
+/**
+ * StopFind clears the matches of FindInPage.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function StopFind() {
+    return window.runtime.StopFind();
+}
+
+/**
+ * OnFindResult registers a listener for the results of FindInPage.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({text: string, matches: number})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnFindResult(callback) {
+    return window.runtime.OnFindResult(callback);
+}
+
+/**
+ * MenuGetStructure returns the tree of the visible items of the application menu.
+ *
+ * @export
+ * @return {Promise<Array<{id: string, label: string, type: string, role?: string, accelerator?: string, disabled: boolean, checked: boolean, children?: Array}>>}
+ */
+export function MenuGetStructure() {
+    return window.runtime.MenuGetStructure();
+}
+
+/**
+ * MenuTrigger invokes the item of the application menu with the id of a node returned by MenuGetStructure, as if
+ * it had been clicked.
+ *
+ * @export
+ * @param {string} id
+ * @return {Promise<boolean>}
+ */
+export function MenuTrigger(id) {
+    return window.runtime.MenuTrigger(id);
+}
+
+/**
+ * OnMenuChange registers a listener for changes of the application menu, which is called with the new structure.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function(Array)} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnMenuChange(callback) {
+    return window.runtime.OnMenuChange(callback);
+}
+
+/**
+ * ShowShareMenu shows the native share menu with the items. On macOS the menu is shown at the anchor, which can be
+ * the bounding rect of an element.
+ *
+ * @export
+ * @param {{title?: string, text?: string, urls?: string[], files?: string[]}} items
+ * @param {{x: number, y: number, width: number, height: number}} [anchor]
+ * @return {Promise<boolean>}
+ */
+export function ShowShareMenu(items, anchor) {
+    return window.runtime.ShowShareMenu(items, anchor);
+}
+
+/**
+ * WasLaunchedAtLogin returns true if the application has been launched at login by the registration of
+ * SetLaunchAtLogin, e.g. to stay in the tray rather than showing the window.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function WasLaunchedAtLogin() {
+    return window.runtime.WasLaunchedAtLogin();
+}
+
+/**
+ * GetNetworkStatus returns the network status reported by the OS, which is reliable unlike navigator.onLine.
+ *
+ * @export
+ * @return {Promise<{online: boolean, connectionType: string}>}
+ */
+export function GetNetworkStatus() {
+    return window.runtime.GetNetworkStatus();
+}
+
+/**
+ * OnNetworkChange registers a listener for changes of the network status reported by the OS.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({online: boolean, connectionType: string})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnNetworkChange(callback) {
+    return window.runtime.OnNetworkChange(callback);
+}
+
+/**
+ * IsRemoteSession returns true if the application runs in a remote desktop session.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function IsRemoteSession() {
+    return window.runtime.IsRemoteSession();
+}
+
+/**
+ * OnSessionChange registers a listener for the locks, unlocks, disconnections and reconnections of the session of
+ * the user. It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function("lock"|"unlock"|"disconnect"|"reconnect")} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnSessionChange(callback) {
+    return window.runtime.OnSessionChange(callback);
+}
+
+/**
+ * SystemPrefersReducedMotion returns true if the user has asked the OS for less animations.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function SystemPrefersReducedMotion() {
+    return window.runtime.SystemPrefersReducedMotion();
+}
+
+/**
+ * SystemHighContrastActive returns true if a high contrast theme of the OS is active.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function SystemHighContrastActive() {
+    return window.runtime.SystemHighContrastActive();
+}
+
+/**
+ * OnAccessibilityChange registers a listener for changes of the reduced motion and the high contrast settings of the
+ * OS. It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({reducedMotion: boolean, highContrast: boolean})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnAccessibilityChange(callback) {
+    return window.runtime.OnAccessibilityChange(callback);
+}
+
+/**
+ * LaunchData returns the URLs and the files the application has been asked to open, including the ones it was
+ * launched with. It is available synchronously when the frontend boots, the data isn't delivered again when the page
+ * is reloaded.
+ *
+ * @export
+ * @return {{urls: string[], files: string[]}}
+ */
+export function LaunchData() {
+    return window.runtime.LaunchData();
+}
+
+/**
+ * OnLaunch registers a listener for the URLs and the files the application is asked to open while it runs. It returns
+ * a function to cancel the listener.
+ *
+ * @export
+ * @param {function({urls: string[], files: string[]})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnLaunch(callback) {
+    return window.runtime.OnLaunch(callback);
+}
+
+/**
+ * IMEIsComposing returns true while an input method composition is in progress, EG while Japanese or Chinese text
+ * is being typed.
+ *
+ * @export
+ * @return {Promise<boolean>}
+ */
+export function IMEIsComposing() {
+    return window.runtime.IMEIsComposing();
+}
+
+/**
+ * OnIMEComposition registers a listener for the start, the updates and the end of input method compositions.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function({phase: string, text: string})} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnIMEComposition(callback) {
+    return window.runtime.OnIMEComposition(callback);
+}
+
+/**
+ * OnNavigationProgress registers a listener for the estimated progress of the page loads, between 0 and 1.
+ * It returns a function to cancel the listener.
+ *
+ * @export
+ * @param {function(number)} callback
+ * @return {function} - A function to cancel the listener
+ */
+export function OnNavigationProgress(callback) {
+    return window.runtime.OnNavigationProgress(callback);
+}
+
+/**
+ * systemCall calls a system method of the backend and returns a promise with the result
+ *
+ * @export
+ * @param {string} name - The name of the system method
+ * @param {any[]} [args] - The arguments of the method
+ * @return {Promise<any>}
+ */
+export function systemCall(name, args) {
+    return window.runtime.systemCall(name, args);
+}
+
+/**
+ * RPCCall calls the Go handler registered with runtime.RegisterRPCHandler for the given method.
+ * The returned promise is resolved with the result of the handler or rejected with its error.
+ *
+ * @export
+ * @param {string} method - The name of the registered method
+ * @param {any} [params] - The params passed to the handler as JSON
+ * @param {{signal?: AbortSignal, timeout?: number}} [options] - An AbortSignal to cancel the call and a timeout in milliseconds
+ * @return {Promise<any>}
+ */
+export function RPCCall(method, params, options) {
+    return window.runtime.RPCCall(method, params, options);
+}
+
+export const rpc = {
+    call: RPCCall,
+};
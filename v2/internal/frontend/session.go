@@ -0,0 +1,27 @@
+package frontend
+
+import "context"
+
+// SessionChangeEvent is emitted with the SessionChange when the session of the user is locked, unlocked,
+// disconnected or reconnected
+const SessionChangeEvent = "wails:session:change"
+
+// SessionChange is a change of the state of the session of the user
+type SessionChange string
+
+const (
+	SessionLock   SessionChange = "lock"
+	SessionUnlock SessionChange = "unlock"
+	// SessionDisconnect is emitted when the session is no longer attached to the console or to a remote connection,
+	// EG when the user switches to another session or a remote desktop connection is closed
+	SessionDisconnect SessionChange = "disconnect"
+	// SessionReconnect is emitted when a disconnected session is attached again
+	SessionReconnect SessionChange = "reconnect"
+)
+
+// SessionChanged emits the SessionChangeEvent
+func SessionChanged(ctx context.Context, change SessionChange) {
+	if events, ok := ctx.Value("events").(Events); ok {
+		events.Emit(SessionChangeEvent, change)
+	}
+}
@@ -0,0 +1,28 @@
+package frontend
+
+// ShareItems is the content offered by the share menu
+type ShareItems struct {
+	// Title describes the content. It is required by Windows and defaults to the title of the application.
+	Title string   `json:"title"`
+	Text  string   `json:"text"`
+	URLs  []string `json:"urls"`
+	// Files are absolute paths, they can't be shared on Windows
+	Files []string `json:"files"`
+}
+
+// IsEmpty returns true if there is nothing to share
+func (s ShareItems) IsEmpty() bool {
+	return s.Text == "" && len(s.URLs) == 0 && len(s.Files) == 0
+}
+
+// ServiceInvokeEvent is emitted with a ServiceInvocation when a service provided by the application is invoked from
+// the macOS Services menu
+const ServiceInvokeEvent = "wails:service:invoke"
+
+// ServiceInvocation describes the invocation of a service provided by the application
+type ServiceInvocation struct {
+	// Name is the NSUserData of the service in the NSServices entry of the Info.plist
+	Name  string   `json:"name"`
+	Text  string   `json:"text"`
+	Files []string `json:"files"`
+}
@@ -0,0 +1,34 @@
+package frontend
+
+// ProgressState is the state of the progress shown on the taskbar button of the window
+type ProgressState string
+
+const (
+	// ProgressStateNone hides the progress
+	ProgressStateNone ProgressState = "none"
+	// ProgressStateIndeterminate shows a progress without a value
+	ProgressStateIndeterminate ProgressState = "indeterminate"
+	// ProgressStateNormal shows the progress in green
+	ProgressStateNormal ProgressState = "normal"
+	// ProgressStateError shows the progress in red
+	ProgressStateError ProgressState = "error"
+	// ProgressStatePaused shows the progress in yellow
+	ProgressStatePaused ProgressState = "paused"
+)
+
+// MaxThumbbarButtons is the maximum number of buttons of the thumbnail toolbar
+const MaxThumbbarButtons = 7
+
+// ThumbbarButton is a button of the toolbar shown in the thumbnail of the taskbar button of the window
+type ThumbbarButton struct {
+	// Icon is a PNG image or an ICO file, it is shown at the size of the small icons
+	Icon []byte
+	// Tooltip is shown when the mouse is over the button
+	Tooltip string
+	// Disabled shows the button greyed out
+	Disabled bool
+	// DismissOnClick closes the thumbnail when the button is clicked
+	DismissOnClick bool
+	// OnClick is called on the main thread when the button is clicked
+	OnClick func()
+}
@@ -0,0 +1,38 @@
+package frontend
+
+import "strconv"
+
+// TextSelectionScript blocks the text selection and the clipboard events of the page. The webviews add it before the
+// scripts of the page are run, and the listeners are registered on the window in the capture phase, so the page
+// can't stop them. The text of editable elements can still be selected.
+func TextSelectionScript(disableSelection bool, disableCopy bool) string {
+	return `(function() {
+	var disableSelection = ` + strconv.FormatBool(disableSelection) + `;
+	var disableCopy = ` + strconv.FormatBool(disableCopy) + `;
+	function isEditable(node) {
+		var element = node && node.nodeType === 1 ? node : node && node.parentElement;
+		return !!element && (element.isContentEditable || element.tagName === "INPUT" || element.tagName === "TEXTAREA");
+	}
+	function block(event) {
+		event.preventDefault();
+		event.stopImmediatePropagation();
+	}
+	if (disableSelection) {
+		window.addEventListener("selectstart", function(event) {
+			if (!isEditable(event.target)) {
+				block(event);
+			}
+		}, true);
+		document.addEventListener("selectionchange", function() {
+			var selection = document.getSelection();
+			if (selection && selection.rangeCount > 0 && !selection.isCollapsed && !isEditable(selection.anchorNode)) {
+				selection.removeAllRanges();
+			}
+		}, true);
+	}
+	if (disableCopy) {
+		window.addEventListener("copy", block, true);
+		window.addEventListener("cut", block, true);
+	}
+})();`
+}
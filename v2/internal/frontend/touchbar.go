@@ -0,0 +1,33 @@
+package frontend
+
+// TouchBarItemType is the type of a TouchBarItem
+type TouchBarItemType string
+
+const (
+	TouchBarButton   TouchBarItemType = "button"
+	TouchBarSlider   TouchBarItemType = "slider"
+	TouchBarScrubber TouchBarItemType = "scrubber"
+)
+
+// TouchBarItem is an item of the macOS Touch Bar. The callbacks are called on a new goroutine.
+type TouchBarItem struct {
+	Type TouchBarItemType `json:"type"`
+	// Label is the title of a button or the label shown next to a slider
+	Label string `json:"label"`
+
+	// Value, Min and Max configure a slider
+	Value float64 `json:"value"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+
+	// Options are the entries of a scrubber, Selected is the index of the selected entry or nil for none
+	Options  []string `json:"options"`
+	Selected *int     `json:"selected"`
+
+	// OnClick is called when a button is tapped
+	OnClick func() `json:"-"`
+	// OnChange is called with the value of a slider when it is moved
+	OnChange func(value float64) `json:"-"`
+	// OnSelect is called with the index of the entry of a scrubber when it is selected
+	OnSelect func(index int) `json:"-"`
+}
@@ -0,0 +1,73 @@
+package frontend
+
+// WindowState is the position, the size and the maximised state of the window. It can be saved as JSON and restored
+// when the application is started again.
+type WindowState struct {
+	// X, Y, Width and Height are the bounds of the window when it isn't maximised. On Windows they are in physical
+	// pixels in the coordinates of the virtual screen.
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+
+	Maximised bool `json:"maximised"`
+
+	// Screen identifies the screen of the window, EG "\\.\DISPLAY2" on Windows
+	Screen string `json:"screen,omitempty"`
+}
+
+// WorkArea is the area of a screen which isn't covered by the taskbar or the docks, in the coordinates of WindowState
+type WorkArea struct {
+	Screen    string
+	IsPrimary bool
+
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+// minimumVisibleWidth and minimumVisibleHeight are the size of the top of the window which must be on a work area for the window to be
+// reachable, so it can be dragged by its title bar
+const (
+	minimumVisibleWidth  = 64
+	minimumVisibleHeight = 32
+)
+
+// FitWindowState returns the state moved onto the work areas. A window whose title bar isn't on any of the work areas,
+// EG because it was saved on a screen which has been disconnected, is centered on the work area of its screen, or of
+// the primary screen. The size is limited to the size of the work area.
+func FitWindowState(state WindowState, areas []WorkArea) WindowState {
+	if len(areas) == 0 {
+		return state
+	}
+	for _, area := range areas {
+		visibleWidth := min(state.X+state.Width, area.X+area.Width) - max(state.X, area.X)
+		visibleHeight := min(state.Y+minimumVisibleHeight, area.Y+area.Height) - max(state.Y, area.Y)
+		if visibleWidth >= min(minimumVisibleWidth, state.Width) && visibleHeight >= minimumVisibleHeight {
+			state.Width = min(state.Width, area.Width)
+			state.Height = min(state.Height, area.Height)
+			return state
+		}
+	}
+
+	target := areas[0]
+	for _, area := range areas {
+		if area.IsPrimary {
+			target = area
+			break
+		}
+	}
+	for _, area := range areas {
+		if state.Screen != "" && area.Screen == state.Screen {
+			target = area
+			break
+		}
+	}
+	state.Width = min(state.Width, target.Width)
+	state.Height = min(state.Height, target.Height)
+	state.X = target.X + (target.Width-state.Width)/2
+	state.Y = target.Y + (target.Height-state.Height)/2
+	state.Screen = target.Screen
+	return state
+}
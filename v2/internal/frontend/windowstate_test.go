@@ -0,0 +1,111 @@
+package frontend
+
+import "testing"
+
+func TestFitWindowState(t *testing.T) {
+	primary := WorkArea{Screen: "primary", IsPrimary: true, X: 0, Y: 0, Width: 1920, Height: 1040}
+	secondary := WorkArea{Screen: "secondary", X: 1920, Y: 0, Width: 1280, Height: 984}
+	tests := []struct {
+		name  string
+		state WindowState
+		areas []WorkArea
+		want  WindowState
+	}{
+		{
+			name:  "no work areas",
+			state: WindowState{X: -5000, Y: -5000, Width: 800, Height: 600},
+			want:  WindowState{X: -5000, Y: -5000, Width: 800, Height: 600},
+		},
+		{
+			name:  "on a work area",
+			state: WindowState{X: 100, Y: 100, Width: 800, Height: 600, Screen: "primary"},
+			areas: []WorkArea{primary, secondary},
+			want:  WindowState{X: 100, Y: 100, Width: 800, Height: 600, Screen: "primary"},
+		},
+		{
+			name:  "on the secondary screen",
+			state: WindowState{X: 2000, Y: 50, Width: 800, Height: 600, Screen: "secondary"},
+			areas: []WorkArea{primary, secondary},
+			want:  WindowState{X: 2000, Y: 50, Width: 800, Height: 600, Screen: "secondary"},
+		},
+		{
+			name:  "disconnected screen falls back to the primary screen",
+			state: WindowState{X: 3500, Y: 100, Width: 800, Height: 600, Screen: "secondary"},
+			areas: []WorkArea{primary},
+			want:  WindowState{X: 560, Y: 220, Width: 800, Height: 600, Screen: "primary"},
+		},
+		{
+			name:  "primary fallback without a saved screen",
+			state: WindowState{X: -2000, Y: 100, Width: 800, Height: 600},
+			areas: []WorkArea{secondary, primary},
+			want:  WindowState{X: 560, Y: 220, Width: 800, Height: 600, Screen: "primary"},
+		},
+		{
+			name:  "first work area without a primary screen",
+			state: WindowState{X: -2000, Y: 100, Width: 800, Height: 600},
+			areas: []WorkArea{secondary},
+			want:  WindowState{X: 2160, Y: 192, Width: 800, Height: 600, Screen: "secondary"},
+		},
+		{
+			name:  "off-screen window is centered on its screen",
+			state: WindowState{X: 5000, Y: 5000, Width: 800, Height: 600, Screen: "secondary"},
+			areas: []WorkArea{primary, secondary},
+			want:  WindowState{X: 2160, Y: 192, Width: 800, Height: 600, Screen: "secondary"},
+		},
+		{
+			name:  "larger than the work area",
+			state: WindowState{X: 0, Y: 0, Width: 2560, Height: 1440, Screen: "primary"},
+			areas: []WorkArea{primary},
+			want:  WindowState{X: 0, Y: 0, Width: 1920, Height: 1040, Screen: "primary"},
+		},
+		{
+			name:  "larger than the work area and off-screen",
+			state: WindowState{X: 5000, Y: 0, Width: 2560, Height: 1440},
+			areas: []WorkArea{secondary},
+			want:  WindowState{X: 1920, Y: 0, Width: 1280, Height: 984, Screen: "secondary"},
+		},
+		{
+			name:  "title bar partly off the left of the screen",
+			state: WindowState{X: -700, Y: 100, Width: 800, Height: 600, Screen: "primary"},
+			areas: []WorkArea{primary},
+			want:  WindowState{X: -700, Y: 100, Width: 800, Height: 600, Screen: "primary"},
+		},
+		{
+			name:  "title bar too little on the screen",
+			state: WindowState{X: -760, Y: 100, Width: 800, Height: 600, Screen: "primary"},
+			areas: []WorkArea{primary},
+			want:  WindowState{X: 560, Y: 220, Width: 800, Height: 600, Screen: "primary"},
+		},
+		{
+			name:  "title bar partly above the screen",
+			state: WindowState{X: 100, Y: -10, Width: 800, Height: 600, Screen: "primary"},
+			areas: []WorkArea{primary},
+			want:  WindowState{X: 560, Y: 220, Width: 800, Height: 600, Screen: "primary"},
+		},
+		{
+			name:  "title bar at the bottom of the screen",
+			state: WindowState{X: 100, Y: 1008, Width: 800, Height: 600, Screen: "primary"},
+			areas: []WorkArea{primary},
+			want:  WindowState{X: 100, Y: 1008, Width: 800, Height: 600, Screen: "primary"},
+		},
+		{
+			name:  "title bar partly below the screen",
+			state: WindowState{X: 100, Y: 1020, Width: 800, Height: 600, Screen: "primary"},
+			areas: []WorkArea{primary},
+			want:  WindowState{X: 560, Y: 220, Width: 800, Height: 600, Screen: "primary"},
+		},
+		{
+			name:  "title bar spanning two screens",
+			state: WindowState{X: 1900, Y: 100, Width: 800, Height: 600, Screen: "primary"},
+			areas: []WorkArea{primary, secondary},
+			want:  WindowState{X: 1900, Y: 100, Width: 800, Height: 600, Screen: "primary"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FitWindowState(tt.state, tt.areas); got != tt.want {
+				t.Errorf("FitWindowState() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -400,3 +400,31 @@ func FindFileInParents(path string, filename string) string {
 	}
 	return pathToFile
 }
+
+// CanonicalPath returns the absolute and cleaned path with all symlinks resolved, EG "/var/folders" becomes
+// "/private/var/folders" on macOS. If the path doesn't exist, the longest existing parent is resolved and the
+// remaining elements are appended, so this also works for files which are about to be created.
+func CanonicalPath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	resolved, err := filepath.EvalSymlinks(absPath)
+	if err == nil {
+		return resolved, nil
+	}
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	parent := filepath.Dir(absPath)
+	if parent == absPath {
+		return absPath, nil
+	}
+	resolvedParent, err := CanonicalPath(parent)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(resolvedParent, filepath.Base(absPath)), nil
+}
@@ -88,3 +88,27 @@ func Test_FindFileInParents(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalPath(t *testing.T) {
+	i := is.New(t)
+
+	tempDir, err := filepath.EvalSymlinks(t.TempDir())
+	i.NoErr(err)
+
+	target := filepath.Join(tempDir, "target")
+	i.NoErr(os.Mkdir(target, 0755))
+	link := filepath.Join(tempDir, "link")
+	i.NoErr(os.Symlink(target, link))
+
+	got, err := CanonicalPath(link)
+	i.NoErr(err)
+	i.Equal(got, target)
+
+	got, err = CanonicalPath(filepath.Join(link, "..", "link", "file.txt"))
+	i.NoErr(err)
+	i.Equal(got, filepath.Join(target, "file.txt"))
+
+	got, err = CanonicalPath(filepath.Join(link, "missing", "file.txt"))
+	i.NoErr(err)
+	i.Equal(got, filepath.Join(target, "missing", "file.txt"))
+}
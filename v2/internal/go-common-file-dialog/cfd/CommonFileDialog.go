@@ -32,6 +32,8 @@ type Dialog interface {
 	// Sets the file name, I.E. the contents of the file name text box.
 	// For Select Folder Dialog, sets folder name.
 	SetFileName(fileName string) error
+	// Sets whether hidden and system files are shown, regardless of the Explorer settings of the user.
+	SetShowHiddenFiles(showHiddenFiles bool) error
 	// Release the resources allocated to this Dialog.
 	// Should be called when the dialog is finished with.
 	Release() error
@@ -48,6 +48,8 @@ type DialogConfig struct {
 	// For Save File Dialog, this extension will be used whenever a user does not specify an extension.
 	// Ignored by Select Folder Dialog.
 	DefaultExtension string
+	// Shows hidden and system files, regardless of the Explorer settings of the user.
+	ShowHiddenFiles bool
 	// ParentWindowHandle is the handle (HWND) to the parent window of the dialog.
 	// If left as 0 / nil, the dialog will have no parent window.
 	ParentWindowHandle uintptr
@@ -104,6 +106,13 @@ func (config *DialogConfig) apply(dialog Dialog) (err error) {
 		}
 	}
 
+	if config.ShowHiddenFiles {
+		err = dialog.SetShowHiddenFiles(true)
+		if err != nil {
+			return
+		}
+	}
+
 	dialog.SetParentWindowHandle(config.ParentWindowHandle)
 
 	if dialog, ok := dialog.(FileDialog); ok {
@@ -131,6 +131,10 @@ func (fileOpenDialog *iFileOpenDialog) SetFileName(initialFileName string) error
 	return fileOpenDialog.vtbl.setFileName(unsafe.Pointer(fileOpenDialog), initialFileName)
 }
 
+func (fileOpenDialog *iFileOpenDialog) SetShowHiddenFiles(showHiddenFiles bool) error {
+	return fileOpenDialog.vtbl.setShowHiddenFiles(unsafe.Pointer(fileOpenDialog), showHiddenFiles)
+}
+
 func (fileOpenDialog *iFileOpenDialog) SetSelectedFileFilterIndex(index uint) error {
 	return fileOpenDialog.vtbl.setSelectedFileFilterIndex(unsafe.Pointer(fileOpenDialog), index)
 }
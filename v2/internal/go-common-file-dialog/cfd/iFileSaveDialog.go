@@ -87,6 +87,10 @@ func (fileSaveDialog *iFileSaveDialog) SetFileName(initialFileName string) error
 	return fileSaveDialog.vtbl.setFileName(unsafe.Pointer(fileSaveDialog), initialFileName)
 }
 
+func (fileSaveDialog *iFileSaveDialog) SetShowHiddenFiles(showHiddenFiles bool) error {
+	return fileSaveDialog.vtbl.setShowHiddenFiles(unsafe.Pointer(fileSaveDialog), showHiddenFiles)
+}
+
 func (fileSaveDialog *iFileSaveDialog) SetSelectedFileFilterIndex(index uint) error {
 	return fileSaveDialog.vtbl.setSelectedFileFilterIndex(unsafe.Pointer(fileSaveDialog), index)
 }
@@ -115,6 +115,16 @@ func (vtbl *iFileDialogVtbl) removeOption(objPtr unsafe.Pointer, option uint32)
 	}
 }
 
+const FosForceShowHidden = 0x10000000
+
+func (vtbl *iFileDialogVtbl) setShowHiddenFiles(objPtr unsafe.Pointer, showHiddenFiles bool) error {
+	if showHiddenFiles {
+		return vtbl.addOption(objPtr, FosForceShowHidden)
+	} else {
+		return vtbl.removeOption(objPtr, FosForceShowHidden)
+	}
+}
+
 func (vtbl *iFileDialogVtbl) setDefaultFolder(objPtr unsafe.Pointer, path string) error {
 	shellItem, err := newIShellItem(path)
 	if err != nil {
@@ -0,0 +1,36 @@
+//go:build windows
+
+// Package com calls the methods of COM interface pointers by their index in the vtable
+package com
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/go-ole/go-ole"
+)
+
+// The vtable indexes of the methods of IUnknown
+const (
+	MethodQueryInterface = 0
+	MethodRelease        = 2
+)
+
+// Object is a COM interface pointer. The array only bounds the indexes, the vtables are usually smaller.
+type Object struct {
+	vtbl *[128]uintptr
+}
+
+// Call calls the method at the given index of the vtable and returns the HRESULT as an error if it failed
+func (o *Object) Call(method int, args ...uintptr) error {
+	hr, _, _ := syscall.SyscallN(o.vtbl[method], append([]uintptr{uintptr(unsafe.Pointer(o))}, args...)...)
+	if int32(hr) < 0 {
+		return ole.NewError(hr)
+	}
+	return nil
+}
+
+// Release releases the interface pointer
+func (o *Object) Release() {
+	_, _, _ = syscall.SyscallN(o.vtbl[MethodRelease], uintptr(unsafe.Pointer(o)))
+}
@@ -94,6 +94,9 @@ type Project struct {
 	FrontendDir string `json:"frontend:dir"`
 
 	Bindings Bindings `json:"bindings"`
+
+	// Sidecars are executables placed next to the compiled binary by the build, EG in Contents/MacOS on macOS
+	Sidecars []Sidecar `json:"sidecars"`
 }
 
 func (p *Project) GetFrontendDir() string {
@@ -237,6 +240,15 @@ type Protocol struct {
 	Role        string `json:"role"`
 }
 
+// Sidecar is an executable shipped with the application
+type Sidecar struct {
+	// Name is passed to runtime.GetSidecarPath, the base name of the path is used if empty
+	Name string `json:"name"`
+	// Path is the path of the executable, relative to the project directory. The build uses the first existing file
+	// of "path-GOOS-GOARCH", "path-GOOS" and "path", with the ".exe" extension when building for Windows.
+	Path string `json:"path"`
+}
+
 type Bindings struct {
 	TsGeneration TsGeneration `json:"ts_generation"`
 }
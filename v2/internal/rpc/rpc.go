@@ -0,0 +1,77 @@
+// Package rpc holds the handlers for JSON-RPC style calls from the frontend. Other than the bindings, the available
+// methods are registered at runtime and don't have to be known at compile time.
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// Handler is called with the params of an RPC call. The context is cancelled when the frontend cancels the call.
+type Handler func(ctx context.Context, params json.RawMessage) (any, error)
+
+var (
+	lock     sync.RWMutex
+	handlers = map[string]Handler{}
+
+	pendingLock sync.Mutex
+	pending     = map[string]context.CancelFunc{}
+)
+
+// Register registers the handler for the given method. An already registered handler for the method is replaced.
+func Register(method string, handler Handler) {
+	lock.Lock()
+	defer lock.Unlock()
+	if handler == nil {
+		delete(handlers, method)
+		return
+	}
+	handlers[method] = handler
+}
+
+// Unregister removes the handler for the given method
+func Unregister(method string) {
+	Register(method, nil)
+}
+
+// Call calls the handler of the given method. The call can be cancelled with Cancel using the given id.
+func Call(ctx context.Context, id string, method string, params json.RawMessage) (any, error) {
+	lock.RLock()
+	handler := handlers[method]
+	lock.RUnlock()
+
+	if handler == nil {
+		return nil, fmt.Errorf("rpc method '%s' not registered", method)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if id != "" {
+		pendingLock.Lock()
+		pending[id] = cancel
+		pendingLock.Unlock()
+
+		defer func() {
+			pendingLock.Lock()
+			delete(pending, id)
+			pendingLock.Unlock()
+		}()
+	}
+
+	return handler(ctx, params)
+}
+
+// Cancel cancels the context of the pending call with the given id. Returns false if no such call is pending.
+func Cancel(id string) bool {
+	pendingLock.Lock()
+	cancel, ok := pending[id]
+	pendingLock.Unlock()
+
+	if ok {
+		cancel()
+	}
+	return ok
+}
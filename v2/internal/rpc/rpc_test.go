@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/matryer/is"
+)
+
+func TestCall(t *testing.T) {
+	i := is.New(t)
+
+	Register("test.add", func(ctx context.Context, params json.RawMessage) (any, error) {
+		var args []int
+		if err := json.Unmarshal(params, &args); err != nil {
+			return nil, err
+		}
+		return args[0] + args[1], nil
+	})
+	defer Unregister("test.add")
+
+	result, err := Call(context.Background(), "", "test.add", json.RawMessage(`[1,2]`))
+	i.NoErr(err)
+	i.Equal(result, 3)
+
+	_, err = Call(context.Background(), "", "test.add", json.RawMessage(`"invalid"`))
+	i.True(err != nil)
+
+	Unregister("test.add")
+	_, err = Call(context.Background(), "", "test.add", json.RawMessage(`[1,2]`))
+	i.Equal(err.Error(), "rpc method 'test.add' not registered")
+}
+
+func TestCancel(t *testing.T) {
+	i := is.New(t)
+
+	started := make(chan struct{})
+	Register("test.wait", func(ctx context.Context, params json.RawMessage) (any, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	defer Unregister("test.wait")
+
+	i.True(!Cancel("call-1"))
+
+	go func() {
+		<-started
+		i.True(Cancel("call-1"))
+	}()
+
+	done := make(chan error)
+	go func() {
+		_, err := Call(context.Background(), "call-1", "test.wait", nil)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		i.True(errors.Is(err, context.Canceled))
+	case <-time.After(5 * time.Second):
+		t.Fatal("call has not been cancelled")
+	}
+
+	i.True(!Cancel("call-1"))
+}
@@ -0,0 +1,238 @@
+// Package settings holds the settings of an application in a JSON file. The file is versioned, the migrations upgrade
+// the files written by older versions of the application when they are opened.
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// ErrNotExist is returned by Get if the setting doesn't exist
+var ErrNotExist = errors.New("setting does not exist")
+
+// FileName is the name of the settings file in the config directory of the application
+const FileName = "settings.json"
+
+// Migration upgrades the settings from the version of its index in the migrations to the next version. The settings
+// are only saved once all the migrations have succeeded.
+type Migration func(settings *Settings) error
+
+// Settings are the values of a settings file. A value is saved to the file as soon as it is set, the file is replaced
+// atomically so it isn't corrupted if the application crashes while it is saved.
+type Settings struct {
+	lock      sync.RWMutex
+	path      string
+	version   int
+	values    map[string]json.RawMessage
+	migrating bool
+}
+
+// file is the content of a settings file
+type file struct {
+	Version int                        `json:"version"`
+	Values  map[string]json.RawMessage `json:"values"`
+}
+
+// DefaultPath returns the path of the settings file in the config directory of the user, EG
+// "%AppData%\<name>\settings.json" on Windows. The name of the executable is used if the name is empty.
+func DefaultPath(name string) (string, error) {
+	config, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	if name == "" {
+		executable, _ := os.Executable()
+		name = strings.TrimSuffix(filepath.Base(executable), filepath.Ext(executable))
+	}
+	return filepath.Join(config, name, FileName), nil
+}
+
+// Open reads the settings file at the path, which doesn't have to exist. The version of the settings is the number of
+// migrations, the migrations the file hasn't been through are run in order and the upgraded file is saved. A file which
+// has been written by a newer version of the application returns an error rather than being overwritten.
+func Open(path string, migrations ...Migration) (*Settings, error) {
+	result := &Settings{
+		path:   path,
+		values: map[string]json.RawMessage{},
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		// A new file doesn't need to be migrated
+		result.version = len(migrations)
+		return result, nil
+	case err != nil:
+		return nil, err
+	}
+
+	var content file
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("invalid settings file %s: %w", path, err)
+	}
+	if content.Version > len(migrations) {
+		return nil, fmt.Errorf("the settings file %s has version %d, the latest known version is %d", path, content.Version, len(migrations))
+	}
+	if content.Values != nil {
+		result.values = content.Values
+	}
+	result.version = content.Version
+	if result.version == len(migrations) {
+		return result, nil
+	}
+
+	result.migrating = true
+	for ; result.version < len(migrations); result.version++ {
+		if err := migrations[result.version](result); err != nil {
+			return nil, fmt.Errorf("unable to migrate the settings from version %d: %w", result.version, err)
+		}
+	}
+	result.migrating = false
+	if err := result.save(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Path returns the path of the settings file
+func (s *Settings) Path() string {
+	return s.path
+}
+
+// Version returns the version of the settings, which is the version being upgraded during a migration
+func (s *Settings) Version() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.version
+}
+
+// Has returns true if the setting exists
+func (s *Settings) Has(key string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	_, ok := s.values[key]
+	return ok
+}
+
+// Keys returns the keys of the settings in alphabetical order
+func (s *Settings) Keys() []string {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	result := make([]string, 0, len(s.values))
+	for key := range s.values {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Get unmarshals the setting into the target like json.Unmarshal. ErrNotExist is returned if the setting doesn't
+// exist.
+func (s *Settings) Get(key string, target any) error {
+	s.lock.RLock()
+	value, ok := s.values[key]
+	s.lock.RUnlock()
+	if !ok {
+		return ErrNotExist
+	}
+	return json.Unmarshal(value, target)
+}
+
+// GetString returns the setting, or the fallback if it doesn't exist or isn't a string
+func (s *Settings) GetString(key string, fallback string) string {
+	return getOrDefault(s, key, fallback)
+}
+
+// GetInt returns the setting, or the fallback if it doesn't exist or isn't an integer
+func (s *Settings) GetInt(key string, fallback int) int {
+	return getOrDefault(s, key, fallback)
+}
+
+// GetFloat returns the setting, or the fallback if it doesn't exist or isn't a number
+func (s *Settings) GetFloat(key string, fallback float64) float64 {
+	return getOrDefault(s, key, fallback)
+}
+
+// GetBool returns the setting, or the fallback if it doesn't exist or isn't a boolean
+func (s *Settings) GetBool(key string, fallback bool) bool {
+	return getOrDefault(s, key, fallback)
+}
+
+func getOrDefault[T any](s *Settings, key string, fallback T) T {
+	var result T
+	if err := s.Get(key, &result); err != nil {
+		return fallback
+	}
+	return result
+}
+
+// Set marshals the value like json.Marshal and saves the settings
+func (s *Settings) Set(key string, value any) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.values[key] = data
+	return s.saveLocked()
+}
+
+// Delete removes the setting and saves the settings. Deleting a setting which doesn't exist isn't an error.
+func (s *Settings) Delete(key string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.values[key]; !ok {
+		return nil
+	}
+	delete(s.values, key)
+	return s.saveLocked()
+}
+
+func (s *Settings) save() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.saveLocked()
+}
+
+// saveLocked writes the settings to a temporary file which then replaces the settings file. It isn't saved during the
+// migrations.
+func (s *Settings) saveLocked() error {
+	if s.migrating {
+		return nil
+	}
+	data, err := json.MarshalIndent(file{Version: s.version, Values: s.values}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	directory := filepath.Dir(s.path)
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return err
+	}
+	temp, err := os.CreateTemp(directory, "."+filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tempPath := temp.Name()
+	_, err = temp.Write(data)
+	if err == nil {
+		err = temp.Sync()
+	}
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempPath, s.path)
+	}
+	if err != nil {
+		os.Remove(tempPath)
+	}
+	return err
+}
@@ -0,0 +1,142 @@
+package settings
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestSetAndGet(t *testing.T) {
+	i := is.New(t)
+	path := filepath.Join(t.TempDir(), "app", FileName)
+
+	settings, err := Open(path)
+	i.NoErr(err)
+	i.Equal(settings.Version(), 0)
+	i.Equal(settings.GetString("theme", "light"), "light")
+
+	i.NoErr(settings.Set("theme", "dark"))
+	i.NoErr(settings.Set("zoom", 1.5))
+	i.NoErr(settings.Set("count", 3))
+	i.NoErr(settings.Set("telemetry", false))
+	i.NoErr(settings.Set("window", map[string]int{"width": 800, "height": 600}))
+
+	reopened, err := Open(path)
+	i.NoErr(err)
+	i.Equal(reopened.GetString("theme", "light"), "dark")
+	i.Equal(reopened.GetFloat("zoom", 1), 1.5)
+	i.Equal(reopened.GetInt("count", 0), 3)
+	i.Equal(reopened.GetBool("telemetry", true), false)
+	i.Equal(reopened.Keys(), []string{"count", "telemetry", "theme", "window", "zoom"})
+
+	var window struct{ Width, Height int }
+	i.NoErr(reopened.Get("window", &window))
+	i.Equal(window.Width, 800)
+	i.Equal(window.Height, 600)
+
+	// The fallback is returned for a value of another type
+	i.Equal(reopened.GetInt("theme", 7), 7)
+
+	i.True(errors.Is(reopened.Get("missing", &window), ErrNotExist))
+
+	i.NoErr(reopened.Delete("theme"))
+	i.NoErr(reopened.Delete("theme"))
+	i.True(!reopened.Has("theme"))
+}
+
+func TestAtomicSave(t *testing.T) {
+	i := is.New(t)
+	directory := t.TempDir()
+	path := filepath.Join(directory, FileName)
+
+	settings, err := Open(path)
+	i.NoErr(err)
+	i.NoErr(settings.Set("name", "value"))
+
+	// No temporary file is left behind
+	entries, err := os.ReadDir(directory)
+	i.NoErr(err)
+	i.Equal(len(entries), 1)
+	i.Equal(entries[0].Name(), FileName)
+}
+
+func TestMigrations(t *testing.T) {
+	i := is.New(t)
+	path := filepath.Join(t.TempDir(), FileName)
+	i.NoErr(os.WriteFile(path, []byte(`{"values": {"dark": true}}`), 0o644))
+
+	migrations := []Migration{
+		// Version 0 stored the theme as a boolean
+		func(settings *Settings) error {
+			theme := "light"
+			if settings.GetBool("dark", false) {
+				theme = "dark"
+			}
+			if err := settings.Delete("dark"); err != nil {
+				return err
+			}
+			return settings.Set("theme", theme)
+		},
+		func(settings *Settings) error {
+			return settings.Set("zoom", 1.0)
+		},
+	}
+
+	settings, err := Open(path, migrations...)
+	i.NoErr(err)
+	i.Equal(settings.Version(), 2)
+	i.Equal(settings.GetString("theme", ""), "dark")
+	i.True(!settings.Has("dark"))
+
+	// The upgraded file has been saved
+	reopened, err := Open(path, migrations...)
+	i.NoErr(err)
+	i.Equal(reopened.Version(), 2)
+	i.Equal(reopened.GetFloat("zoom", 0), 1.0)
+
+	// A new file has the latest version
+	created, err := Open(filepath.Join(t.TempDir(), FileName), migrations...)
+	i.NoErr(err)
+	i.Equal(created.Version(), 2)
+}
+
+func TestMigrationFailure(t *testing.T) {
+	i := is.New(t)
+	path := filepath.Join(t.TempDir(), FileName)
+	original := []byte(`{"version": 0, "values": {"name": "value"}}`)
+	i.NoErr(os.WriteFile(path, original, 0o644))
+
+	_, err := Open(path, func(settings *Settings) error {
+		if err := settings.Set("name", "changed"); err != nil {
+			return err
+		}
+		return errors.New("failed")
+	})
+	i.True(err != nil)
+
+	// The file is left untouched
+	data, err := os.ReadFile(path)
+	i.NoErr(err)
+	i.Equal(data, original)
+}
+
+func TestNewerVersion(t *testing.T) {
+	i := is.New(t)
+	path := filepath.Join(t.TempDir(), FileName)
+	i.NoErr(os.WriteFile(path, []byte(`{"version": 3, "values": {}}`), 0o644))
+
+	_, err := Open(path)
+	i.True(err != nil)
+}
+
+func TestInvalidFile(t *testing.T) {
+	i := is.New(t)
+	path := filepath.Join(t.TempDir(), FileName)
+	i.NoErr(os.WriteFile(path, []byte(`{`), 0o644))
+
+	_, err := Open(path)
+	i.True(err != nil)
+}
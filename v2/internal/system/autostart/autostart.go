@@ -0,0 +1,72 @@
+package autostart
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Flag is the argument added to the command of the registration, it is removed from os.Args when the package is
+// initialised so it doesn't reach the flag parsing of the application
+const Flag = "--wails-autostart"
+
+// ErrNotSupported is returned on platforms without a way to launch applications at login
+var ErrNotSupported = errors.New("launching at login is not supported on this platform")
+
+var launchedAtLogin = removeFlag()
+
+func removeFlag() bool {
+	for i, arg := range os.Args {
+		if i > 0 && arg == Flag {
+			os.Args = append(os.Args[:i:i], os.Args[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// LaunchedAtLogin returns true if the application has been launched by the registration of Enable
+func LaunchedAtLogin() bool {
+	return launchedAtLogin
+}
+
+// Enable registers the executable to be launched at login with the Flag
+func Enable() error {
+	executable, name, err := executable()
+	if err != nil {
+		return err
+	}
+	return platformEnable(name, executable)
+}
+
+// Disable removes the registration, it is not an error if there is none
+func Disable() error {
+	_, name, err := executable()
+	if err != nil {
+		return err
+	}
+	return platformDisable(name)
+}
+
+// IsEnabled returns true if the executable has been registered with Enable
+func IsEnabled() (bool, error) {
+	_, name, err := executable()
+	if err != nil {
+		return false, err
+	}
+	return platformIsEnabled(name)
+}
+
+// executable returns the path of the executable and its name without the extension, which names the registration
+func executable() (string, string, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return "", "", err
+	}
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	return path, name, nil
+}
@@ -0,0 +1,79 @@
+//go:build darwin
+// +build darwin
+
+package autostart
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// launchAgent returns the label and the path of the launch agent in the LaunchAgents directory of the user. A launch
+// agent is used rather than SMAppService, as it can pass the Flag and doesn't require macOS 13.
+func launchAgent(name string) (string, string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", "", err
+	}
+	label := "wails.autostart." + name
+	return label, filepath.Join(home, "Library", "LaunchAgents", label+".plist"), nil
+}
+
+func escapeXML(s string) string {
+	var result bytes.Buffer
+	_ = xml.EscapeText(&result, []byte(s))
+	return result.String()
+}
+
+func platformEnable(name string, executable string) error {
+	label, path, err := launchAgent(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	plist := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>` + escapeXML(label) + `</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>` + escapeXML(executable) + `</string>
+		<string>` + Flag + `</string>
+	</array>
+	<key>RunAtLoad</key>
+	<true/>
+</dict>
+</plist>
+`
+	return os.WriteFile(path, []byte(plist), 0o644)
+}
+
+func platformDisable(name string) error {
+	_, path, err := launchAgent(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func platformIsEnabled(name string) (bool, error) {
+	_, path, err := launchAgent(name)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,63 @@
+//go:build linux
+// +build linux
+
+package autostart
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// desktopFile returns the path of the desktop entry in the XDG autostart directory
+func desktopFile(name string) (string, error) {
+	config, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(config, "autostart", name+".desktop"), nil
+}
+
+// quoteExec quotes an argument of the Exec key of a desktop entry
+func quoteExec(arg string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "`", "\\`", `$`, `\$`)
+	return `"` + replacer.Replace(arg) + `"`
+}
+
+func platformEnable(name string, executable string) error {
+	path, err := desktopFile(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	entry := fmt.Sprintf("[Desktop Entry]\nType=Application\nName=%s\nExec=%s %s\nX-GNOME-Autostart-enabled=true\n",
+		name, quoteExec(executable), Flag)
+	return os.WriteFile(path, []byte(entry), 0o644)
+}
+
+func platformDisable(name string) error {
+	path, err := desktopFile(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func platformIsEnabled(name string) (bool, error) {
+	path, err := desktopFile(name)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,16 @@
+//go:build !windows && !linux && !darwin
+// +build !windows,!linux,!darwin
+
+package autostart
+
+func platformEnable(name string, executable string) error {
+	return ErrNotSupported
+}
+
+func platformDisable(name string) error {
+	return ErrNotSupported
+}
+
+func platformIsEnabled(name string) (bool, error) {
+	return false, ErrNotSupported
+}
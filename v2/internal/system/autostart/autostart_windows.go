@@ -0,0 +1,47 @@
+//go:build windows
+// +build windows
+
+package autostart
+
+import (
+	"errors"
+	"syscall"
+
+	"golang.org/x/sys/windows/registry"
+)
+
+const runKey = `Software\Microsoft\Windows\CurrentVersion\Run`
+
+func platformEnable(name string, executable string) error {
+	key, _, err := registry.CreateKey(registry.CURRENT_USER, runKey, registry.SET_VALUE)
+	if err != nil {
+		return err
+	}
+	defer key.Close()
+	return key.SetStringValue(name, syscall.EscapeArg(executable)+" "+Flag)
+}
+
+func platformDisable(name string) error {
+	key, err := registry.OpenKey(registry.CURRENT_USER, runKey, registry.SET_VALUE)
+	if err != nil {
+		return err
+	}
+	defer key.Close()
+	if err := key.DeleteValue(name); err != nil && !errors.Is(err, registry.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func platformIsEnabled(name string) (bool, error) {
+	key, err := registry.OpenKey(registry.CURRENT_USER, runKey, registry.QUERY_VALUE)
+	if err != nil {
+		return false, err
+	}
+	defer key.Close()
+	_, _, err = key.GetStringValue(name)
+	if errors.Is(err, registry.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
@@ -0,0 +1,57 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrNotSupported is returned on platforms without a network status API
+var ErrNotSupported = errors.New("the network status is not supported on this platform")
+
+// ConnectionType is the type of the connection used to reach the network
+type ConnectionType string
+
+const (
+	ConnectionNone     ConnectionType = "none"
+	ConnectionWifi     ConnectionType = "wifi"
+	ConnectionEthernet ConnectionType = "ethernet"
+	ConnectionCellular ConnectionType = "cellular"
+	// ConnectionUnknown is used when the OS doesn't report the type of the connection
+	ConnectionUnknown ConnectionType = "unknown"
+)
+
+// Status is the network status reported by the OS
+type Status struct {
+	// Online is true when the OS reports a connection to the internet, or at least a route to it
+	Online         bool           `json:"online"`
+	ConnectionType ConnectionType `json:"connectionType"`
+}
+
+// GetStatus returns the current network status
+func GetStatus() (Status, error) {
+	return platformGetStatus()
+}
+
+// Watch calls onChange with the new status each time the network status changes, until the context is done.
+// It blocks and returns an error if the status can't be watched.
+func Watch(ctx context.Context, onChange func(Status)) error {
+	last, err := GetStatus()
+	if err != nil {
+		return err
+	}
+	var lock sync.Mutex
+	return platformWatch(ctx, func() {
+		status, err := GetStatus()
+		if err != nil {
+			return
+		}
+		lock.Lock()
+		changed := status != last
+		last = status
+		lock.Unlock()
+		if changed {
+			onChange(status)
+		}
+	})
+}
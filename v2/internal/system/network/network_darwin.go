@@ -0,0 +1,130 @@
+//go:build darwin
+// +build darwin
+
+package network
+
+/*
+#cgo CFLAGS: -x objective-c
+#cgo LDFLAGS: -framework Network
+#import <Network/Network.h>
+#include <os/lock.h>
+#include <stdbool.h>
+
+extern void networkPathChanged(void);
+
+static nw_path_monitor_t monitor = NULL;
+// The status is written on the queue of the monitor and read by Go, the lock keeps both values consistent
+static os_unfair_lock statusLock = OS_UNFAIR_LOCK_INIT;
+static bool online = false;
+static int connectionType = 0;
+
+// StartPathMonitor starts the path monitor, which reports the current path and each change of it. The first path is
+// awaited, so the status is known when this returns.
+static void StartPathMonitor(void) {
+	if (monitor != NULL) {
+		return;
+	}
+	dispatch_semaphore_t first = dispatch_semaphore_create(0);
+	__block bool started = false;
+	monitor = nw_path_monitor_create();
+	nw_path_monitor_set_queue(monitor, dispatch_get_global_queue(QOS_CLASS_UTILITY, 0));
+	nw_path_monitor_set_update_handler(monitor, ^(nw_path_t path) {
+		int type = 0;
+		if (nw_path_uses_interface_type(path, nw_interface_type_wifi)) {
+			type = 1;
+		} else if (nw_path_uses_interface_type(path, nw_interface_type_wired)) {
+			type = 2;
+		} else if (nw_path_uses_interface_type(path, nw_interface_type_cellular)) {
+			type = 3;
+		}
+		os_unfair_lock_lock(&statusLock);
+		online = nw_path_get_status(path) == nw_path_status_satisfied;
+		connectionType = type;
+		os_unfair_lock_unlock(&statusLock);
+		if (!started) {
+			started = true;
+			dispatch_semaphore_signal(first);
+		} else {
+			networkPathChanged();
+		}
+	});
+	nw_path_monitor_start(monitor);
+	dispatch_semaphore_wait(first, dispatch_time(DISPATCH_TIME_NOW, 2 * NSEC_PER_SEC));
+}
+
+// GetStatus returns the online state and the connection type of the last path
+static void GetStatus(bool *isOnline, int *type) {
+	os_unfair_lock_lock(&statusLock);
+	*isOnline = online;
+	*type = connectionType;
+	os_unfair_lock_unlock(&statusLock);
+}
+*/
+import "C"
+
+import (
+	"context"
+	"sync"
+)
+
+var (
+	startMonitor sync.Once
+
+	watchersLock sync.Mutex
+	watchers     = map[chan struct{}]struct{}{}
+)
+
+// platformGetStatus returns the status of the path reported by the NWPathMonitor
+func platformGetStatus() (Status, error) {
+	startMonitor.Do(func() { C.StartPathMonitor() })
+	var online C.bool
+	var connectionType C.int
+	C.GetStatus(&online, &connectionType)
+	if !bool(online) {
+		return Status{Online: false, ConnectionType: ConnectionNone}, nil
+	}
+	status := Status{Online: true, ConnectionType: ConnectionUnknown}
+	switch connectionType {
+	case 1:
+		status.ConnectionType = ConnectionWifi
+	case 2:
+		status.ConnectionType = ConnectionEthernet
+	case 3:
+		status.ConnectionType = ConnectionCellular
+	}
+	return status, nil
+}
+
+// platformWatch calls onChange each time the NWPathMonitor reports a new path
+func platformWatch(ctx context.Context, onChange func()) error {
+	startMonitor.Do(func() { C.StartPathMonitor() })
+	changes := make(chan struct{}, 1)
+	watchersLock.Lock()
+	watchers[changes] = struct{}{}
+	watchersLock.Unlock()
+	defer func() {
+		watchersLock.Lock()
+		delete(watchers, changes)
+		watchersLock.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+			onChange()
+		}
+	}
+}
+
+func notifyWatchers() {
+	watchersLock.Lock()
+	defer watchersLock.Unlock()
+	for changes := range watchers {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+}
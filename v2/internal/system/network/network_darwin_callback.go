@@ -0,0 +1,13 @@
+//go:build darwin
+// +build darwin
+
+package network
+
+// The callback is exported from its own file, as the C code of a file with exports may only contain declarations
+
+import "C"
+
+//export networkPathChanged
+func networkPathChanged() {
+	notifyWatchers()
+}
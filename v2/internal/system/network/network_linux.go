@@ -0,0 +1,93 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"context"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	nmService   = "org.freedesktop.NetworkManager"
+	nmPath      = dbus.ObjectPath("/org/freedesktop/NetworkManager")
+	nmInterface = "org.freedesktop.NetworkManager"
+
+	// nmStateConnectedGlobal is the NMState of a connection to the internet
+	nmStateConnectedGlobal = 70
+)
+
+// nmConnectionTypes maps the setting types of NetworkManager connections to the connection types
+var nmConnectionTypes = map[string]ConnectionType{
+	"802-11-wireless": ConnectionWifi,
+	"802-3-ethernet":  ConnectionEthernet,
+	"gsm":             ConnectionCellular,
+	"cdma":            ConnectionCellular,
+}
+
+// platformGetStatus queries NetworkManager over D-Bus
+func platformGetStatus() (Status, error) {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return Status{}, err
+	}
+	manager := conn.Object(nmService, nmPath)
+
+	state, err := manager.GetProperty(nmInterface + ".State")
+	if err != nil {
+		return Status{}, err
+	}
+	var stateValue uint32
+	if err := state.Store(&stateValue); err != nil {
+		return Status{}, err
+	}
+	if stateValue != nmStateConnectedGlobal {
+		return Status{Online: false, ConnectionType: ConnectionNone}, nil
+	}
+
+	status := Status{Online: true, ConnectionType: ConnectionUnknown}
+	if primaryType, err := manager.GetProperty(nmInterface + ".PrimaryConnectionType"); err == nil {
+		if connectionType, ok := nmConnectionTypes[primaryTypeName(primaryType)]; ok {
+			status.ConnectionType = connectionType
+		}
+	}
+	return status, nil
+}
+
+// platformWatch calls onChange each time the properties of NetworkManager change
+func platformWatch(ctx context.Context, onChange func()) error {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	err = conn.AddMatchSignal(
+		dbus.WithMatchObjectPath(nmPath),
+		dbus.WithMatchInterface("org.freedesktop.DBus.Properties"),
+		dbus.WithMatchMember("PropertiesChanged"),
+	)
+	if err != nil {
+		return err
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-signals:
+			if !ok {
+				return nil
+			}
+			onChange()
+		}
+	}
+}
+
+func primaryTypeName(value dbus.Variant) string {
+	name, _ := value.Value().(string)
+	return name
+}
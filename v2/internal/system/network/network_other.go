@@ -0,0 +1,20 @@
+//go:build !linux && !windows && !(darwin && cgo)
+
+package network
+
+import (
+	"context"
+	"net/url"
+)
+
+func platformGetStatus() (Status, error) {
+	return Status{}, ErrNotSupported
+}
+
+func platformWatch(ctx context.Context, onChange func()) error {
+	return ErrNotSupported
+}
+
+func platformResolveProxy(target *url.URL) (*Proxy, error) {
+	return environmentProxy(target)
+}
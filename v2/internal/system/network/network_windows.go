@@ -0,0 +1,139 @@
+//go:build windows
+
+package network
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// The interface types of mobile broadband adapters, which are not defined in x/sys/windows
+const (
+	ifTypeWWANPP  = 243
+	ifTypeWWANPP2 = 244
+)
+
+// platformGetStatus returns whether the Network List Manager reports a connection to the internet, and the type of
+// the adapter with a gateway and the lowest metric, which is the one Windows routes the internet traffic through
+func platformGetStatus() (Status, error) {
+	online, err := connectedToInternet()
+	if err == nil && !online {
+		return Status{Online: false, ConnectionType: ConnectionNone}, nil
+	}
+	// Without the Network List Manager, a route to the internet is reported as online
+	return adapterStatus()
+}
+
+// adapterStatus returns the status of the adapter with a gateway and the lowest metric
+func adapterStatus() (Status, error) {
+	adapters, err := adapterAddresses()
+	if err != nil {
+		return Status{}, err
+	}
+
+	var connected []*windows.IpAdapterAddresses
+	for _, adapter := range adapters {
+		if adapter.OperStatus == windows.IfOperStatusUp && adapter.FirstGatewayAddress != nil &&
+			adapter.IfType != windows.IF_TYPE_SOFTWARE_LOOPBACK && adapter.IfType != windows.IF_TYPE_TUNNEL {
+			connected = append(connected, adapter)
+		}
+	}
+	if len(connected) == 0 {
+		return Status{Online: false, ConnectionType: ConnectionNone}, nil
+	}
+	sort.SliceStable(connected, func(i, j int) bool {
+		return connected[i].Ipv4Metric < connected[j].Ipv4Metric
+	})
+
+	status := Status{Online: true, ConnectionType: ConnectionUnknown}
+	switch connected[0].IfType {
+	case windows.IF_TYPE_IEEE80211:
+		status.ConnectionType = ConnectionWifi
+	case windows.IF_TYPE_ETHERNET_CSMACD:
+		status.ConnectionType = ConnectionEthernet
+	case ifTypeWWANPP, ifTypeWWANPP2:
+		status.ConnectionType = ConnectionCellular
+	}
+	return status, nil
+}
+
+func adapterAddresses() ([]*windows.IpAdapterAddresses, error) {
+	size := uint32(15 * 1024)
+	for {
+		buffer := make([]byte, size)
+		first := (*windows.IpAdapterAddresses)(unsafe.Pointer(&buffer[0]))
+		err := windows.GetAdaptersAddresses(windows.AF_UNSPEC, windows.GAA_FLAG_INCLUDE_GATEWAYS, 0, first, &size)
+		if err == windows.ERROR_BUFFER_OVERFLOW {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		var result []*windows.IpAdapterAddresses
+		for adapter := first; adapter != nil; adapter = adapter.Next {
+			result = append(result, adapter)
+		}
+		return result, nil
+	}
+}
+
+var (
+	watchersLock sync.Mutex
+	watchers     = map[chan struct{}]struct{}{}
+
+	// interfaceChangeCallback is created once, as the number of callbacks is limited
+	interfaceChangeCallback = syscall.NewCallback(func(callerContext uintptr, row uintptr, notificationType uint32) uintptr {
+		notifyWatchers()
+		return 0
+	})
+)
+
+func notifyWatchers() {
+	watchersLock.Lock()
+	defer watchersLock.Unlock()
+	for changes := range watchers {
+		select {
+		case changes <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// platformWatch calls onChange each time an IP interface changes, EG when it is connected or disconnected, or the
+// Network List Manager reports a change of the connectivity
+func platformWatch(ctx context.Context, onChange func()) error {
+	changes := make(chan struct{}, 1)
+	watchersLock.Lock()
+	watchers[changes] = struct{}{}
+	watchersLock.Unlock()
+	defer func() {
+		watchersLock.Lock()
+		delete(watchers, changes)
+		watchersLock.Unlock()
+	}()
+
+	var handle windows.Handle
+	if err := windows.NotifyIpInterfaceChange(windows.AF_UNSPEC, interfaceChangeCallback, nil, false, &handle); err != nil {
+		return err
+	}
+	defer windows.CancelMibChangeNotify2(handle)
+
+	// The interface changes are still reported if the Network List Manager isn't available
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() { _ = watchConnectivity(stop) }()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-changes:
+			onChange()
+		}
+	}
+}
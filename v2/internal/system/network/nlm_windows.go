@@ -0,0 +1,133 @@
+//go:build windows
+
+package network
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/wailsapp/wails/v2/internal/platform/com"
+	"golang.org/x/sys/windows"
+)
+
+// The Network List Manager reports the connectivity Windows detects, including whether the internet can be reached
+var (
+	clsidNetworkListManager      = windows.GUID{Data1: 0xDCB00C01, Data2: 0x570F, Data3: 0x4A9B, Data4: [8]byte{0x8D, 0x69, 0x19, 0x9F, 0xDB, 0xA5, 0x72, 0x3B}}
+	iidINetworkListManager       = windows.GUID{Data1: 0xDCB00000, Data2: 0x570F, Data3: 0x4A9B, Data4: [8]byte{0x8D, 0x69, 0x19, 0x9F, 0xDB, 0xA5, 0x72, 0x3B}}
+	iidINetworkListManagerEvents = windows.GUID{Data1: 0xDCB00001, Data2: 0x570F, Data3: 0x4A9B, Data4: [8]byte{0x8D, 0x69, 0x19, 0x9F, 0xDB, 0xA5, 0x72, 0x3B}}
+	iidIUnknown                  = windows.GUID{Data1: 0x00000000, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	iidIConnectionPointContainer = windows.GUID{Data1: 0xB196B284, Data2: 0xBAB4, Data3: 0x101A, Data4: [8]byte{0xB6, 0x9C, 0x00, 0xAA, 0x00, 0x34, 0x1D, 0x07}}
+
+	procCoCreateInstance = windows.NewLazySystemDLL("ole32.dll").NewProc("CoCreateInstance")
+)
+
+// The vtable indexes of the methods
+const (
+	methodNetworkListManagerGetConnectivity           = 13
+	methodConnectionPointContainerFindConnectionPoint = 4
+	methodConnectionPointAdvise                       = 5
+	methodConnectionPointUnadvise                     = 6
+)
+
+// The NLM_CONNECTIVITY flags of a connection to the internet
+const (
+	nlmConnectivityIPv4Internet = 0x40
+	nlmConnectivityIPv6Internet = 0x400
+)
+
+const (
+	sFalse       = 1
+	eNoInterface = 0x80004002
+	clsctxServer = windows.CLSCTX_INPROC_SERVER | windows.CLSCTX_LOCAL_SERVER
+)
+
+// withCOM runs f on a locked thread of the multithreaded apartment
+func withCOM(f func() error) error {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	if err := windows.CoInitializeEx(0, windows.COINIT_MULTITHREADED); err != nil && err != syscall.Errno(sFalse) {
+		return err
+	}
+	defer windows.CoUninitialize()
+	return f()
+}
+
+func createNetworkListManager() (*com.Object, error) {
+	var result *com.Object
+	hr, _, _ := procCoCreateInstance.Call(uintptr(unsafe.Pointer(&clsidNetworkListManager)), 0, clsctxServer, uintptr(unsafe.Pointer(&iidINetworkListManager)), uintptr(unsafe.Pointer(&result)))
+	if int32(hr) < 0 {
+		return nil, syscall.Errno(hr)
+	}
+	return result, nil
+}
+
+// connectedToInternet returns true if the Network List Manager reports a connection to the internet over IPv4 or
+// IPv6
+func connectedToInternet() (bool, error) {
+	var connectivity uint32
+	err := withCOM(func() error {
+		manager, err := createNetworkListManager()
+		if err != nil {
+			return err
+		}
+		defer manager.Release()
+		return manager.Call(methodNetworkListManagerGetConnectivity, uintptr(unsafe.Pointer(&connectivity)))
+	})
+	return connectivity&(nlmConnectivityIPv4Internet|nlmConnectivityIPv6Internet) != 0, err
+}
+
+// connectivitySink implements INetworkListManagerEvents. It is a single static object, as the number of callbacks is
+// limited, and isn't reference counted.
+var connectivitySink = &struct {
+	vtbl *[4]uintptr
+}{
+	vtbl: &[4]uintptr{
+		syscall.NewCallback(func(this uintptr, iid *windows.GUID, result *uintptr) uintptr {
+			if *iid == iidIUnknown || *iid == iidINetworkListManagerEvents {
+				*result = this
+				return 0
+			}
+			*result = 0
+			return eNoInterface
+		}),
+		syscall.NewCallback(func(this uintptr) uintptr { return 1 }),
+		syscall.NewCallback(func(this uintptr) uintptr { return 1 }),
+		// ConnectivityChanged
+		syscall.NewCallback(func(this uintptr, connectivity uint32) uintptr {
+			notifyWatchers()
+			return 0
+		}),
+	},
+}
+
+// watchConnectivity calls notifyWatchers each time the Network List Manager reports a change of the connectivity,
+// EG when the internet becomes reachable through a connected network, until stop is closed
+func watchConnectivity(stop <-chan struct{}) error {
+	return withCOM(func() error {
+		manager, err := createNetworkListManager()
+		if err != nil {
+			return err
+		}
+		defer manager.Release()
+
+		var container *com.Object
+		if err := manager.Call(com.MethodQueryInterface, uintptr(unsafe.Pointer(&iidIConnectionPointContainer)), uintptr(unsafe.Pointer(&container))); err != nil {
+			return err
+		}
+		defer container.Release()
+		var point *com.Object
+		if err := container.Call(methodConnectionPointContainerFindConnectionPoint, uintptr(unsafe.Pointer(&iidINetworkListManagerEvents)), uintptr(unsafe.Pointer(&point))); err != nil {
+			return err
+		}
+		defer point.Release()
+
+		var cookie uint32
+		if err := point.Call(methodConnectionPointAdvise, uintptr(unsafe.Pointer(connectivitySink)), uintptr(unsafe.Pointer(&cookie))); err != nil {
+			return err
+		}
+		// The events are delivered on the threads of the apartment, this one only has to stay initialised
+		<-stop
+		return point.Call(methodConnectionPointUnadvise, uintptr(cookie))
+	})
+}
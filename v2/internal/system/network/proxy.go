@@ -0,0 +1,101 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// Proxy is the system proxy configuration resolved for a URL
+type Proxy struct {
+	// Proxies are the proxies to try in order, EG "http://proxy.example.com:8080" or
+	// "socks5://proxy.example.com:1080". It is empty when the URL is fetched directly.
+	Proxies []*url.URL
+	// PACURL is the URL of the proxy auto-config script which has been evaluated, if any
+	PACURL string
+}
+
+// URL returns the first proxy, or nil when the URL is fetched directly
+func (p *Proxy) URL() *url.URL {
+	if p == nil || len(p.Proxies) == 0 {
+		return nil
+	}
+	return p.Proxies[0]
+}
+
+// ResolveProxy returns the system proxy configuration for the URL, evaluating the proxy auto-config script of the
+// system if there is one
+func ResolveProxy(rawURL string) (*Proxy, error) {
+	target, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if target.Scheme == "" || target.Host == "" {
+		return nil, fmt.Errorf("not an absolute URL: %s", rawURL)
+	}
+	return platformResolveProxy(target)
+}
+
+// environmentProxy returns the proxy of the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables
+func environmentProxy(target *url.URL) (*Proxy, error) {
+	proxy, err := http.ProxyFromEnvironment(&http.Request{URL: target})
+	if err != nil {
+		return nil, err
+	}
+	result := &Proxy{}
+	if proxy != nil {
+		result.Proxies = []*url.URL{proxy}
+	}
+	return result, nil
+}
+
+// proxyURL returns the URL of a proxy given as "host:port" or as a URL. The scheme is used when the proxy has none.
+func proxyURL(scheme string, proxy string) (*url.URL, error) {
+	if !strings.Contains(proxy, "://") {
+		proxy = scheme + "://" + proxy
+	}
+	result, err := url.Parse(proxy)
+	if err != nil {
+		return nil, err
+	}
+	if result.Host == "" {
+		return nil, fmt.Errorf("invalid proxy: %s", proxy)
+	}
+	return result, nil
+}
+
+// bypassed returns true if the host matches one of the bypass patterns of the system settings. A pattern is a host
+// name which matches its subdomains too, a wildcard such as "*.example.com", an IP address or a CIDR block. The
+// "<local>" pattern of Windows matches the host names without a dot.
+func bypassed(host string, patterns []string) bool {
+	host = strings.ToLower(host)
+	ip := net.ParseIP(host)
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		switch {
+		case pattern == "":
+			continue
+		case pattern == "<local>":
+			if !strings.Contains(host, ".") && ip == nil {
+				return true
+			}
+		case strings.Contains(pattern, "/"):
+			if _, block, err := net.ParseCIDR(pattern); err == nil && ip != nil && block.Contains(ip) {
+				return true
+			}
+		case strings.Contains(pattern, "*"):
+			if matched, _ := path.Match(pattern, host); matched {
+				return true
+			}
+		default:
+			pattern = strings.TrimPrefix(pattern, ".")
+			if host == pattern || strings.HasSuffix(host, "."+pattern) {
+				return true
+			}
+		}
+	}
+	return false
+}
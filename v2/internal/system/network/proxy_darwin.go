@@ -0,0 +1,163 @@
+//go:build darwin
+// +build darwin
+
+package network
+
+/*
+#cgo LDFLAGS: -framework CoreFoundation -framework CFNetwork -framework SystemConfiguration
+#include <CoreFoundation/CoreFoundation.h>
+#include <CFNetwork/CFNetwork.h>
+#include <SystemConfiguration/SystemConfiguration.h>
+#include <stdbool.h>
+#include <stdlib.h>
+
+#define PACRunLoopMode CFSTR("WailsProxyAutoConfiguration")
+
+static void appendString(CFMutableStringRef out, CFStringRef value) {
+	if (value != NULL && CFGetTypeID(value) == CFStringGetTypeID()) {
+		CFStringAppend(out, value);
+	}
+}
+
+static void pacResult(void *client, CFArrayRef proxies, CFErrorRef error) {
+	CFTypeRef *result = (CFTypeRef *)client;
+	*result = CFRetain(error != NULL ? (CFTypeRef)error : (CFTypeRef)proxies);
+	CFRunLoopStop(CFRunLoopGetCurrent());
+}
+
+// executePAC runs the run loop of the current thread until the proxy auto-config script has been evaluated
+static CFArrayRef executePAC(CFDictionaryRef proxy, CFURLRef url) {
+	CFTypeRef result = NULL;
+	CFStreamClientContext context = {0, &result, NULL, NULL, NULL};
+	CFRunLoopSourceRef source;
+	if (CFEqual(CFDictionaryGetValue(proxy, kCFProxyTypeKey), kCFProxyTypeAutoConfigurationURL)) {
+		CFURLRef script = CFDictionaryGetValue(proxy, kCFProxyAutoConfigurationURLKey);
+		source = CFNetworkExecuteProxyAutoConfigurationURL(script, url, pacResult, &context);
+	} else {
+		CFStringRef script = CFDictionaryGetValue(proxy, kCFProxyAutoConfigurationJavaScriptKey);
+		source = CFNetworkExecuteProxyAutoConfigurationScript(script, url, pacResult, &context);
+	}
+	CFRunLoopAddSource(CFRunLoopGetCurrent(), source, PACRunLoopMode);
+	CFRunLoopRunInMode(PACRunLoopMode, 10, false);
+	CFRunLoopRemoveSource(CFRunLoopGetCurrent(), source, PACRunLoopMode);
+	CFRelease(source);
+	if (result != NULL && CFGetTypeID(result) != CFArrayGetTypeID()) {
+		CFRelease(result);
+		return NULL;
+	}
+	return (CFArrayRef)result;
+}
+
+// appendProxies appends the proxies as lines of "<scheme> <host> <port>", or "direct" for a direct connection.
+// The proxy auto-config scripts are evaluated and their URL is appended as a "pac <url>" line.
+static void appendProxies(CFMutableStringRef out, CFArrayRef proxies, CFURLRef url, bool evaluatePAC) {
+	for (CFIndex i = 0; i < CFArrayGetCount(proxies); i++) {
+		CFDictionaryRef proxy = CFArrayGetValueAtIndex(proxies, i);
+		CFStringRef type = CFDictionaryGetValue(proxy, kCFProxyTypeKey);
+		if (type == NULL) {
+			continue;
+		}
+		if (CFEqual(type, kCFProxyTypeAutoConfigurationURL) || CFEqual(type, kCFProxyTypeAutoConfigurationJavaScript)) {
+			if (!evaluatePAC) {
+				continue;
+			}
+			CFURLRef script = CFDictionaryGetValue(proxy, kCFProxyAutoConfigurationURLKey);
+			if (script != NULL) {
+				CFStringAppend(out, CFSTR("pac "));
+				appendString(out, CFURLGetString(script));
+				CFStringAppend(out, CFSTR("\n"));
+			}
+			CFArrayRef evaluated = executePAC(proxy, url);
+			if (evaluated != NULL) {
+				appendProxies(out, evaluated, url, false);
+				CFRelease(evaluated);
+			}
+			continue;
+		}
+		if (CFEqual(type, kCFProxyTypeNone)) {
+			CFStringAppend(out, CFSTR("direct\n"));
+			continue;
+		}
+		if (CFEqual(type, kCFProxyTypeHTTP) || CFEqual(type, kCFProxyTypeHTTPS)) {
+			CFStringAppend(out, CFSTR("http "));
+		} else if (CFEqual(type, kCFProxyTypeSOCKS)) {
+			CFStringAppend(out, CFSTR("socks5 "));
+		} else {
+			continue;
+		}
+		appendString(out, CFDictionaryGetValue(proxy, kCFProxyHostNameKey));
+		CFNumberRef port = CFDictionaryGetValue(proxy, kCFProxyPortNumberKey);
+		int portNumber = 0;
+		if (port != NULL) {
+			CFNumberGetValue(port, kCFNumberIntType, &portNumber);
+		}
+		CFStringAppendFormat(out, NULL, CFSTR(" %d\n"), portNumber);
+	}
+}
+
+// ResolveProxies resolves the proxies of the URL with the proxy settings of the SCDynamicStore. The result must be
+// freed, it is NULL if the settings can't be read.
+static char *ResolveProxies(const char *rawURL) {
+	CFURLRef url = CFURLCreateWithBytes(NULL, (const UInt8 *)rawURL, strlen(rawURL), kCFStringEncodingUTF8, NULL);
+	if (url == NULL) {
+		return NULL;
+	}
+	CFDictionaryRef settings = SCDynamicStoreCopyProxies(NULL);
+	if (settings == NULL) {
+		CFRelease(url);
+		return NULL;
+	}
+	CFArrayRef proxies = CFNetworkCopyProxiesForURL(url, settings);
+	CFMutableStringRef out = CFStringCreateMutable(NULL, 0);
+	if (proxies != NULL) {
+		appendProxies(out, proxies, url, true);
+		CFRelease(proxies);
+	}
+	CFRelease(settings);
+	CFRelease(url);
+
+	CFIndex size = CFStringGetMaximumSizeForEncoding(CFStringGetLength(out), kCFStringEncodingUTF8) + 1;
+	char *result = malloc(size);
+	if (!CFStringGetCString(out, result, size, kCFStringEncodingUTF8)) {
+		result[0] = '\0';
+	}
+	CFRelease(out);
+	return result;
+}
+*/
+import "C"
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+	"unsafe"
+)
+
+// platformResolveProxy resolves the proxy with the settings of the system, which are the settings of WebKit. The
+// proxy auto-config script is evaluated by CFNetwork when it is configured or auto-discovered.
+func platformResolveProxy(target *url.URL) (*Proxy, error) {
+	rawURL := C.CString(target.String())
+	defer C.free(unsafe.Pointer(rawURL))
+	proxies := C.ResolveProxies(rawURL)
+	if proxies == nil {
+		return nil, errors.New("unable to read the proxy settings")
+	}
+	defer C.free(unsafe.Pointer(proxies))
+
+	result := &Proxy{}
+	for _, line := range strings.Split(C.GoString(proxies), "\n") {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 2 && fields[0] == "pac":
+			result.PACURL = fields[1]
+		case len(fields) == 1 && fields[0] == "direct":
+			// The proxies after a direct connection are only used when it fails
+			return result, nil
+		case len(fields) == 3:
+			result.Proxies = append(result.Proxies, &url.URL{Scheme: fields[0], Host: net.JoinHostPort(fields[1], fields[2])})
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,129 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"net"
+	"net/url"
+	"os/exec"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	gnomeProxySchema = "org.gnome.system.proxy"
+
+	// The PACRunner of glib-networking evaluates the proxy auto-config scripts for GLib, and so for WebKitGTK
+	pacRunnerService   = "org.gtk.GLib.PACRunner"
+	pacRunnerPath      = dbus.ObjectPath("/org/gtk/GLib/PACRunner")
+	pacRunnerInterface = "org.gtk.GLib.PACRunner"
+)
+
+// platformResolveProxy resolves the proxy with the GNOME proxy settings, which are used by WebKitGTK. The proxy
+// auto-config script is evaluated by the PACRunner of glib-networking. The environment variables are used when the
+// GNOME settings are unavailable or the proxy mode is "none".
+func platformResolveProxy(target *url.URL) (*Proxy, error) {
+	settings := gnomeProxySettings()
+	switch settings[gnomeProxySchema+" mode"] {
+	case "manual":
+		return manualProxy(target, settings), nil
+	case "auto":
+		return autoProxy(target, settings[gnomeProxySchema+" autoconfig-url"])
+	default:
+		return environmentProxy(target)
+	}
+}
+
+// gnomeProxySettings returns the values of the proxy settings by "<schema> <key>". The values are GVariant text,
+// strings are unquoted.
+func gnomeProxySettings() map[string]string {
+	result := map[string]string{}
+	output, err := exec.Command("gsettings", "list-recursively", gnomeProxySchema).Output()
+	if err != nil {
+		return result
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		result[fields[0]+" "+fields[1]] = unquoteGVariant(fields[2])
+	}
+	return result
+}
+
+func unquoteGVariant(value string) string {
+	if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+		return strings.ReplaceAll(value[1:len(value)-1], `\'`, `'`)
+	}
+	return value
+}
+
+// gvariantStrings returns the strings of a GVariant text array, EG "['localhost', '127.0.0.0/8']"
+func gvariantStrings(value string) []string {
+	value = strings.TrimPrefix(value, "@as ")
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+	var result []string
+	for _, item := range strings.Split(value, ",") {
+		if item = unquoteGVariant(strings.TrimSpace(item)); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// manualProxy returns the proxy of the scheme of the URL, or the SOCKS proxy if there is none
+func manualProxy(target *url.URL, settings map[string]string) *Proxy {
+	result := &Proxy{}
+	if bypassed(target.Hostname(), gvariantStrings(settings[gnomeProxySchema+" ignore-hosts"])) {
+		return result
+	}
+	proxy := func(schema string, scheme string) *url.URL {
+		host, port := settings[gnomeProxySchema+"."+schema+" host"], settings[gnomeProxySchema+"."+schema+" port"]
+		if host == "" || port == "" || port == "0" {
+			return nil
+		}
+		return &url.URL{Scheme: scheme, Host: net.JoinHostPort(host, port)}
+	}
+	schema := target.Scheme
+	if schema != "https" && schema != "ftp" {
+		schema = "http"
+	}
+	if httpProxy := proxy(schema, "http"); httpProxy != nil {
+		result.Proxies = append(result.Proxies, httpProxy)
+	} else if socks := proxy("socks", "socks5"); socks != nil {
+		result.Proxies = append(result.Proxies, socks)
+	}
+	return result
+}
+
+// autoProxy evaluates the proxy auto-config script with the PACRunner. The script is auto-discovered with WPAD when
+// the URL is empty, the script URLs are given in the notation of libproxy like GLib does.
+func autoProxy(target *url.URL, pacURL string) (*Proxy, error) {
+	conn, err := dbus.SessionBus()
+	if err != nil {
+		return nil, err
+	}
+	script := "wpad://"
+	if pacURL != "" {
+		script = "pac+" + pacURL
+	}
+	var proxies []string
+	err = conn.Object(pacRunnerService, pacRunnerPath).Call(pacRunnerInterface+".Lookup", 0, script, target.String()).Store(&proxies)
+	if err != nil {
+		return nil, err
+	}
+	result := &Proxy{PACURL: pacURL}
+	for _, proxy := range proxies {
+		if strings.HasPrefix(proxy, "direct://") {
+			// The proxies after a direct connection are only used when it fails
+			break
+		}
+		if parsed, err := proxyURL("http", proxy); err == nil {
+			result.Proxies = append(result.Proxies, parsed)
+		}
+	}
+	return result, nil
+}
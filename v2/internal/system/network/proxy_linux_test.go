@@ -0,0 +1,71 @@
+//go:build linux
+// +build linux
+
+package network
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func Test_gvariantStrings(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "empty array", value: "@as []", want: nil},
+		{name: "empty", value: "", want: nil},
+		{name: "strings", value: "['localhost', '127.0.0.0/8', '::1']", want: []string{"localhost", "127.0.0.0/8", "::1"}},
+		{name: "escaped quote", value: `['it\'s']`, want: []string{"it's"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gvariantStrings(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("gvariantStrings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_manualProxy(t *testing.T) {
+	settings := map[string]string{
+		gnomeProxySchema + " ignore-hosts": "['localhost', '*.local']",
+		gnomeProxySchema + ".http host":    "http.example.com",
+		gnomeProxySchema + ".http port":    "8080",
+		gnomeProxySchema + ".https host":   "https.example.com",
+		gnomeProxySchema + ".https port":   "8443",
+		gnomeProxySchema + ".ftp host":     "ftp.example.com",
+		gnomeProxySchema + ".ftp port":     "0",
+		gnomeProxySchema + ".socks host":   "socks.example.com",
+		gnomeProxySchema + ".socks port":   "1080",
+	}
+	tests := []struct {
+		name string
+		url  string
+		want []string
+	}{
+		{name: "http", url: "http://wails.io", want: []string{"http://http.example.com:8080"}},
+		{name: "https", url: "https://wails.io", want: []string{"http://https.example.com:8443"}},
+		{name: "other schemes use the http proxy", url: "ws://wails.io", want: []string{"http://http.example.com:8080"}},
+		{name: "socks without a port for the scheme", url: "ftp://wails.io", want: []string{"socks5://socks.example.com:1080"}},
+		{name: "ignored host", url: "http://localhost:34115", want: nil},
+		{name: "ignored wildcard", url: "https://printer.local", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := url.Parse(tt.url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, proxy := range manualProxy(target, settings).Proxies {
+				got = append(got, proxy.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("manualProxy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
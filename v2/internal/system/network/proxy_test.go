@@ -0,0 +1,36 @@
+package network
+
+import "testing"
+
+func Test_bypassed(t *testing.T) {
+	tests := []struct {
+		name     string
+		host     string
+		patterns []string
+		want     bool
+	}{
+		{name: "no patterns", host: "example.com", patterns: nil, want: false},
+		{name: "exact host", host: "example.com", patterns: []string{"example.com"}, want: true},
+		{name: "subdomain", host: "www.example.com", patterns: []string{"example.com"}, want: true},
+		{name: "leading dot", host: "www.example.com", patterns: []string{".example.com"}, want: true},
+		{name: "not a subdomain", host: "badexample.com", patterns: []string{"example.com"}, want: false},
+		{name: "case insensitive", host: "WWW.Example.COM", patterns: []string{"example.com"}, want: true},
+		{name: "wildcard", host: "api.example.com", patterns: []string{"*.example.com"}, want: true},
+		{name: "wildcard without subdomain", host: "example.com", patterns: []string{"*.example.com"}, want: false},
+		{name: "local host name", host: "intranet", patterns: []string{"<local>"}, want: true},
+		{name: "local with dot", host: "intranet.example.com", patterns: []string{"<local>"}, want: false},
+		{name: "local IP", host: "::1", patterns: []string{"<local>"}, want: false},
+		{name: "IP address", host: "10.0.0.1", patterns: []string{"10.0.0.1"}, want: true},
+		{name: "CIDR block", host: "127.0.0.53", patterns: []string{"127.0.0.0/8"}, want: true},
+		{name: "outside CIDR block", host: "192.168.1.1", patterns: []string{"127.0.0.0/8"}, want: false},
+		{name: "CIDR block host name", host: "localhost", patterns: []string{"127.0.0.0/8"}, want: false},
+		{name: "blank patterns", host: "example.com", patterns: []string{"", "  ", " example.com "}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bypassed(tt.host, tt.patterns); got != tt.want {
+				t.Errorf("bypassed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,176 @@
+//go:build windows
+
+package network
+
+import (
+	"net/url"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+var (
+	winhttp                                   = windows.NewLazySystemDLL("winhttp.dll")
+	procWinHttpGetIEProxyConfigForCurrentUser = winhttp.NewProc("WinHttpGetIEProxyConfigForCurrentUser")
+	procWinHttpOpen                           = winhttp.NewProc("WinHttpOpen")
+	procWinHttpGetProxyForUrl                 = winhttp.NewProc("WinHttpGetProxyForUrl")
+	procWinHttpCloseHandle                    = winhttp.NewProc("WinHttpCloseHandle")
+
+	procGlobalFree = windows.NewLazySystemDLL("kernel32.dll").NewProc("GlobalFree")
+)
+
+const (
+	winhttpAccessTypeNoProxy    = 1
+	winhttpAccessTypeNamedProxy = 3
+
+	winhttpAutoProxyAutoDetect = 0x1
+	winhttpAutoProxyConfigURL  = 0x2
+	winhttpAutoDetectTypeDHCP  = 0x1
+	winhttpAutoDetectTypeDNSA  = 0x2
+)
+
+// WINHTTP_CURRENT_USER_IE_PROXY_CONFIG
+type winhttpCurrentUserIEProxyConfig struct {
+	AutoDetect    int32
+	AutoConfigURL *uint16
+	Proxy         *uint16
+	ProxyBypass   *uint16
+}
+
+// WINHTTP_AUTOPROXY_OPTIONS
+type winhttpAutoProxyOptions struct {
+	Flags                 uint32
+	AutoDetectFlags       uint32
+	AutoConfigURL         *uint16
+	reserved1             uintptr
+	reserved2             uint32
+	AutoLogonIfChallenged int32
+}
+
+// WINHTTP_PROXY_INFO
+type winhttpProxyInfo struct {
+	AccessType  uint32
+	Proxy       *uint16
+	ProxyBypass *uint16
+}
+
+// platformResolveProxy resolves the proxy with the settings of the current user, which are the settings of Windows
+// and WebView2. The proxy auto-config script is evaluated by WinHTTP when it is configured or auto-detected.
+func platformResolveProxy(target *url.URL) (*Proxy, error) {
+	var config winhttpCurrentUserIEProxyConfig
+	if ok, _, err := procWinHttpGetIEProxyConfigForCurrentUser.Call(uintptr(unsafe.Pointer(&config))); ok == 0 {
+		return nil, err
+	}
+	defer globalFree(config.AutoConfigURL)
+	defer globalFree(config.Proxy)
+	defer globalFree(config.ProxyBypass)
+
+	if config.AutoDetect != 0 || config.AutoConfigURL != nil {
+		result, err := autoProxy(target, config)
+		if err == nil {
+			return result, nil
+		}
+		// The static proxy or a direct connection is used when no script is auto-detected, and the static proxy when
+		// the configured script can't be evaluated, like Windows does
+		if config.Proxy == nil && config.AutoConfigURL != nil {
+			return nil, err
+		}
+	}
+
+	result := &Proxy{}
+	if config.Proxy == nil || bypassed(target.Hostname(), splitProxyList(windows.UTF16PtrToString(config.ProxyBypass))) {
+		return result, nil
+	}
+	result.Proxies = parseProxyList(windows.UTF16PtrToString(config.Proxy), target.Scheme)
+	return result, nil
+}
+
+// autoProxy evaluates the proxy auto-config script of the settings with WinHTTP
+func autoProxy(target *url.URL, config winhttpCurrentUserIEProxyConfig) (*Proxy, error) {
+	agent, err := windows.UTF16PtrFromString("Wails")
+	if err != nil {
+		return nil, err
+	}
+	session, _, err := procWinHttpOpen.Call(uintptr(unsafe.Pointer(agent)), winhttpAccessTypeNoProxy, 0, 0, 0)
+	if session == 0 {
+		return nil, err
+	}
+	defer procWinHttpCloseHandle.Call(session)
+
+	options := winhttpAutoProxyOptions{AutoLogonIfChallenged: 1}
+	result := &Proxy{}
+	if config.AutoConfigURL != nil {
+		options.Flags = winhttpAutoProxyConfigURL
+		options.AutoConfigURL = config.AutoConfigURL
+		result.PACURL = windows.UTF16PtrToString(config.AutoConfigURL)
+	} else {
+		options.Flags = winhttpAutoProxyAutoDetect
+		options.AutoDetectFlags = winhttpAutoDetectTypeDHCP | winhttpAutoDetectTypeDNSA
+	}
+
+	rawURL, err := windows.UTF16PtrFromString(target.String())
+	if err != nil {
+		return nil, err
+	}
+	var info winhttpProxyInfo
+	if ok, _, err := procWinHttpGetProxyForUrl.Call(session, uintptr(unsafe.Pointer(rawURL)), uintptr(unsafe.Pointer(&options)), uintptr(unsafe.Pointer(&info))); ok == 0 {
+		return nil, err
+	}
+	defer globalFree(info.Proxy)
+	defer globalFree(info.ProxyBypass)
+
+	if info.AccessType == winhttpAccessTypeNamedProxy && info.Proxy != nil {
+		result.Proxies = parseProxyList(windows.UTF16PtrToString(info.Proxy), target.Scheme)
+	}
+	return result, nil
+}
+
+// parseProxyList returns the proxies of a WinHTTP proxy list for the scheme. The entries are separated by semicolons
+// or whitespace and are "[<scheme>=][<scheme>://]<host>[:<port>]". The entries with the scheme of the URL are used,
+// otherwise the ones without a scheme, otherwise the SOCKS proxies.
+func parseProxyList(list string, scheme string) []*url.URL {
+	var matching, generic, socks []*url.URL
+	for _, entry := range splitProxyList(list) {
+		entryScheme, proxy, found := strings.Cut(entry, "=")
+		if !found {
+			entryScheme, proxy = "", entry
+		}
+		proxyScheme := "http"
+		if strings.EqualFold(entryScheme, "socks") {
+			proxyScheme = "socks4"
+		}
+		parsed, err := proxyURL(proxyScheme, proxy)
+		if err != nil {
+			continue
+		}
+		switch {
+		case !found:
+			generic = append(generic, parsed)
+		case strings.EqualFold(entryScheme, scheme):
+			matching = append(matching, parsed)
+		case proxyScheme == "socks4":
+			socks = append(socks, parsed)
+		}
+	}
+	switch {
+	case len(matching) > 0:
+		return matching
+	case len(generic) > 0:
+		return generic
+	default:
+		return socks
+	}
+}
+
+func splitProxyList(list string) []string {
+	return strings.FieldsFunc(list, func(r rune) bool {
+		return r == ';' || r == ' ' || r == '\t' || r == '\r' || r == '\n'
+	})
+}
+
+func globalFree(value *uint16) {
+	if value != nil {
+		procGlobalFree.Call(uintptr(unsafe.Pointer(value)))
+	}
+}
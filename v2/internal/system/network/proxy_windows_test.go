@@ -0,0 +1,61 @@
+//go:build windows
+
+package network
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_splitProxyList(t *testing.T) {
+	tests := []struct {
+		name string
+		list string
+		want []string
+	}{
+		{name: "empty", list: "", want: []string{}},
+		{name: "single", list: "proxy:8080", want: []string{"proxy:8080"}},
+		{name: "semicolons", list: "http=a:80;https=b:443", want: []string{"http=a:80", "https=b:443"}},
+		{name: "whitespace", list: "a:80 b:81\tc:82\r\nd:83", want: []string{"a:80", "b:81", "c:82", "d:83"}},
+		{name: "empty entries", list: ";;a:80; ;", want: []string{"a:80"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitProxyList(tt.list); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitProxyList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseProxyList(t *testing.T) {
+	tests := []struct {
+		name   string
+		list   string
+		scheme string
+		want   []string
+	}{
+		{name: "empty", list: "", scheme: "http", want: nil},
+		{name: "generic", list: "proxy:8080", scheme: "https", want: []string{"http://proxy:8080"}},
+		{name: "matching scheme", list: "http=a:80;https=b:443", scheme: "https", want: []string{"http://b:443"}},
+		{name: "scheme case", list: "HTTPS=b:443", scheme: "https", want: []string{"http://b:443"}},
+		{name: "matching before generic", list: "a:80;https=b:443", scheme: "https", want: []string{"http://b:443"}},
+		{name: "generic before socks", list: "socks=s:1080;a:80", scheme: "https", want: []string{"http://a:80"}},
+		{name: "socks fallback", list: "http=a:80;socks=s:1080", scheme: "https", want: []string{"socks4://s:1080"}},
+		{name: "explicit scheme", list: "https=socks5://s:1080", scheme: "https", want: []string{"socks5://s:1080"}},
+		{name: "other scheme only", list: "ftp=f:21", scheme: "https", want: nil},
+		{name: "several proxies", list: "a:80 b:81", scheme: "http", want: []string{"http://a:80", "http://b:81"}},
+		{name: "invalid entry", list: "http=;a:80", scheme: "http", want: []string{"http://a:80"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, proxy := range parseProxyList(tt.list, tt.scheme) {
+				got = append(got, proxy.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseProxyList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotSupported is returned on platforms without process management
+var ErrNotSupported = errors.New("the process management is not supported on this platform")
+
+// Priority is the scheduling priority of the process
+type Priority string
+
+const (
+	// PriorityEfficiency lowers the priority and lets the OS run the process in a power efficient way
+	PriorityEfficiency Priority = "efficiency"
+	// PriorityNormal is the default priority
+	PriorityNormal Priority = "normal"
+	// PriorityHigh raises the priority above other processes
+	PriorityHigh Priority = "high"
+)
+
+// SetPriority sets the scheduling priority of the current process
+func SetPriority(priority Priority) error {
+	switch priority {
+	case PriorityEfficiency, PriorityNormal, PriorityHigh:
+		return platformSetPriority(priority)
+	}
+	return fmt.Errorf("unknown process priority '%s'", priority)
+}
+
+// GetPriority returns the scheduling priority of the current process
+func GetPriority() (Priority, error) {
+	return platformGetPriority()
+}
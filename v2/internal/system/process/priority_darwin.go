@@ -0,0 +1,63 @@
+//go:build darwin
+// +build darwin
+
+package process
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	prioDarwinProcess = 4
+	prioDarwinBG      = 0x1000
+)
+
+// platformSetPriority uses the Darwin background state for the efficiency mode, which lowers the CPU, I/O and
+// network priority of the process. Raising the nice value for the high priority requires root privileges.
+func platformSetPriority(priority Priority) error {
+	switch priority {
+	case PriorityEfficiency:
+		return unix.Setpriority(prioDarwinProcess, 0, prioDarwinBG)
+	case PriorityHigh:
+		if err := unix.Setpriority(prioDarwinProcess, 0, 0); err != nil {
+			return err
+		}
+		err := unix.Setpriority(unix.PRIO_PROCESS, 0, -10)
+		if err == unix.EACCES || err == unix.EPERM {
+			return fmt.Errorf("the high process priority requires root privileges on macOS: %w", err)
+		}
+		return err
+	}
+
+	if err := unix.Setpriority(prioDarwinProcess, 0, 0); err != nil {
+		return err
+	}
+	nice, err := unix.Getpriority(unix.PRIO_PROCESS, 0)
+	if err != nil {
+		return err
+	}
+	if nice < 0 {
+		return unix.Setpriority(unix.PRIO_PROCESS, 0, 0)
+	}
+	return nil
+}
+
+func platformGetPriority() (Priority, error) {
+	background, err := unix.Getpriority(prioDarwinProcess, 0)
+	if err != nil {
+		return "", err
+	}
+	if background != 0 {
+		return PriorityEfficiency, nil
+	}
+	nice, err := unix.Getpriority(unix.PRIO_PROCESS, 0)
+	if err != nil {
+		return "", err
+	}
+	if nice < 0 {
+		return PriorityHigh, nil
+	}
+	return PriorityNormal, nil
+}
@@ -0,0 +1,54 @@
+//go:build linux
+// +build linux
+
+package process
+
+import (
+	"os"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+)
+
+var niceValues = map[Priority]int{
+	PriorityEfficiency: 19,
+	PriorityNormal:     0,
+	PriorityHigh:       -10,
+}
+
+// platformSetPriority sets the nice value of all threads, on Linux the nice value is a per-thread attribute. Lowering
+// the nice value, which is needed for the high priority and to return from the efficiency mode, requires
+// CAP_SYS_NICE or a matching RLIMIT_NICE.
+func platformSetPriority(priority Priority) error {
+	tasks, err := os.ReadDir("/proc/self/task")
+	if err != nil {
+		return err
+	}
+	nice := niceValues[priority]
+	for _, task := range tasks {
+		tid, err := strconv.Atoi(task.Name())
+		if err != nil {
+			continue
+		}
+		if err := unix.Setpriority(unix.PRIO_PROCESS, tid, nice); err != nil && err != unix.ESRCH {
+			return err
+		}
+	}
+	return nil
+}
+
+func platformGetPriority() (Priority, error) {
+	// The raw syscall returns 20 - nice
+	prio, err := unix.Getpriority(unix.PRIO_PROCESS, os.Getpid())
+	if err != nil {
+		return "", err
+	}
+	nice := 20 - prio
+	switch {
+	case nice > 0:
+		return PriorityEfficiency, nil
+	case nice < 0:
+		return PriorityHigh, nil
+	}
+	return PriorityNormal, nil
+}
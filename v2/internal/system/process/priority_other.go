@@ -0,0 +1,11 @@
+//go:build !darwin && !linux && !windows
+
+package process
+
+func platformSetPriority(priority Priority) error {
+	return ErrNotSupported
+}
+
+func platformGetPriority() (Priority, error) {
+	return "", ErrNotSupported
+}
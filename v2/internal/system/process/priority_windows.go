@@ -0,0 +1,79 @@
+//go:build windows
+// +build windows
+
+package process
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	processPowerThrottling                      = 4
+	processPowerThrottlingCurrentVersion        = 1
+	processPowerThrottlingExecutionSpeed        = 0x1
+	processPowerThrottlingIgnoreTimerResolution = 0x4
+)
+
+type processPowerThrottlingState struct {
+	Version     uint32
+	ControlMask uint32
+	StateMask   uint32
+}
+
+var procSetProcessInformation = windows.NewLazySystemDLL("kernel32.dll").NewProc("SetProcessInformation")
+
+// platformSetPriority sets the priority class and the EcoQoS state of the process. This is the same as the
+// efficiency mode of the Task Manager.
+func platformSetPriority(priority Priority) error {
+	var priorityClass uint32
+	var throttling processPowerThrottlingState
+	switch priority {
+	case PriorityEfficiency:
+		priorityClass = windows.IDLE_PRIORITY_CLASS
+		throttling.ControlMask = processPowerThrottlingExecutionSpeed | processPowerThrottlingIgnoreTimerResolution
+		throttling.StateMask = throttling.ControlMask
+	case PriorityHigh:
+		priorityClass = windows.HIGH_PRIORITY_CLASS
+		// Explicitly opt out of throttling
+		throttling.ControlMask = processPowerThrottlingExecutionSpeed | processPowerThrottlingIgnoreTimerResolution
+	default:
+		priorityClass = windows.NORMAL_PRIORITY_CLASS
+		// Let the system decide
+	}
+
+	if err := windows.SetPriorityClass(windows.CurrentProcess(), priorityClass); err != nil {
+		return err
+	}
+
+	// SetProcessInformation requires Windows 8, the power throttling state is honoured since Windows 10 1709 and runs
+	// the process with EcoQoS on Windows 11
+	if procSetProcessInformation.Find() == nil {
+		throttling.Version = processPowerThrottlingCurrentVersion
+		ret, _, err := procSetProcessInformation.Call(
+			uintptr(windows.CurrentProcess()),
+			processPowerThrottling,
+			uintptr(unsafe.Pointer(&throttling)),
+			unsafe.Sizeof(throttling),
+		)
+		if ret == 0 {
+			return err
+		}
+	}
+	return nil
+}
+
+func platformGetPriority() (Priority, error) {
+	priorityClass, err := windows.GetPriorityClass(windows.CurrentProcess())
+	if err != nil {
+		return "", err
+	}
+	switch priorityClass {
+	case windows.IDLE_PRIORITY_CLASS, windows.BELOW_NORMAL_PRIORITY_CLASS:
+		return PriorityEfficiency, nil
+	case windows.ABOVE_NORMAL_PRIORITY_CLASS, windows.HIGH_PRIORITY_CLASS, windows.REALTIME_PRIORITY_CLASS:
+		return PriorityHigh, nil
+	}
+	return PriorityNormal, nil
+}
@@ -0,0 +1,155 @@
+package process
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// Stream identifies an output of a spawned process
+type Stream string
+
+const (
+	Stdout Stream = "stdout"
+	Stderr Stream = "stderr"
+)
+
+// SpawnOptions configures a process started with Spawn
+type SpawnOptions struct {
+	// Dir is the working directory of the process, the working directory of the application is used if empty
+	Dir string
+	// Env contains "KEY=value" entries added to the environment of the application
+	Env []string
+	// Stdin is read by the process, it has no input if nil
+	Stdin io.Reader
+}
+
+// Process is a process started with Spawn. It is terminated along with the processes it started when Kill or
+// TerminateAll is called.
+type Process struct {
+	cmd   *exec.Cmd
+	group processGroup
+
+	done     chan struct{}
+	exitCode int
+	err      error
+}
+
+var (
+	runningLock sync.Mutex
+	running     = map[*Process]struct{}{}
+)
+
+// Spawn starts the command in its own process group. onOutput is called with every line written by the process
+// and onExit once both outputs have been closed and the process has exited. The callbacks are called on a goroutine
+// of the process, the lines of an output are reported in order.
+func Spawn(name string, args []string, options SpawnOptions, onOutput func(stream Stream, line string), onExit func(code int, err error)) (*Process, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Dir = options.Dir
+	if len(options.Env) > 0 {
+		cmd.Env = append(os.Environ(), options.Env...)
+	}
+	cmd.Stdin = options.Stdin
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Process{cmd: cmd, done: make(chan struct{})}
+	if err := start(cmd, &result.group); err != nil {
+		return nil, err
+	}
+
+	runningLock.Lock()
+	running[result] = struct{}{}
+	runningLock.Unlock()
+
+	var outputs sync.WaitGroup
+	outputs.Add(2)
+	go readLines(stdout, Stdout, onOutput, &outputs)
+	go readLines(stderr, Stderr, onOutput, &outputs)
+	go func() {
+		// The pipes must be read to the end before waiting, Wait closes them
+		outputs.Wait()
+		result.wait()
+		if onExit != nil {
+			onExit(result.exitCode, result.err)
+		}
+	}()
+	return result, nil
+}
+
+func readLines(output io.Reader, stream Stream, onOutput func(stream Stream, line string), outputs *sync.WaitGroup) {
+	defer outputs.Done()
+	reader := bufio.NewReader(output)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" && onOutput != nil {
+			onOutput(stream, strings.TrimRight(line, "\r\n"))
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (p *Process) wait() {
+	err := p.cmd.Wait()
+	var exitError *exec.ExitError
+	if errors.As(err, &exitError) {
+		// A non-zero exit code isn't an error of the process management
+		err = nil
+	}
+	p.exitCode = p.cmd.ProcessState.ExitCode()
+	p.err = err
+	releaseGroup(&p.group)
+
+	runningLock.Lock()
+	delete(running, p)
+	runningLock.Unlock()
+	close(p.done)
+}
+
+// PID returns the process ID
+func (p *Process) PID() int {
+	return p.cmd.Process.Pid
+}
+
+// Kill terminates the process and the processes it started
+func (p *Process) Kill() error {
+	select {
+	case <-p.done:
+		return nil
+	default:
+	}
+	return killGroup(p.cmd.Process, &p.group)
+}
+
+// Wait blocks until the process has exited and returns its exit code, which is -1 if the process has been
+// terminated by a signal
+func (p *Process) Wait() (int, error) {
+	<-p.done
+	return p.exitCode, p.err
+}
+
+// TerminateAll kills the running processes started with Spawn, this is called when the application quits
+func TerminateAll() {
+	runningLock.Lock()
+	processes := make([]*Process, 0, len(running))
+	for process := range running {
+		processes = append(processes, process)
+	}
+	runningLock.Unlock()
+
+	for _, process := range processes {
+		_ = process.Kill()
+	}
+}
@@ -0,0 +1,31 @@
+//go:build darwin
+// +build darwin
+
+package process
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// processGroup holds no state on macOS. Setpgid makes the process the leader of a new process group, which has the ID
+// of the process, so killGroup signals the group by the process ID.
+type processGroup struct{}
+
+// start starts the command in a new process group. macOS has no parent death signal, so the processes are only
+// terminated if the application quits normally.
+func start(cmd *exec.Cmd, group *processGroup) error {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	return cmd.Start()
+}
+
+func killGroup(process *os.Process, group *processGroup) error {
+	err := syscall.Kill(-process.Pid, syscall.SIGKILL)
+	if err == syscall.ESRCH {
+		return nil
+	}
+	return err
+}
+
+func releaseGroup(group *processGroup) {}
@@ -0,0 +1,49 @@
+//go:build linux
+// +build linux
+
+package process
+
+import (
+	"os"
+	"os/exec"
+	goruntime "runtime"
+	"syscall"
+)
+
+// processGroup holds no state on Linux. The process leads a process group with its ID and is also tied to the
+// lifetime of the application by the parent death signal of the spawner thread.
+type processGroup struct{}
+
+// spawner starts the processes on a locked thread which never exits. The parent death signal is sent when the
+// thread which started the process exits, not the process, and Go may exit threads while the application is running.
+var spawner = make(chan func())
+
+func init() {
+	go func() {
+		goruntime.LockOSThread()
+		for start := range spawner {
+			start()
+		}
+	}()
+}
+
+// start starts the command in a new process group. The kernel kills the process if the application dies without
+// terminating it, which doesn't apply to the processes it started.
+func start(cmd *exec.Cmd, group *processGroup) error {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true, Pdeathsig: syscall.SIGKILL}
+	result := make(chan error, 1)
+	spawner <- func() {
+		result <- cmd.Start()
+	}
+	return <-result
+}
+
+func killGroup(process *os.Process, group *processGroup) error {
+	err := syscall.Kill(-process.Pid, syscall.SIGKILL)
+	if err == syscall.ESRCH {
+		return nil
+	}
+	return err
+}
+
+func releaseGroup(group *processGroup) {}
@@ -0,0 +1,21 @@
+//go:build !darwin && !linux && !windows
+
+package process
+
+import (
+	"os"
+	"os/exec"
+)
+
+// processGroup isn't used, the processes can't be spawned on this platform
+type processGroup struct{}
+
+func start(cmd *exec.Cmd, group *processGroup) error {
+	return ErrNotSupported
+}
+
+func killGroup(process *os.Process, group *processGroup) error {
+	return ErrNotSupported
+}
+
+func releaseGroup(group *processGroup) {}
@@ -0,0 +1,114 @@
+//go:build windows
+// +build windows
+
+package process
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// processGroup is the job object of the process. The job is closed by the system when the application dies, which
+// terminates the processes of the job. The lock guards the handle, which is closed when the process has exited while
+// Kill may be called concurrently.
+type processGroup struct {
+	lock sync.Mutex
+	job  windows.Handle
+}
+
+// start starts the command suspended without a console window, assigns it to a new job object which kills its
+// processes when it is closed and resumes it. The process can't start other processes before it is in the job, so
+// they are all added to it.
+func start(cmd *exec.Cmd, group *processGroup) error {
+	cmd.SysProcAttr = &syscall.SysProcAttr{HideWindow: true, CreationFlags: windows.CREATE_NO_WINDOW | windows.CREATE_SUSPENDED}
+
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return err
+	}
+	info := windows.JOBOBJECT_EXTENDED_LIMIT_INFORMATION{
+		BasicLimitInformation: windows.JOBOBJECT_BASIC_LIMIT_INFORMATION{
+			LimitFlags: windows.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE,
+		},
+	}
+	if _, err := windows.SetInformationJobObject(job, windows.JobObjectExtendedLimitInformation, uintptr(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info))); err != nil {
+		_ = windows.CloseHandle(job)
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		_ = windows.CloseHandle(job)
+		return err
+	}
+	if err := assignToJob(job, cmd.Process.Pid); err == nil {
+		err = resumeProcess(cmd.Process.Pid)
+	}
+	if err != nil {
+		_ = cmd.Process.Kill()
+		_ = windows.CloseHandle(job)
+		_ = cmd.Wait()
+		return err
+	}
+	group.job = job
+	return nil
+}
+
+func assignToJob(job windows.Handle, pid int) error {
+	process, err := windows.OpenProcess(windows.PROCESS_SET_QUOTA|windows.PROCESS_TERMINATE, false, uint32(pid))
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(process)
+	return windows.AssignProcessToJobObject(job, process)
+}
+
+// resumeProcess resumes the main thread of a process created suspended. os/exec closes the handle of the thread, so
+// it is found with a snapshot of the threads. The suspended process has no other threads.
+func resumeProcess(pid int) error {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPTHREAD, 0)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(snapshot)
+
+	entry := windows.ThreadEntry32{Size: uint32(unsafe.Sizeof(windows.ThreadEntry32{}))}
+	for err = windows.Thread32First(snapshot, &entry); err == nil; err = windows.Thread32Next(snapshot, &entry) {
+		if entry.OwnerProcessID != uint32(pid) {
+			continue
+		}
+		thread, err := windows.OpenThread(windows.THREAD_SUSPEND_RESUME, false, entry.ThreadID)
+		if err != nil {
+			return err
+		}
+		_, err = windows.ResumeThread(thread)
+		_ = windows.CloseHandle(thread)
+		return err
+	}
+	return errors.New("unable to find the main thread of the process")
+}
+
+func killGroup(process *os.Process, group *processGroup) error {
+	group.lock.Lock()
+	defer group.lock.Unlock()
+	// The job has been released if the process has exited
+	if group.job == 0 {
+		return nil
+	}
+	return windows.TerminateJobObject(group.job, 1)
+}
+
+// releaseGroup closes the job, which terminates the processes started by the process that are still running
+func releaseGroup(group *processGroup) {
+	group.lock.Lock()
+	defer group.lock.Unlock()
+	if group.job != 0 {
+		_ = windows.CloseHandle(group.job)
+		group.job = 0
+	}
+}
@@ -0,0 +1,165 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Root is the predefined key a Key is located in
+type Root string
+
+const (
+	CurrentUser  Root = "HKCU"
+	LocalMachine Root = "HKLM"
+	ClassesRoot  Root = "HKCR"
+)
+
+// View selects the registry view used by the 32-bit and 64-bit applications on 64-bit Windows
+type View string
+
+const (
+	// ViewDefault uses the view matching the architecture of the application
+	ViewDefault View = ""
+	View32      View = "32"
+	View64      View = "64"
+)
+
+// Key identifies a registry key, EG: Key{Root: CurrentUser, Path: `Software\MyCompany\MyApp`}
+type Key struct {
+	Root Root   `json:"root"`
+	Path string `json:"path"`
+	View View   `json:"view,omitempty"`
+}
+
+// ValueType is the type of a registry value
+type ValueType string
+
+const (
+	String       ValueType = "string"
+	ExpandString ValueType = "expand-string"
+	DWord        ValueType = "dword"
+	MultiString  ValueType = "multi-string"
+)
+
+// Value is a registry value, only the field matching the Type is used
+type Value struct {
+	Type ValueType `json:"type"`
+	// String is used by String and ExpandString, the environment variables of an ExpandString are not expanded
+	String  string   `json:"string,omitempty"`
+	DWord   uint32   `json:"dword,omitempty"`
+	Strings []string `json:"strings,omitempty"`
+}
+
+var (
+	ErrNotSupported = errors.New("the registry is only supported on Windows")
+	ErrNotExist     = errors.New("registry key or value does not exist")
+	ErrNotWritable  = errors.New("writing to the registry key is not allowed")
+)
+
+var (
+	writableLock sync.Mutex
+	writable     []Key
+)
+
+// AppKey returns the key of the application, HKCU\Software\<name>. The name of the executable is used if the name is
+// empty.
+func AppKey(name string) Key {
+	if name == "" {
+		executable, _ := os.Executable()
+		name = strings.TrimSuffix(filepath.Base(executable), filepath.Ext(executable))
+	}
+	return Key{Root: CurrentUser, Path: `Software\` + name}
+}
+
+// AllowWrite allows Set and Delete to write to the keys and their subkeys. A key with the default view allows both
+// views.
+func AllowWrite(keys ...Key) error {
+	for _, key := range keys {
+		if err := validateKey(key); err != nil {
+			return err
+		}
+	}
+	writableLock.Lock()
+	defer writableLock.Unlock()
+	writable = append(writable, keys...)
+	return nil
+}
+
+// isWritable returns true if the key is one of the allowed keys or one of their subkeys. The paths are
+// case-insensitive like the registry.
+func isWritable(key Key) bool {
+	path := strings.ToLower(strings.Trim(key.Path, `\`))
+	writableLock.Lock()
+	defer writableLock.Unlock()
+	for _, allowed := range writable {
+		if allowed.Root != key.Root || (allowed.View != ViewDefault && allowed.View != key.View) {
+			continue
+		}
+		prefix := strings.ToLower(strings.Trim(allowed.Path, `\`))
+		if path == prefix || strings.HasPrefix(path, prefix+`\`) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the named value of the key. An empty name returns the default value of the key.
+func Get(key Key, name string) (Value, error) {
+	if err := validateKey(key); err != nil {
+		return Value{}, err
+	}
+	return platformGet(key, name)
+}
+
+// Set sets the named value of the key, the key is created if it doesn't exist. ErrNotWritable is returned if the key
+// hasn't been allowed with AllowWrite.
+func Set(key Key, name string, value Value) error {
+	if err := validateWrite(key); err != nil {
+		return err
+	}
+	switch value.Type {
+	case String, ExpandString, DWord, MultiString:
+		return platformSet(key, name, value)
+	}
+	return fmt.Errorf("unsupported registry value type '%s'", value.Type)
+}
+
+// Delete deletes the named value of the key. ErrNotWritable is returned if the key hasn't been allowed with
+// AllowWrite.
+func Delete(key Key, name string) error {
+	if err := validateWrite(key); err != nil {
+		return err
+	}
+	return platformDelete(key, name)
+}
+
+func validateWrite(key Key) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	if !isWritable(key) {
+		return fmt.Errorf("%w: %s\\%s", ErrNotWritable, key.Root, key.Path)
+	}
+	return nil
+}
+
+func validateKey(key Key) error {
+	switch key.Root {
+	case CurrentUser, LocalMachine, ClassesRoot:
+	default:
+		return fmt.Errorf("unknown registry root '%s'", key.Root)
+	}
+	switch key.View {
+	case ViewDefault, View32, View64:
+	default:
+		return fmt.Errorf("unknown registry view '%s'", key.View)
+	}
+	if key.Path == "" {
+		return errors.New("empty registry key path")
+	}
+	return nil
+}
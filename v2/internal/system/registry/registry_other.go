@@ -0,0 +1,16 @@
+//go:build !windows
+// +build !windows
+
+package registry
+
+func platformGet(key Key, name string) (Value, error) {
+	return Value{}, ErrNotSupported
+}
+
+func platformSet(key Key, name string, value Value) error {
+	return ErrNotSupported
+}
+
+func platformDelete(key Key, name string) error {
+	return ErrNotSupported
+}
@@ -0,0 +1,44 @@
+package registry
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsWritable(t *testing.T) {
+	writable = []Key{
+		{Root: CurrentUser, Path: `Software\MyCompany\MyApp`},
+		{Root: LocalMachine, Path: `Software\MyCompany\Shared\`, View: View64},
+	}
+	defer func() { writable = nil }()
+
+	tests := []struct {
+		name string
+		key  Key
+		want bool
+	}{
+		{name: "allowed key", key: Key{Root: CurrentUser, Path: `Software\MyCompany\MyApp`}, want: true},
+		{name: "subkey", key: Key{Root: CurrentUser, Path: `Software\MyCompany\MyApp\Window`}, want: true},
+		{name: "case difference", key: Key{Root: CurrentUser, Path: `software\mycompany\MYAPP`}, want: true},
+		{name: "any view of a default view key", key: Key{Root: CurrentUser, Path: `Software\MyCompany\MyApp`, View: View32}, want: true},
+		{name: "parent key", key: Key{Root: CurrentUser, Path: `Software\MyCompany`}, want: false},
+		{name: "sibling with the same prefix", key: Key{Root: CurrentUser, Path: `Software\MyCompany\MyAppUpdater`}, want: false},
+		{name: "other root", key: Key{Root: LocalMachine, Path: `Software\MyCompany\MyApp`}, want: false},
+		{name: "trailing separator of the allowed key", key: Key{Root: LocalMachine, Path: `Software\MyCompany\Shared\Data`, View: View64}, want: true},
+		{name: "other view", key: Key{Root: LocalMachine, Path: `Software\MyCompany\Shared`, View: View32}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isWritable(tt.key); got != tt.want {
+				t.Errorf("isWritable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetNotWritable(t *testing.T) {
+	err := Set(Key{Root: LocalMachine, Path: `Software\Microsoft\Windows\CurrentVersion\Run`}, "MyApp", Value{Type: String, String: "MyApp.exe"})
+	if !errors.Is(err, ErrNotWritable) {
+		t.Errorf("Set() error = %v, want %v", err, ErrNotWritable)
+	}
+}
@@ -0,0 +1,103 @@
+//go:build windows
+// +build windows
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/sys/windows/registry"
+)
+
+var roots = map[Root]registry.Key{
+	CurrentUser:  registry.CURRENT_USER,
+	LocalMachine: registry.LOCAL_MACHINE,
+	ClassesRoot:  registry.CLASSES_ROOT,
+}
+
+// access adds the flag selecting the view to the access rights. Without a flag, the keys of a 32-bit application
+// on 64-bit Windows are redirected to the 32-bit view.
+func access(key Key, access uint32) uint32 {
+	switch key.View {
+	case View32:
+		return access | registry.WOW64_32KEY
+	case View64:
+		return access | registry.WOW64_64KEY
+	}
+	return access
+}
+
+func openKey(key Key, rights uint32) (registry.Key, error) {
+	k, err := registry.OpenKey(roots[key.Root], key.Path, access(key, rights))
+	return k, mapError(err)
+}
+
+func mapError(err error) error {
+	if errors.Is(err, registry.ErrNotExist) {
+		return ErrNotExist
+	}
+	return err
+}
+
+func platformGet(key Key, name string) (Value, error) {
+	k, err := openKey(key, registry.QUERY_VALUE)
+	if err != nil {
+		return Value{}, err
+	}
+	defer k.Close()
+
+	_, valueType, err := k.GetValue(name, nil)
+	if err != nil {
+		return Value{}, mapError(err)
+	}
+
+	var result Value
+	switch valueType {
+	case registry.SZ, registry.EXPAND_SZ:
+		result.Type = String
+		if valueType == registry.EXPAND_SZ {
+			result.Type = ExpandString
+		}
+		result.String, _, err = k.GetStringValue(name)
+	case registry.DWORD:
+		var dword uint64
+		result.Type = DWord
+		dword, _, err = k.GetIntegerValue(name)
+		result.DWord = uint32(dword)
+	case registry.MULTI_SZ:
+		result.Type = MultiString
+		result.Strings, _, err = k.GetStringsValue(name)
+	default:
+		return Value{}, fmt.Errorf("unsupported registry value type %d", valueType)
+	}
+	return result, mapError(err)
+}
+
+func platformSet(key Key, name string, value Value) error {
+	k, _, err := registry.CreateKey(roots[key.Root], key.Path, access(key, registry.SET_VALUE))
+	if err != nil {
+		return err
+	}
+	defer k.Close()
+
+	switch value.Type {
+	case String:
+		return k.SetStringValue(name, value.String)
+	case ExpandString:
+		return k.SetExpandStringValue(name, value.String)
+	case DWord:
+		return k.SetDWordValue(name, value.DWord)
+	default:
+		return k.SetStringsValue(name, value.Strings)
+	}
+}
+
+func platformDelete(key Key, name string) error {
+	k, err := openKey(key, registry.SET_VALUE)
+	if err != nil {
+		return err
+	}
+	defer k.Close()
+	return mapError(k.DeleteValue(name))
+}
@@ -0,0 +1,115 @@
+package sidecar
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strings"
+	"sync"
+)
+
+var (
+	lock     sync.Mutex
+	embedded fs.FS
+)
+
+// SetEmbedded sets the file system of the sidecars embedded in the executable
+func SetEmbedded(fsys fs.FS) {
+	lock.Lock()
+	defer lock.Unlock()
+	embedded = fsys
+}
+
+// FileName is the name of the sidecar placed next to the executable by the build
+func FileName(name string, goos string) string {
+	if goos == "windows" {
+		return name + ".exe"
+	}
+	return name
+}
+
+// FileNames returns the names of the files of the sidecar for the platform in order of preference:
+// "name-goos-goarch", "name-goos" and "name", with the ".exe" extension on Windows
+func FileNames(name string, goos string, goarch string) []string {
+	return []string{
+		FileName(name+"-"+goos+"-"+goarch, goos),
+		FileName(name+"-"+goos, goos),
+		FileName(name, goos),
+	}
+}
+
+// Path returns the path of the sidecar. The sidecar placed next to the executable is used if there is one,
+// otherwise the embedded sidecar is extracted to the cache directory of the user.
+func Path(name string) (string, error) {
+	if name == "" || strings.ContainsAny(name, `/\`) || name == "." || name == ".." {
+		return "", fmt.Errorf("invalid sidecar name '%s'", name)
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	if resolved, err := filepath.EvalSymlinks(executable); err == nil {
+		executable = resolved
+	}
+	placed := filepath.Join(filepath.Dir(executable), FileName(name, goruntime.GOOS))
+	if info, err := os.Stat(placed); err == nil && !info.IsDir() {
+		return placed, nil
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	if embedded != nil {
+		for _, filename := range FileNames(name, goruntime.GOOS, goruntime.GOARCH) {
+			data, err := fs.ReadFile(embedded, filename)
+			if err == nil {
+				appName := strings.TrimSuffix(filepath.Base(executable), filepath.Ext(executable))
+				return extract(appName, FileName(name, goruntime.GOOS), data)
+			}
+		}
+	}
+	return "", fmt.Errorf("sidecar '%s' not found", name)
+}
+
+// extract writes the sidecar to a directory named after the hash of its content, so a new version of the
+// application doesn't run the sidecar of an older one. The file is renamed once it has been written, so a partial
+// file is never executed.
+func extract(appName string, filename string, data []byte) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(data)
+	dir := filepath.Join(cacheDir, appName, "sidecars", hex.EncodeToString(hash[:8]))
+	target := filepath.Join(dir, filename)
+	if existing, err := os.ReadFile(target); err == nil && bytes.Equal(existing, data) {
+		return target, nil
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	temp, err := os.CreateTemp(dir, filename+".*")
+	if err != nil {
+		return "", err
+	}
+	_, err = temp.Write(data)
+	if closeErr := temp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(temp.Name(), 0o755)
+	}
+	if err == nil {
+		err = os.Rename(temp.Name(), target)
+	}
+	if err != nil {
+		_ = os.Remove(temp.Name())
+		return "", err
+	}
+	return target, nil
+}
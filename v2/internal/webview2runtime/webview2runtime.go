@@ -43,23 +43,41 @@ func (i *Info) IsOlderThan(requiredVersion string) (bool, error) {
 	return result == -1, nil
 }
 
-func downloadBootstrapper() (string, error) {
+// progressWriter reports the number of bytes written to it
+type progressWriter struct {
+	received int64
+	total    int64
+	progress func(bytesReceived, totalBytes int64)
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.received += int64(len(p))
+	w.progress(w.received, w.total)
+	return len(p), nil
+}
+
+// downloadBootstrapper downloads the bootstrapper, the progress is called with -1 as total if the size is unknown
+func downloadBootstrapper(progress func(bytesReceived, totalBytes int64)) (string, error) {
 	bootstrapperURL := `https://go.microsoft.com/fwlink/p/?LinkId=2124703`
 	installer := filepath.Join(os.TempDir(), `MicrosoftEdgeWebview2Setup.exe`)
 
 	// Download installer
 	out, err := os.Create(installer)
-	defer out.Close()
 	if err != nil {
 		return "", err
 	}
+	defer out.Close()
 	resp, err := http.Get(bootstrapperURL)
-	defer resp.Body.Close()
 	if err != nil {
-		err = out.Close()
 		return "", err
 	}
-	_, err = io.Copy(out, resp.Body)
+	defer resp.Body.Close()
+	var body io.Reader = resp.Body
+	if progress != nil {
+		// The ContentLength is -1 if the size is unknown
+		body = io.TeeReader(resp.Body, &progressWriter{total: resp.ContentLength, progress: progress})
+	}
+	_, err = io.Copy(out, body)
 	if err != nil {
 		return "", err
 	}
@@ -87,11 +105,12 @@ func InstallUsingEmbeddedBootstrapper() (bool, error) {
 
 // InstallUsingBootstrapper will extract the embedded bootstrapper from Microsoft and run it to install
 // the latest version of the runtime.
+// The progress of the download is reported to progress, if it isn't nil.
 // Returns true if the installer ran successfully.
 // Returns an error if something goes wrong
-func InstallUsingBootstrapper() (bool, error) {
+func InstallUsingBootstrapper(progress func(bytesReceived, totalBytes int64)) (bool, error) {
 
-	installer, err := downloadBootstrapper()
+	installer, err := downloadBootstrapper(progress)
 	if err != nil {
 		return false, err
 	}
@@ -9,8 +9,8 @@ import (
 	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
 
-func doInstallationStrategy(installStatus installationStatus, messages *windows.Messages) error {
-	confirmed, err := webview2runtime.Confirm(messages.DownloadPage+MinimumRuntimeVersion, messages.MissingRequirements)
+func doInstallationStrategy(installStatus installationStatus, messages *windows.Messages, callbacks installCallbacks) error {
+	confirmed, err := webview2runtime.Confirm(messages.DownloadPage, messages.MissingRequirements)
 	if err != nil {
 		return err
 	}
@@ -4,13 +4,14 @@
 package wv2installer
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/wailsapp/wails/v2/internal/webview2runtime"
 	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
 
-func doInstallationStrategy(installStatus installationStatus, messages *windows.Messages) error {
+func doInstallationStrategy(installStatus installationStatus, messages *windows.Messages, callbacks installCallbacks) error {
 	message := messages.InstallationRequired
 	if installStatus == needsUpdating {
 		message = messages.UpdateRequired
@@ -22,14 +23,17 @@ func doInstallationStrategy(installStatus installationStatus, messages *windows.
 	if !confirmed {
 		return fmt.Errorf(messages.Webview2NotInstalled)
 	}
-	installedCorrectly, err := webview2runtime.InstallUsingBootstrapper()
+	installedCorrectly, err := webview2runtime.InstallUsingBootstrapper(callbacks.progress)
 	if err != nil {
 		_ = webview2runtime.Error(err.Error(), messages.Error)
+		callbacks.complete(err)
 		return err
 	}
 	if !installedCorrectly {
+		callbacks.complete(errors.New(messages.FailedToInstall))
 		err = webview2runtime.Error(messages.FailedToInstall, messages.Error)
 		return err
 	}
+	callbacks.complete(nil)
 	return nil
 }
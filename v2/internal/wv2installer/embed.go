@@ -4,12 +4,13 @@
 package wv2installer
 
 import (
+	"errors"
 	"fmt"
 	"github.com/wailsapp/wails/v2/internal/webview2runtime"
 	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
 
-func doInstallationStrategy(installStatus installationStatus, messages *windows.Messages) error {
+func doInstallationStrategy(installStatus installationStatus, messages *windows.Messages, callbacks installCallbacks) error {
 	message := messages.InstallationRequired
 	if installStatus == needsUpdating {
 		message = messages.UpdateRequired
@@ -25,11 +26,14 @@ func doInstallationStrategy(installStatus installationStatus, messages *windows.
 	installedCorrectly, err := webview2runtime.InstallUsingEmbeddedBootstrapper()
 	if err != nil {
 		_ = webview2runtime.Error(err.Error(), messages.Error)
+		callbacks.complete(err)
 		return err
 	}
 	if !installedCorrectly {
+		callbacks.complete(errors.New(messages.FailedToInstall))
 		err = webview2runtime.Error(messages.FailedToInstall, messages.Error)
 		return err
 	}
+	callbacks.complete(nil)
 	return nil
 }
@@ -9,7 +9,7 @@ import (
 	"github.com/wailsapp/wails/v2/pkg/options/windows"
 )
 
-func doInstallationStrategy(installStatus installationStatus, messages *windows.Messages) error {
+func doInstallationStrategy(installStatus installationStatus, messages *windows.Messages, callbacks installCallbacks) error {
 	_ = webview2runtime.Error(messages.ContactAdmin, messages.Error)
 	return fmt.Errorf(messages.Webview2NotInstalled)
 }
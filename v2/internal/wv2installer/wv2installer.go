@@ -4,6 +4,8 @@ package wv2installer
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/wailsapp/go-webview2/webviewloader"
 	"github.com/wailsapp/wails/v2/pkg/options"
@@ -14,6 +16,25 @@ const MinimumRuntimeVersion string = "94.0.992.31" // WebView2 SDK 1.0.992.28
 
 type installationStatus int
 
+// installCallbacks are the callbacks of the options reporting the installation of the runtime
+type installCallbacks struct {
+	onProgress func(bytesReceived, totalBytes int64)
+	onComplete func(err error)
+}
+
+func (c installCallbacks) progress(bytesReceived, totalBytes int64) {
+	if c.onProgress != nil {
+		c.onProgress(bytesReceived, totalBytes)
+	}
+}
+
+// complete reports the result of the installer, the error is nil if it has been installed correctly
+func (c installCallbacks) complete(err error) {
+	if c.onComplete != nil {
+		c.onComplete(err)
+	}
+}
+
 const (
 	needsInstalling installationStatus = iota
 	needsUpdating
@@ -24,13 +45,18 @@ func Process(appoptions *options.App) (string, error) {
 	if appoptions.Windows != nil && appoptions.Windows.Messages != nil {
 		messages = appoptions.Windows.Messages
 	}
+	messages = formatMessages(messages, appoptions.Title)
 
 	installStatus := needsInstalling
 
 	// Override version check for manually specified webview path if present
 	var webviewPath = ""
 	if opts := appoptions.Windows; opts != nil && opts.WebviewBrowserPath != "" {
-		webviewPath = opts.WebviewBrowserPath
+		expanded, err := windows.ExpandPath(opts.WebviewBrowserPath)
+		if err != nil {
+			return "", err
+		}
+		webviewPath = expanded
 	}
 
 	installedVersion, err := webviewloader.GetAvailableCoreWebView2BrowserVersionString(webviewPath)
@@ -56,5 +82,25 @@ func Process(appoptions *options.App) (string, error) {
 		return installedVersion, fmt.Errorf(messages.InvalidFixedWebview2)
 	}
 
-	return installedVersion, doInstallationStrategy(installStatus, messages)
+	callbacks := installCallbacks{}
+	if opts := appoptions.Windows; opts != nil {
+		callbacks.onProgress = opts.OnWebView2DownloadProgress
+		callbacks.onComplete = opts.OnWebView2InstallComplete
+	}
+	return installedVersion, doInstallationStrategy(installStatus, messages, callbacks)
+}
+
+// formatMessages returns a copy of the messages with the placeholders replaced. The version is appended to the
+// DownloadPage message if it doesn't contain the {version} placeholder, as the default message ends with it.
+func formatMessages(messages *windows.Messages, appName string) *windows.Messages {
+	result := *messages
+	if !strings.Contains(result.DownloadPage, "{version}") {
+		result.DownloadPage += "{version}"
+	}
+	data := map[string]string{"version": MinimumRuntimeVersion, "appName": appName}
+	fields := reflect.ValueOf(&result).Elem()
+	for index := 0; index < fields.NumField(); index++ {
+		fields.Field(index).SetString(windows.FormatMessage(fields.Field(index).String(), data))
+	}
+	return &result
 }
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/wailsapp/wails/v2/internal/app"
@@ -48,6 +49,16 @@ func (a *Application) SetApplicationMenu(appMenu *menu.Menu) {
 	a.options.Menu = appMenu
 }
 
+// RegisterCustomScheme adds the handler of the requests of a custom URI scheme, EG "myapp" for the myapp:// URLs. The
+// schemes are registered with the webview when it is created, so they must be added before Run. Custom schemes are not
+// supported on Windows.
+func (a *Application) RegisterCustomScheme(scheme string, handler options.CustomSchemeHandler) error {
+	if a.running {
+		return errors.New("custom schemes must be registered before the application is run")
+	}
+	return a.options.RegisterCustomScheme(scheme, handler)
+}
+
 // Run starts the application
 func (a *Application) Run() error {
 	err := applicationInit()
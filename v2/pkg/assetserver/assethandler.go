@@ -75,6 +75,10 @@ func NewAssetHandler(options assetserver.Options, log Logger) (http.Handler, err
 		result = middleware(result)
 	}
 
+	if len(options.Headers) != 0 {
+		result = headersHandler(options.Headers, result)
+	}
+
 	return result, nil
 }
 
@@ -47,6 +47,9 @@ type AssetServer struct {
 	// plugin scripts
 	pluginScripts map[string]string
 
+	// launchScript returns the script prepended to the runtime, which sets the launch data of the application
+	launchScript func() string
+
 	assetServerWebView
 }
 
@@ -96,6 +99,12 @@ func (d *AssetServer) UseRuntimeHandler(handler RuntimeHandler) {
 	d.runtimeHandler = handler
 }
 
+// UseLaunchScript prepends the script to the runtime each time it is served, so the script runs before the scripts of
+// the page
+func (d *AssetServer) UseLaunchScript(script func() string) {
+	d.launchScript = script
+}
+
 func (d *AssetServer) AddPluginScript(pluginName string, script string) {
 	if d.pluginScripts == nil {
 		d.pluginScripts = make(map[string]string)
@@ -125,7 +134,13 @@ func (d *AssetServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 
 	path := req.URL.Path
 	if path == runtimeJSPath {
-		d.writeBlob(rw, path, d.runtimeJS)
+		content := d.runtimeJS
+		if d.launchScript != nil {
+			// The launch data changes, the runtime mustn't be cached
+			rw.Header().Set(HeaderCacheControl, "no-cache")
+			content = append([]byte(d.launchScript()), d.runtimeJS...)
+		}
+		d.writeBlob(rw, path, content)
 	} else if path == runtimePath && d.runtimeHandler != nil {
 		d.runtimeHandler.HandleRuntimeCall(rw, req)
 	} else if path == ipcJSPath {
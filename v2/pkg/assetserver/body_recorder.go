@@ -26,6 +26,17 @@ func (rw *bodyRecorder) WriteHeader(code int) {
 	rw.writeHeader(nil, code)
 }
 
+func (rw *bodyRecorder) Flush() {
+	rw.writeHeader(nil, http.StatusOK)
+	if rw.body != nil {
+		// The body is recorded and will be written after the handler has finished
+		return
+	}
+	if f, ok := rw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func (rw *bodyRecorder) Code() int {
 	return rw.code
 }
@@ -28,6 +28,13 @@ func (rw *contentTypeSniffer) WriteHeader(code int) {
 	rw.wroteHeader = true
 }
 
+func (rw *contentTypeSniffer) Flush() {
+	rw.writeHeader(nil)
+	if f, ok := rw.rw.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func (rw *contentTypeSniffer) writeHeader(b []byte) {
 	if rw.wroteHeader {
 		return
@@ -0,0 +1,129 @@
+package assetserver
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/assetserver/webview"
+	"github.com/wailsapp/wails/v2/pkg/options"
+)
+
+// CustomSchemeHandler returns the handler of the custom scheme of the URI
+func CustomSchemeHandler(schemes map[string]options.CustomSchemeHandler, uri string) (options.CustomSchemeHandler, bool) {
+	if len(schemes) == 0 {
+		return nil, false
+	}
+	scheme, _, found := strings.Cut(uri, ":")
+	if !found {
+		return nil, false
+	}
+	handler, ok := schemes[strings.ToLower(scheme)]
+	return handler, ok
+}
+
+// ServeCustomScheme serves the request of a custom scheme with the handler on a new goroutine, so the UI thread isn't
+// blocked. The body of the response is streamed to the webview. Like ServeWebViewRequest, it takes ownership of the
+// request. The errors are returned to the webview and passed to logError.
+func ServeCustomScheme(req webview.Request, handler options.CustomSchemeHandler, logError func(format string, args ...interface{})) {
+	go func() {
+		uri, _ := req.URL()
+		if err := serveCustomScheme(req, handler); err != nil {
+			logError("Error processing request '%s': %s", uri, err)
+		}
+		if err := req.Close(); err != nil {
+			logError("Unable to call close for request for uri '%s'", uri)
+		}
+	}()
+}
+
+func serveCustomScheme(r webview.Request, handler options.CustomSchemeHandler) (err error) {
+	rw := r.Response()
+	defer func() {
+		if finishErr := rw.Finish(); err == nil {
+			err = finishErr
+		}
+	}()
+
+	request, err := newCustomSchemeRequest(r)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return err
+	}
+	defer request.Body.Close()
+
+	response, err := handler(request)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return err
+	}
+	if response == nil {
+		http.NotFound(rw, request)
+		return nil
+	}
+	if response.Body != nil {
+		defer response.Body.Close()
+	}
+
+	for key, values := range response.Header {
+		rw.Header()[key] = values
+	}
+	status := response.StatusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+	rw.WriteHeader(status)
+	if response.Body == nil {
+		return nil
+	}
+
+	// Every chunk is flushed, so the response is streamed rather than buffered until it has been read
+	buffer := make([]byte, 32*1024)
+	for {
+		n, readErr := response.Body.Read(buffer)
+		if n > 0 {
+			if _, err := rw.Write(buffer[:n]); err != nil {
+				return err
+			}
+			rw.Flush()
+		}
+		if readErr == io.EOF {
+			return nil
+		}
+		if readErr != nil {
+			return fmt.Errorf("HTTP-Body: %w", readErr)
+		}
+	}
+}
+
+// newCustomSchemeRequest returns the request of the webview as a client request, which has the full URL
+func newCustomSchemeRequest(r webview.Request) (*http.Request, error) {
+	uri, err := r.URL()
+	if err != nil {
+		return nil, err
+	}
+	method, err := r.Method()
+	if err != nil {
+		return nil, fmt.Errorf("HTTP-Method: %w", err)
+	}
+	header, err := r.Header()
+	if err != nil {
+		return nil, fmt.Errorf("HTTP-Header: %w", err)
+	}
+	body, err := r.Body()
+	if err != nil {
+		return nil, fmt.Errorf("HTTP-Body: %w", err)
+	}
+	if body == nil {
+		body = http.NoBody
+	}
+
+	request, err := http.NewRequest(method, uri, body)
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+	request.Header = header
+	return request, nil
+}
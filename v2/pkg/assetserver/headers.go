@@ -0,0 +1,42 @@
+package assetserver
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// headersHandler adds the headers of all paths matching the request before calling the next handler
+func headersHandler(headers map[string]http.Header, next http.Handler) http.Handler {
+	// Sort the paths by length, so the headers of the longer paths are set last and take precedence
+	paths := make([]string, 0, len(headers))
+	for path := range headers {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if len(paths[i]) != len(paths[j]) {
+			return len(paths[i]) < len(paths[j])
+		}
+		return paths[i] < paths[j]
+	})
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		header := rw.Header()
+		for _, path := range paths {
+			if !headerPathMatches(path, req.URL.Path) {
+				continue
+			}
+			for key, values := range headers[path] {
+				header[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+			}
+		}
+		next.ServeHTTP(rw, req)
+	})
+}
+
+func headerPathMatches(pattern string, path string) bool {
+	if strings.HasSuffix(pattern, "/") {
+		return strings.HasPrefix(path, pattern)
+	}
+	return pattern == path
+}
@@ -0,0 +1,54 @@
+package assetserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestHeadersHandler(t *testing.T) {
+	i := is.New(t)
+
+	handler := headersHandler(map[string]http.Header{
+		"/": {
+			"Cross-Origin-Opener-Policy":   {"same-origin"},
+			"Cross-Origin-Embedder-Policy": {"require-corp"},
+			"X-Test":                       {"root"},
+		},
+		"/wasm/": {
+			"x-test": {"wasm"},
+		},
+		"/index.html": {
+			"Cache-Control": {"no-store"},
+		},
+	}, http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/override" {
+			rw.Header().Set("X-Test", "handler")
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(path string) http.Header {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec.Header()
+	}
+
+	header := serve("/index.html")
+	i.Equal(header.Get("Cross-Origin-Opener-Policy"), "same-origin")
+	i.Equal(header.Get("Cross-Origin-Embedder-Policy"), "require-corp")
+	i.Equal(header.Get("X-Test"), "root")
+	i.Equal(header.Get("Cache-Control"), "no-store")
+
+	header = serve("/wasm/app.wasm")
+	i.Equal(header.Get("X-Test"), "wasm")
+	i.Equal(header.Get("Cache-Control"), "")
+
+	header = serve("/index.html.map")
+	i.Equal(header.Get("Cache-Control"), "")
+
+	header = serve("/override")
+	i.Equal(header.Get("X-Test"), "handler")
+}
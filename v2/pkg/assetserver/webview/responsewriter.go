@@ -19,6 +19,7 @@ var (
 // construct an HTTP response for the WebView.
 type ResponseWriter interface {
 	http.ResponseWriter
+	http.Flusher
 
 	// Finish the response and flush all data. A Finish after the request has already been finished has no effect.
 	Finish() error
@@ -149,6 +149,11 @@ func (rw *responseWriter) WriteHeader(code int) {
 	C.URLSchemeTaskDidReceiveResponse(rw.r.task, C.int(code), headers, C.int(headersLen))
 }
 
+// Flush sends the headers to the WebView, written data is passed to the WebView immediately.
+func (rw *responseWriter) Flush() {
+	rw.WriteHeader(http.StatusOK)
+}
+
 func (rw *responseWriter) Finish() error {
 	if !rw.wroteHeader {
 		rw.WriteHeader(http.StatusNotImplemented)
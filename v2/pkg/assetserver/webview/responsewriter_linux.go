@@ -86,6 +86,11 @@ func (rw *responseWriter) WriteHeader(code int) {
 	}
 }
 
+// Flush sends the headers to the WebView, written data is passed to the WebView immediately through the pipe.
+func (rw *responseWriter) Flush() {
+	rw.WriteHeader(http.StatusOK)
+}
+
 func (rw *responseWriter) Finish() error {
 	if !rw.wroteHeader {
 		rw.WriteHeader(http.StatusNotImplemented)
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+
+	"github.com/wailsapp/go-webview2/pkg/edge"
 )
 
 var _ http.ResponseWriter = &responseWriter{}
+var _ http.Flusher = &responseWriter{}
 
 type responseWriter struct {
 	req *request
@@ -20,6 +23,9 @@ type responseWriter struct {
 	code        int
 	body        *bytes.Buffer
 
+	// stream is set after the first Flush, all further writes are streamed to the WebView
+	stream *pipeStream
+
 	finished bool
 }
 
@@ -37,6 +43,9 @@ func (rw *responseWriter) Write(buf []byte) (int, error) {
 
 	rw.WriteHeader(http.StatusOK)
 
+	if rw.stream != nil {
+		return rw.stream.Write(buf)
+	}
 	return rw.body.Write(buf)
 }
 
@@ -53,6 +62,33 @@ func (rw *responseWriter) WriteHeader(code int) {
 	rw.code = code
 }
 
+// Flush sends the headers and the data written so far to the WebView. After the first Flush the response is streamed,
+// which allows chunked responses and server-sent events.
+func (rw *responseWriter) Flush() {
+	if rw.finished {
+		return
+	}
+
+	rw.WriteHeader(http.StatusOK)
+	if rw.stream != nil {
+		return
+	}
+
+	stream := newPipeStream()
+	if _, err := stream.Write(rw.body.Bytes()); err != nil {
+		stream.IStream().Release()
+		return
+	}
+	rw.body.Reset()
+
+	if err := rw.sendResponse(stream.IStream()); err != nil {
+		// The response has been finished with an error, nothing will be read from the stream anymore
+		rw.finished = true
+	}
+	stream.IStream().Release()
+	rw.stream = stream
+}
+
 func (rw *responseWriter) Finish() error {
 	if !rw.wroteHeader {
 		rw.WriteHeader(http.StatusNotImplemented)
@@ -63,6 +99,17 @@ func (rw *responseWriter) Finish() error {
 	}
 	rw.finished = true
 
+	if rw.stream != nil {
+		// Headers and status have already been sent with the first Flush
+		return rw.stream.Close()
+	}
+
+	return rw.sendResponse(nil)
+}
+
+// sendResponse sends the headers, status code and content to the WebView and completes the request. If stream is nil
+// the buffered body is used as content.
+func (rw *responseWriter) sendResponse(stream *edge.IStream) error {
 	var errs []error
 
 	code := rw.code
@@ -92,7 +139,11 @@ func (rw *responseWriter) Finish() error {
 			errs = append(errs, fmt.Errorf("Resp.PutStatusCode failed: %s", err))
 		}
 
-		if err := resp.PutByteContent(rw.body.Bytes()); err != nil {
+		if stream != nil {
+			if err := resp.PutContent(stream); err != nil {
+				errs = append(errs, fmt.Errorf("Resp.PutContent failed: %s", err))
+			}
+		} else if err := resp.PutByteContent(rw.body.Bytes()); err != nil {
 			errs = append(errs, fmt.Errorf("Resp.PutByteContent failed: %s", err))
 		}
 
@@ -0,0 +1,182 @@
+//go:build windows
+// +build windows
+
+package webview
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/wailsapp/go-webview2/pkg/edge"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	hrENotImpl      = 0x80004001
+	hrENoInterface  = 0x80004002
+	hrEPointer      = 0x80004003
+	hrSTGInvalidFun = 0x80030001
+)
+
+var (
+	iidIUnknown          = windows.GUID{Data1: 0x00000000, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+	iidISequentialStream = windows.GUID{Data1: 0x0c733a30, Data2: 0x2a1c, Data3: 0x11ce, Data4: [8]byte{0xad, 0xe5, 0x00, 0xaa, 0x00, 0x44, 0x77, 0x3d}}
+	iidIStream           = windows.GUID{Data1: 0x0000000c, Data2: 0x0000, Data3: 0x0000, Data4: [8]byte{0xC0, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46}}
+)
+
+type pipeStreamVtbl struct {
+	QueryInterface edge.ComProc
+	AddRef         edge.ComProc
+	Release        edge.ComProc
+	Read           edge.ComProc
+	Write          edge.ComProc
+	Seek           edge.ComProc
+	SetSize        edge.ComProc
+	CopyTo         edge.ComProc
+	Commit         edge.ComProc
+	Revert         edge.ComProc
+	LockRegion     edge.ComProc
+	UnlockRegion   edge.ComProc
+	Stat           edge.ComProc
+	Clone          edge.ComProc
+}
+
+// The 64bit integer arguments of Seek, SetSize, CopyTo and LockRegion take two stack slots on 386, the callbacks for
+// those are defined in the architecture specific files.
+var pipeStreamFn = pipeStreamVtbl{
+	QueryInterface: edge.NewComProc(pipeStreamQueryInterface),
+	AddRef:         edge.NewComProc(pipeStreamAddRef),
+	Release:        edge.NewComProc(pipeStreamRelease),
+	Read:           edge.NewComProc(pipeStreamRead),
+	Write:          edge.NewComProc(pipeStreamNotImplemented3),
+	Seek:           edge.NewComProc(pipeStreamSeek),
+	SetSize:        edge.NewComProc(pipeStreamSetSize),
+	CopyTo:         edge.NewComProc(pipeStreamCopyTo),
+	Commit:         edge.NewComProc(pipeStreamNotImplemented1),
+	Revert:         edge.NewComProc(pipeStreamNotImplemented0),
+	LockRegion:     edge.NewComProc(pipeStreamLockRegion),
+	UnlockRegion:   edge.NewComProc(pipeStreamLockRegion),
+	Stat:           edge.NewComProc(pipeStreamNotImplemented2),
+	Clone:          edge.NewComProc(pipeStreamNotImplemented1),
+}
+
+// liveStreams keeps the streams alive while WebView2 holds a reference to them
+var liveStreams sync.Map
+
+// pipeStream is a COM IStream which is fed from Go. WebView2 reads the content stream of a response on a
+// background thread, Read blocks until data has been written or the stream has been closed. This allows streaming
+// responses like chunked transfers or server-sent events to the WebView.
+type pipeStream struct {
+	vtbl *pipeStreamVtbl
+	refs int32
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	buf    []byte
+	closed bool
+}
+
+func newPipeStream() *pipeStream {
+	s := &pipeStream{
+		vtbl: &pipeStreamFn,
+		refs: 1,
+	}
+	s.cond = sync.NewCond(&s.mu)
+	liveStreams.Store(s, struct{}{})
+	return s
+}
+
+// IStream returns the stream as an edge.IStream. The reference is owned by the caller.
+func (s *pipeStream) IStream() *edge.IStream {
+	return (*edge.IStream)(unsafe.Pointer(s))
+}
+
+func (s *pipeStream) Write(buf []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return 0, errResponseFinished
+	}
+	s.buf = append(s.buf, buf...)
+	s.cond.Broadcast()
+	return len(buf), nil
+}
+
+// Close marks the end of the stream, Read returns EOF after all written data has been consumed.
+func (s *pipeStream) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	s.cond.Broadcast()
+	return nil
+}
+
+func (s *pipeStream) read(p []byte) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(s.buf) == 0 && !s.closed {
+		s.cond.Wait()
+	}
+	n := copy(p, s.buf)
+	s.buf = s.buf[n:]
+	return n, s.closed && len(s.buf) == 0
+}
+
+func pipeStreamQueryInterface(this *pipeStream, refiid *windows.GUID, object *uintptr) uintptr {
+	if object == nil {
+		return hrEPointer
+	}
+	switch *refiid {
+	case iidIUnknown, iidISequentialStream, iidIStream:
+		pipeStreamAddRef(this)
+		*object = uintptr(unsafe.Pointer(this))
+		return uintptr(windows.S_OK)
+	}
+	*object = 0
+	return hrENoInterface
+}
+
+func pipeStreamAddRef(this *pipeStream) uintptr {
+	return uintptr(atomic.AddInt32(&this.refs, 1))
+}
+
+func pipeStreamRelease(this *pipeStream) uintptr {
+	refs := atomic.AddInt32(&this.refs, -1)
+	if refs == 0 {
+		this.Close()
+		liveStreams.Delete(this)
+	}
+	return uintptr(refs)
+}
+
+func pipeStreamRead(this *pipeStream, pv *byte, cb uintptr, pcbRead *uint32) uintptr {
+	var n int
+	var eof bool
+	if pv != nil && cb > 0 {
+		n, eof = this.read(unsafe.Slice(pv, cb))
+	}
+	if pcbRead != nil {
+		*pcbRead = uint32(n)
+	}
+	if eof && n == 0 {
+		return uintptr(windows.S_FALSE)
+	}
+	return uintptr(windows.S_OK)
+}
+
+func pipeStreamNotImplemented0(this *pipeStream) uintptr {
+	return hrENotImpl
+}
+
+func pipeStreamNotImplemented1(this *pipeStream, _ uintptr) uintptr {
+	return hrENotImpl
+}
+
+func pipeStreamNotImplemented2(this *pipeStream, _, _ uintptr) uintptr {
+	return hrENotImpl
+}
+
+func pipeStreamNotImplemented3(this *pipeStream, _, _, _ uintptr) uintptr {
+	return hrENotImpl
+}
@@ -0,0 +1,20 @@
+//go:build windows && !386
+
+package webview
+
+func pipeStreamSeek(this *pipeStream, _, _, _ uintptr) uintptr {
+	// Seeking is not supported on a pipe
+	return hrSTGInvalidFun
+}
+
+func pipeStreamSetSize(this *pipeStream, _ uintptr) uintptr {
+	return hrENotImpl
+}
+
+func pipeStreamCopyTo(this *pipeStream, _, _, _, _ uintptr) uintptr {
+	return hrENotImpl
+}
+
+func pipeStreamLockRegion(this *pipeStream, _, _, _ uintptr) uintptr {
+	return hrENotImpl
+}
@@ -9,6 +9,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pterm/pterm"
 
@@ -152,6 +153,48 @@ func (b *BaseBuilder) OutputFilename(options *Options) string {
 	return outputFile
 }
 
+// appInfoLDFlags returns the ldflags which set the values returned by runtime.GetAppInfo
+func appInfoLDFlags(options *Options) ([]string, error) {
+	if options.ProjectData == nil {
+		return nil, nil
+	}
+
+	values := map[string]string{
+		"appName":      options.ProjectData.Info.ProductName,
+		"appVersion":   options.ProjectData.Info.ProductVersion,
+		"appBuildDate": time.Now().UTC().Format(time.RFC3339),
+	}
+	if commit, _, err := shell.RunCommand(options.ProjectData.Path, "git", "rev-parse", "HEAD"); err == nil {
+		values["appGitCommit"] = strings.TrimSpace(commit)
+	}
+
+	var result []string
+	for _, name := range []string{"appName", "appVersion", "appBuildDate", "appGitCommit"} {
+		value := values[name]
+		if value == "" {
+			continue
+		}
+		flag, err := quoteLDFlag("github.com/wailsapp/wails/v2/pkg/runtime." + name + "=" + value)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, "-X "+flag)
+	}
+	return result, nil
+}
+
+// quoteLDFlag quotes an argument of the ldflags. The go command splits the ldflags at spaces and only supports quoting
+// whole arguments with single or double quotes, without escapes, so an argument can't contain both.
+func quoteLDFlag(arg string) (string, error) {
+	if !strings.Contains(arg, "'") {
+		return "'" + arg + "'", nil
+	}
+	if !strings.Contains(arg, `"`) {
+		return `"` + arg + `"`, nil
+	}
+	return "", fmt.Errorf("the ldflag %s can't contain both single and double quotes", arg)
+}
+
 // CompileProject compiles the project
 func (b *BaseBuilder) CompileProject(options *Options) error {
 	// Check if the runtime wrapper exists
@@ -247,6 +290,12 @@ func (b *BaseBuilder) CompileProject(options *Options) error {
 
 	// LDFlags
 	ldflags := slicer.String()
+	// Add the app info first, so it can be overridden by the user
+	appInfo, err := appInfoLDFlags(options)
+	if err != nil {
+		return err
+	}
+	ldflags.AddSlice(appInfo)
 	if options.LDFlags != "" {
 		ldflags.Add(options.LDFlags)
 	}
@@ -1,6 +1,10 @@
 package build
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
 
 func Test_commandPrettifier(t *testing.T) {
 	tests := []struct {
@@ -32,3 +36,49 @@ func Test_commandPrettifier(t *testing.T) {
 		})
 	}
 }
+
+func Test_appInfoLDFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		productName string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "no quotes",
+			productName: "My App",
+			want:        "-X 'github.com/wailsapp/wails/v2/pkg/runtime.appName=My App'",
+		},
+		{
+			name:        "single quote",
+			productName: "Bob's App",
+			want:        `-X "github.com/wailsapp/wails/v2/pkg/runtime.appName=Bob's App"`,
+		},
+		{
+			name:        "double quotes",
+			productName: `The "App"`,
+			want:        `-X 'github.com/wailsapp/wails/v2/pkg/runtime.appName=The "App"'`,
+		},
+		{
+			name:        "both quotes",
+			productName: `Bob's "App"`,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectData := &project.Project{Path: t.TempDir()}
+			projectData.Info.ProductName = tt.productName
+			got, err := appInfoLDFlags(&Options{ProjectData: projectData})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("appInfoLDFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) == 0 || got[0] != tt.want {
+				t.Errorf("appInfoLDFlags() = %v, want %v first", got, tt.want)
+			}
+		})
+	}
+}
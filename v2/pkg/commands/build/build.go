@@ -254,6 +254,9 @@ func GenerateBindings(buildOptions *Options) error {
 }
 
 func execBuildApplication(builder Builder, options *Options) (string, error) {
+	// The arch is changed while building a universal binary
+	targetArch := options.Arch
+
 	// If we are building for windows, we will need to generate the asset bundle before
 	// compilation. This will be a .syso file in the project root
 	if options.Pack && options.Platform == "windows" {
@@ -359,6 +362,15 @@ func execBuildApplication(builder Builder, options *Options) (string, error) {
 		pterm.Println("Done.")
 	}
 
+	if len(options.ProjectData.Sidecars) > 0 {
+		printBulletPoint("Placing sidecars: ")
+		err := placeSidecars(options, targetArch)
+		if err != nil {
+			return "", err
+		}
+		pterm.Println("Done.")
+	}
+
 	if options.Platform == "windows" {
 		const nativeWebView2Loader = "native_webview2loader"
 
@@ -0,0 +1,151 @@
+package build
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"golang.org/x/image/draw"
+
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/project"
+	"github.com/wailsapp/wails/v2/pkg/buildassets"
+)
+
+// windowsIconSizes are the sizes embedded in a generated ico file. Windows picks the best matching size for the
+// DPI of the monitor, e.g. 24 for the small icon at 150% or 48 for the big icon at 150%.
+var windowsIconSizes = []int{256, 128, 64, 48, 32, 24, 16}
+
+// darwinIconTypes are the PNG icon types of the full macOS iconset, from 16x16 to 512x512@2x. The @2x types are
+// used on Retina displays.
+var darwinIconTypes = []struct {
+	osType string
+	size   int
+}{
+	{"icp4", 16},
+	{"ic11", 32},
+	{"icp5", 32},
+	{"ic12", 64},
+	{"ic07", 128},
+	{"ic13", 256},
+	{"ic08", 256},
+	{"ic14", 512},
+	{"ic09", 512},
+	{"ic10", 1024},
+}
+
+// darwinIconSizes returns the distinct sizes of darwinIconTypes
+func darwinIconSizes() []int {
+	var sizes []int
+	for _, iconType := range darwinIconTypes {
+		if !slices.Contains(sizes, iconType.size) {
+			sizes = append(sizes, iconType.size)
+		}
+	}
+	return sizes
+}
+
+// readIconSizes reads the per-size PNGs of an icon from the build folder, e.g. "build/appicon/32.png". These are used
+// instead of scaling the source image for that size.
+func readIconSizes(projectData *project.Project, iconName string, sizes []int) (map[int][]byte, error) {
+	dir := buildassets.GetLocalPath(projectData, iconName)
+	if !fs.DirExists(dir) {
+		return nil, nil
+	}
+
+	result := map[int][]byte{}
+	for _, size := range sizes {
+		content, err := os.ReadFile(filepath.Join(dir, strconv.Itoa(size)+".png"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		cfg, err := png.DecodeConfig(bytes.NewReader(content))
+		if err != nil {
+			return nil, fmt.Errorf("%s/%d.png: %w", iconName, size, err)
+		}
+		if cfg.Width != size || cfg.Height != size {
+			return nil, fmt.Errorf("%s/%d.png: expected a size of %dx%d but got %dx%d", iconName, size, size, size, cfg.Width, cfg.Height)
+		}
+		result[size] = content
+	}
+	return result, nil
+}
+
+// iconImages returns the PNGs of the given sizes by size. Sizes without a PNG in sizeSources are scaled from the
+// source image.
+func iconImages(source []byte, sizes []int, sizeSources map[int][]byte) (map[int][]byte, error) {
+	var srcImg image.Image
+
+	images := map[int][]byte{}
+	for _, size := range sizes {
+		if content, ok := sizeSources[size]; ok {
+			images[size] = content
+			continue
+		}
+
+		if srcImg == nil {
+			var err error
+			srcImg, _, err = image.Decode(bytes.NewReader(source))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		rect := image.Rect(0, 0, size, size)
+		scaled := image.NewRGBA(rect)
+		draw.CatmullRom.Scale(scaled, rect, srcImg, srcImg.Bounds(), draw.Over, nil)
+
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, scaled); err != nil {
+			return nil, err
+		}
+		images[size] = buf.Bytes()
+	}
+	return images, nil
+}
+
+// generateIcns writes an icns file containing the full macOS iconset as PNGs. Sizes without a PNG in sizeSources are
+// scaled from the source image.
+func generateIcns(w io.Writer, source []byte, sizeSources map[int][]byte) error {
+	images, err := iconImages(source, darwinIconSizes(), sizeSources)
+	if err != nil {
+		return err
+	}
+
+	// The header and each icon are a 4 character type followed by the big endian length including the 8 byte header
+	length := 8
+	for _, iconType := range darwinIconTypes {
+		length += 8 + len(images[iconType.size])
+	}
+	if err := writeIcnsHeader(w, "icns", length); err != nil {
+		return err
+	}
+	for _, iconType := range darwinIconTypes {
+		img := images[iconType.size]
+		if err := writeIcnsHeader(w, iconType.osType, 8+len(img)); err != nil {
+			return err
+		}
+		if _, err := w.Write(img); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeIcnsHeader(w io.Writer, osType string, length int) error {
+	if _, err := io.WriteString(w, osType); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.BigEndian, uint32(length))
+}
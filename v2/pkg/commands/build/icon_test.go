@@ -0,0 +1,76 @@
+package build
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+)
+
+func testPNG(t *testing.T, size int, c color.Color) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, size, size))
+	for x := 0; x < size; x++ {
+		for y := 0; y < size; y++ {
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func Test_generateIcns(t *testing.T) {
+	red := color.RGBA{R: 255, A: 255}
+	blue := color.RGBA{B: 255, A: 255}
+
+	source := testPNG(t, 1024, red)
+	sizeSources := map[int][]byte{16: testPNG(t, 16, blue)}
+
+	var buf bytes.Buffer
+	if err := generateIcns(&buf, source, sizeSources); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if string(data[0:4]) != "icns" {
+		t.Fatalf("expected the icns magic, got %q", data[0:4])
+	}
+	if length := int(binary.BigEndian.Uint32(data[4:8])); length != len(data) {
+		t.Fatalf("expected a length of %d, got %d", len(data), length)
+	}
+
+	offset := 8
+	for i, iconType := range darwinIconTypes {
+		if offset+8 > len(data) {
+			t.Fatalf("entry %d: missing", i)
+		}
+		if osType := string(data[offset : offset+4]); osType != iconType.osType {
+			t.Errorf("entry %d: expected type %s, got %s", i, iconType.osType, osType)
+		}
+		length := int(binary.BigEndian.Uint32(data[offset+4 : offset+8]))
+		img, err := png.Decode(bytes.NewReader(data[offset+8 : offset+length]))
+		if err != nil {
+			t.Fatalf("entry %d: %s", i, err)
+		}
+		if img.Bounds().Dx() != iconType.size {
+			t.Errorf("entry %d: expected image size %d, got %d", i, iconType.size, img.Bounds().Dx())
+		}
+
+		want := red
+		if iconType.size == 16 {
+			want = blue
+		}
+		if got := color.RGBAModel.Convert(img.At(iconType.size/2, iconType.size/2)); got != want {
+			t.Errorf("entry %d: expected colour %v, got %v", i, want, got)
+		}
+		offset += length
+	}
+	if offset != len(data) {
+		t.Errorf("expected %d bytes of icons, got %d", len(data), offset)
+	}
+}
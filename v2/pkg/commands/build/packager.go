@@ -3,7 +3,6 @@ package build
 import (
 	"bytes"
 	"fmt"
-	"image"
 	"os"
 	"path/filepath"
 	"strings"
@@ -13,7 +12,6 @@ import (
 	"github.com/tc-hib/winres/version"
 	"github.com/wailsapp/wails/v2/internal/project"
 
-	"github.com/jackmordaunt/icns"
 	"github.com/pkg/errors"
 	"github.com/wailsapp/wails/v2/pkg/buildassets"
 
@@ -137,7 +135,7 @@ func processDarwinIcon(projectData *project.Project, iconName string, resourceDi
 		return err
 	}
 
-	srcImg, _, err := image.Decode(bytes.NewBuffer(appIcon))
+	sizeSources, err := readIconSizes(projectData, iconName, darwinIconSizes())
 	if err != nil {
 		return err
 	}
@@ -157,7 +155,7 @@ func processDarwinIcon(projectData *project.Project, iconName string, resourceDi
 			return
 		}
 	}()
-	return icns.Encode(dest, srcImg)
+	return generateIcns(dest, appIcon, sizeSources)
 }
 
 func packageApplicationForWindows(options *Options) error {
@@ -214,7 +212,7 @@ func generateIcoFile(options *Options, iconName string, destIconName string) err
 		}
 		defer output.Close()
 
-		err = winicon.GenerateIcon(bytes.NewBuffer(content), output, []int{256, 128, 64, 48, 32, 16})
+		err = winicon.GenerateIcon(bytes.NewBuffer(content), output, windowsIconSizes)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,47 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/wailsapp/wails/v2/internal/fs"
+	"github.com/wailsapp/wails/v2/internal/system/sidecar"
+)
+
+// placeSidecars copies the sidecars of the project for the target platform next to the compiled binary, where the
+// runtime looks for them first. For a path of "sidecars/ffmpeg", the first existing file of "sidecars/ffmpeg-windows-amd64.exe",
+// "sidecars/ffmpeg-windows.exe" and "sidecars/ffmpeg.exe" is used when building for windows/amd64.
+func placeSidecars(options *Options, arch string) error {
+	for _, item := range options.ProjectData.Sidecars {
+		source := item.Path
+		if !filepath.IsAbs(source) {
+			source = filepath.Join(options.ProjectData.Path, source)
+		}
+		name := item.Name
+		if name == "" {
+			name = filepath.Base(item.Path)
+		}
+
+		var found string
+		for _, filename := range sidecar.FileNames(filepath.Base(source), options.Platform, arch) {
+			candidate := filepath.Join(filepath.Dir(source), filename)
+			if fs.FileExists(candidate) {
+				found = candidate
+				break
+			}
+		}
+		if found == "" {
+			return fmt.Errorf("no sidecar found for '%s' targeting %s/%s", item.Path, options.Platform, arch)
+		}
+
+		target := filepath.Join(filepath.Dir(options.CompiledBinary), sidecar.FileName(name, options.Platform))
+		if err := fs.CopyFile(found, target); err != nil {
+			return err
+		}
+		if err := os.Chmod(target, 0o755); err != nil {
+			return err
+		}
+	}
+	return nil
+}
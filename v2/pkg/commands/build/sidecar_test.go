@@ -0,0 +1,89 @@
+package build
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/wailsapp/wails/v2/internal/project"
+)
+
+func Test_placeSidecars(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		platform string
+		arch     string
+		want     string
+		wantFile string
+		wantErr  bool
+	}{
+		{
+			name:     "platform and arch",
+			files:    []string{"ffmpeg-windows-amd64.exe", "ffmpeg-windows.exe", "ffmpeg.exe"},
+			platform: "windows",
+			arch:     "amd64",
+			want:     "ffmpeg-windows-amd64.exe",
+			wantFile: "ffmpeg.exe",
+		},
+		{
+			name:     "platform",
+			files:    []string{"ffmpeg-linux-arm64", "ffmpeg-linux"},
+			platform: "linux",
+			arch:     "amd64",
+			want:     "ffmpeg-linux",
+			wantFile: "ffmpeg",
+		},
+		{
+			name:     "fallback",
+			files:    []string{"ffmpeg"},
+			platform: "darwin",
+			arch:     "universal",
+			want:     "ffmpeg",
+			wantFile: "ffmpeg",
+		},
+		{
+			name:     "missing",
+			files:    []string{"ffmpeg-linux"},
+			platform: "windows",
+			arch:     "amd64",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			projectDir := t.TempDir()
+			binDir := t.TempDir()
+			if err := os.Mkdir(filepath.Join(projectDir, "sidecars"), 0o755); err != nil {
+				t.Fatal(err)
+			}
+			for _, file := range tt.files {
+				if err := os.WriteFile(filepath.Join(projectDir, "sidecars", file), []byte(file), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			options := &Options{
+				Platform:       tt.platform,
+				CompiledBinary: filepath.Join(binDir, "app"),
+				ProjectData: &project.Project{
+					Path:     projectDir,
+					Sidecars: []project.Sidecar{{Path: "sidecars/ffmpeg"}},
+				},
+			}
+			err := placeSidecars(options, tt.arch)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("placeSidecars() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got, err := os.ReadFile(filepath.Join(binDir, tt.wantFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("placeSidecars() placed %v, want %v", string(got), tt.want)
+			}
+		})
+	}
+}
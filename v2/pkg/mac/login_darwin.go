@@ -15,6 +15,10 @@ import (
 // StartAtLogin will either add or remove this application to/from the login
 // items, depending on the given boolean flag. The limitation is that the
 // currently running app must be in an app bundle.
+//
+// Deprecated: Use runtime.SetLaunchAtLogin, which registers a launch agent without requiring an app bundle or the
+// permission to control System Events. Remove the login item added by StartAtLogin when switching, otherwise the
+// application is launched twice.
 func StartAtLogin(enabled bool) error {
 	exe, err := os.Executable()
 	if err != nil {
@@ -41,6 +45,9 @@ func StartAtLogin(enabled bool) error {
 // StartsAtLogin will indicate if this application is in the login
 // items. The limitation is that the currently running app must be
 // in an app bundle.
+//
+// Deprecated: Use runtime.IsLaunchAtLoginEnabled, which checks the registration of runtime.SetLaunchAtLogin. The
+// login items added by StartAtLogin are not reported by it.
 func StartsAtLogin() (bool, error) {
 	exe, err := os.Executable()
 	if err != nil {
@@ -33,6 +33,13 @@ type Options struct {
 	// Multiple Middlewares can be chained together with:
 	//   ChainMiddleware(middleware ...Middleware) Middleware
 	Middleware Middleware
+
+	// Headers are custom headers which are added to the responses of the AssetServer, EG the
+	// `Cross-Origin-Opener-Policy` and `Cross-Origin-Embedder-Policy` headers needed for `SharedArrayBuffer`.
+	// The keys are paths, a path ending with a slash matches all requests below it, so "/" matches all requests.
+	// Other paths only match exactly. The headers of all matching paths are added, the headers of the longer paths
+	// take precedence. Headers set by the Handler or Middleware take precedence over these headers.
+	Headers map[string]http.Header
 }
 
 // Validate the options
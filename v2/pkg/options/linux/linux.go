@@ -43,6 +43,20 @@ type Options struct {
 	//
 	//[see the docs]: https://docs.gtk.org/glib/func.set_prgname.html
 	ProgramName string
+
+	// PreferXWayland forces GTK to use the X11 backend when running in a Wayland session, so that the application runs
+	// through XWayland. This makes window operations that are restricted by Wayland, like setting the window position,
+	// work at the cost of native Wayland rendering. It has no effect if GDK_BACKEND is already set in the environment.
+	PreferXWayland bool
+
+	// ParentWindow is the X11 window ID of a window which owns the application window, EG when the application is
+	// launched as a dialog of another application. The window manager keeps the window on top of its parent.
+	// Wayland compositors don't allow windows of other clients as parents, wails.Run returns an error if it is set in a
+	// Wayland session.
+	ParentWindow uintptr
+
+	// Modal asks the window manager to treat the application window as a modal dialog of the ParentWindow
+	Modal bool
 }
 
 type Messages struct {
@@ -22,6 +22,11 @@ type Options struct {
 	WindowIsTranslucent  bool
 	Preferences          *Preferences
 	DisableZoom          bool
+	// WebviewKeyboardNavigationContained keeps the keyboard focus within the web content, so pressing Tab on the last
+	// focusable element moves the focus to the first one instead of to the native controls of the window
+	WebviewKeyboardNavigationContained bool
+	// DisableWebviewFocusRing hides the native focus ring around the webview when it has the keyboard focus
+	DisableWebviewFocusRing bool
 	// ActivationPolicy     ActivationPolicy
 	About      *AboutInfo
 	OnFileOpen func(filePath string) `json:"-"`
@@ -2,12 +2,16 @@ package options
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"html"
 	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"strings"
 
 	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 	"github.com/wailsapp/wails/v2/pkg/options/linux"
@@ -28,6 +32,28 @@ const (
 	Fullscreen WindowStartState = 3
 )
 
+// WindowLevel is the z-order tier of the window. Windows on a higher level are kept above windows on a lower level.
+// The values match the macOS NSWindowLevel constants.
+//
+// Windows and Linux only have a topmost and a bottom tier besides the normal one: all levels above WindowLevelNormal
+// are equivalent to WindowLevelFloating, which is the same as AlwaysOnTop, and all levels below it are equivalent to
+// WindowSetAlwaysOnBottom. The order between the levels above normal is only kept on macOS.
+type WindowLevel int
+
+const (
+	WindowLevelNormal WindowLevel = 0
+	// WindowLevelFloating keeps the window above the normal windows, EG a tool palette
+	WindowLevelFloating WindowLevel = 3
+	// WindowLevelModalPanel is equivalent to WindowLevelFloating on Windows and Linux
+	WindowLevelModalPanel WindowLevel = 8
+	// WindowLevelStatus is equivalent to WindowLevelFloating on Windows and Linux
+	WindowLevelStatus WindowLevel = 25
+	// WindowLevelPopUpMenu is equivalent to WindowLevelFloating on Windows and Linux
+	WindowLevelPopUpMenu WindowLevel = 101
+	// WindowLevelScreenSaver is equivalent to WindowLevelFloating on Windows and Linux
+	WindowLevelScreenSaver WindowLevel = 1000
+)
+
 type Experimental struct{}
 
 // App contains options for creating the App
@@ -45,6 +71,13 @@ type App struct {
 	StartHidden       bool
 	HideWindowOnClose bool
 	AlwaysOnTop       bool
+	// WindowLevel sets the initial z-order tier of the window. Takes precedence over AlwaysOnTop when not WindowLevelNormal.
+	WindowLevel WindowLevel
+	// StartActivated brings the window to the foreground when it is first shown, even when the application has been
+	// launched by a background process. Otherwise the OS decides, which may leave the window behind the active window.
+	StartActivated bool
+	// FocusOnShow brings the window to the foreground whenever it is shown with WindowShow
+	FocusOnShow bool
 	// BackgroundColour is the background colour of the window
 	// You can use the options.NewRGB and options.NewRGBA functions to create a new colour
 	BackgroundColour *RGBA
@@ -75,17 +108,53 @@ type App struct {
 	// The CSS Value that the CSSDragProperty must have to be draggable, EG: "drag"
 	CSSDragValue string
 
+	// DisableDoubleClickMaximise stops a double-click on a draggable element from toggling the maximised state of the
+	// window, which matches the title bar of the native windows
+	DisableDoubleClickMaximise bool
+
 	// EnableDefaultContextMenu enables the browser's default context-menu in production
 	// This menu is already enabled in development and debug builds
 	EnableDefaultContextMenu bool
 
+	// DisableWebviewTextSelection prevents the selection of the text of the page, except in editable elements. This is
+	// enforced by the webview rather than with CSS, so it also applies to the scripts of the page.
+	DisableWebviewTextSelection bool
+
+	// DisableWebviewCopy blocks the copy and cut shortcuts and clipboard events of the webview
+	DisableWebviewCopy bool
+
+	// ShowNavigationProgress shows a thin native progress bar at the top of the window while a page is loading. The
+	// "wails:navigation:progress" event is emitted whether or not the bar is shown.
+	ShowNavigationProgress bool
+
 	// EnableFraudulentWebsiteDetection enables scan services for fraudulent content, such as malware or phishing attempts.
 	// These services might send information from your app like URLs navigated to and possibly other content to cloud
 	// services of Apple and Microsoft.
 	EnableFraudulentWebsiteDetection bool
 
+	// DisableBackgroundThrottling keeps the timers and rendering of the webview running at full speed when the window
+	// is occluded or in the background. This increases the power usage and reduces the battery life.
+	DisableBackgroundThrottling bool
+
+	// DisableAutoFullscreen stops the window from going fullscreen when an element of the page, EG a video, requests
+	// fullscreen. The element then only fills the webview. Not supported on macOS.
+	DisableAutoFullscreen bool
+
+	// CustomSchemes are the handlers of the requests of custom URI schemes by scheme, EG "myapp" for the myapp:// URLs.
+	// Use RegisterCustomScheme to add a scheme.
+	CustomSchemes map[string]CustomSchemeHandler `json:"-"`
+
 	SingleInstanceLock *SingleInstanceLock
 
+	// CrashReporting writes crash artifacts of the application, which can be uploaded when the application is started
+	// again
+	CrashReporting *CrashReporting
+
+	// Sidecars contains the executables returned by runtime.GetSidecarPath when they haven't been placed next to the
+	// executable by the build. The files are named "name-GOOS-GOARCH", "name-GOOS" or "name", with the ".exe" extension
+	// on Windows, and are extracted to the user cache directory.
+	Sidecars fs.FS
+
 	Windows *windows.Options
 	Mac     *mac.Options
 	Linux   *linux.Options
@@ -102,6 +171,55 @@ type App struct {
 
 type ErrorFormatter func(error) any
 
+// CustomSchemeHandler handles a request of a custom URI scheme. The URL of the request is the full URL, EG
+// myapp://host/path. The handlers are run off the UI thread, the body of the response is streamed to the webview and
+// closed.
+type CustomSchemeHandler func(req *http.Request) (*http.Response, error)
+
+// reservedSchemes are handled by the webviews or used for the assets
+var reservedSchemes = []string{"about", "blob", "data", "file", "ftp", "http", "https", "javascript", "wails", "ws", "wss"}
+
+// customSchemesSupported is false on Windows, as the schemes have to be registered with the WebView2 environment,
+// which isn't supported by go-webview2
+var customSchemesSupported = runtime.GOOS != "windows"
+
+// RegisterCustomScheme adds the handler of the requests of the URI scheme, which is a letter followed by letters,
+// digits, "+", "-" or ".". The schemes handled by the webview, EG http, and the wails scheme can't be registered.
+// An error is returned on Windows, where custom schemes are not supported.
+func (a *App) RegisterCustomScheme(scheme string, handler CustomSchemeHandler) error {
+	if !customSchemesSupported {
+		return errors.New("custom schemes are not supported on Windows")
+	}
+	scheme = strings.ToLower(scheme)
+	if handler == nil {
+		return errors.New("the handler of a custom scheme must not be nil")
+	}
+	if !validScheme(scheme) {
+		return fmt.Errorf("invalid custom scheme '%s'", scheme)
+	}
+	if slices.Contains(reservedSchemes, scheme) {
+		return fmt.Errorf("the scheme '%s' is reserved", scheme)
+	}
+	if a.CustomSchemes == nil {
+		a.CustomSchemes = map[string]CustomSchemeHandler{}
+	}
+	a.CustomSchemes[scheme] = handler
+	return nil
+}
+
+// validScheme returns true if the scheme has the syntax of RFC 3986
+func validScheme(scheme string) bool {
+	for index, char := range scheme {
+		switch {
+		case char >= 'a' && char <= 'z':
+		case index > 0 && (char >= '0' && char <= '9' || char == '+' || char == '-' || char == '.'):
+		default:
+			return false
+		}
+	}
+	return scheme != ""
+}
+
 type RGBA struct {
 	R uint8 `json:"r"`
 	G uint8 `json:"g"`
@@ -187,6 +305,20 @@ type SingleInstanceLock struct {
 	OnSecondInstanceLaunch func(secondInstanceData SecondInstanceData)
 }
 
+// CrashReporting configures the crash artifacts. The output of fatal Go errors, including the stacks of all goroutines
+// and the signal, is written when the application is built with Go 1.23 or later. On Windows a minidump is also written
+// for native exceptions, and the WebView2 process failures are recorded.
+type CrashReporting struct {
+	// Directory is where the crash artifacts are written. Defaults to the "crashes" folder in the user config directory
+	// of the application, EG %APPDATA%\[BinaryName]\crashes.
+	Directory string
+	// ShowMessage shows a message with the path of the minidump before the application exits. Windows only.
+	ShowMessage bool
+	// UploadURL receives the crash artifacts of previous runs on startup, each as the "file" field of a multipart POST.
+	// The artifacts are deleted once they have been uploaded. Nothing is uploaded if this is empty.
+	UploadURL string
+}
+
 type SecondInstanceData struct {
 	Args             []string
 	WorkingDirectory string
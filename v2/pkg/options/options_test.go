@@ -1,6 +1,8 @@
 package options
 
 import (
+	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -83,3 +85,42 @@ func TestMergeDefaultsWH(t *testing.T) {
 		})
 	}
 }
+
+func TestRegisterCustomScheme(t *testing.T) {
+	handler := func(req *http.Request) (*http.Response, error) { return nil, nil }
+	tests := []struct {
+		name    string
+		scheme  string
+		handler CustomSchemeHandler
+		wantErr bool
+	}{
+		{name: "Valid scheme", scheme: "myapp", handler: handler},
+		{name: "Upper case scheme", scheme: "MyApp", handler: handler},
+		{name: "Scheme with digits and symbols", scheme: "my-app2+x.y", handler: handler},
+		{name: "Empty scheme", scheme: "", handler: handler, wantErr: true},
+		{name: "Leading digit", scheme: "2app", handler: handler, wantErr: true},
+		{name: "Invalid character", scheme: "my_app", handler: handler, wantErr: true},
+		{name: "Reserved scheme", scheme: "HTTPS", handler: handler, wantErr: true},
+		{name: "Assets scheme", scheme: "wails", handler: handler, wantErr: true},
+		{name: "Nil handler", scheme: "myapp", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := &App{}
+			err := app.RegisterCustomScheme(tt.scheme, tt.handler)
+			wantErr := tt.wantErr || !customSchemesSupported
+			if (err != nil) != wantErr {
+				t.Fatalf("RegisterCustomScheme() error = %v, wantErr %v", err, wantErr)
+			}
+			if wantErr {
+				if len(app.CustomSchemes) != 0 {
+					t.Errorf("RegisterCustomScheme() registered %v", app.CustomSchemes)
+				}
+				return
+			}
+			if want := strings.ToLower(tt.scheme); len(app.CustomSchemes) != 1 || app.CustomSchemes[want] == nil {
+				t.Errorf("RegisterCustomScheme() registered %v, want %v", app.CustomSchemes, want)
+			}
+		})
+	}
+}
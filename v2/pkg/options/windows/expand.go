@@ -0,0 +1,46 @@
+package windows
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ExpandPath expands the Windows environment variables of the path, which are written as %NAME%, EG
+// "%LOCALAPPDATA%\MyApp". A path without variables is returned unchanged. It returns an error if a variable isn't
+// defined, instead of keeping it literally in the path.
+func ExpandPath(path string) (string, error) {
+	if !strings.Contains(path, "%") {
+		return path, nil
+	}
+	for _, name := range environmentVariables(path) {
+		if _, ok := os.LookupEnv(name); !ok {
+			return "", fmt.Errorf("the environment variable %%%s%% of %q is not defined", name, path)
+		}
+	}
+	return expandEnvironmentStrings(path)
+}
+
+// environmentVariables returns the names of the variables of the path. A percent sign without a closing one, or
+// followed by a character which can't be part of a name, is kept literally like ExpandEnvironmentStrings does.
+func environmentVariables(path string) []string {
+	var result []string
+	for {
+		start := strings.IndexByte(path, '%')
+		if start == -1 {
+			return result
+		}
+		length := strings.IndexByte(path[start+1:], '%')
+		if length == -1 {
+			return result
+		}
+		name := path[start+1 : start+1+length]
+		if name == "" || strings.ContainsAny(name, `\/:*?"<>|`) {
+			// The closing sign may open the next variable
+			path = path[start+1:]
+			continue
+		}
+		result = append(result, name)
+		path = path[start+length+2:]
+	}
+}
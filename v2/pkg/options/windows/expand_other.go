@@ -0,0 +1,22 @@
+//go:build !windows
+
+package windows
+
+import (
+	"os"
+	"strings"
+)
+
+// expandEnvironmentStrings replaces the variables like ExpandEnvironmentStrings, so the options can be validated on
+// the other platforms, EG when the Windows build is tested in CI
+func expandEnvironmentStrings(path string) (string, error) {
+	var result strings.Builder
+	for _, name := range environmentVariables(path) {
+		index := strings.Index(path, "%"+name+"%")
+		result.WriteString(path[:index])
+		result.WriteString(os.Getenv(name))
+		path = path[index+len(name)+2:]
+	}
+	result.WriteString(path)
+	return result.String(), nil
+}
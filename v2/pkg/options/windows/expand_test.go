@@ -0,0 +1,34 @@
+package windows
+
+import "testing"
+
+func TestExpandPath(t *testing.T) {
+	t.Setenv("WAILS_TEST_DATA", `C:\Users\me\AppData\Local`)
+	t.Setenv("WAILS_TEST_EMPTY", "")
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{name: "No variables", path: `C:\MyApp\wv2`, want: `C:\MyApp\wv2`},
+		{name: "Empty", path: "", want: ""},
+		{name: "Variable", path: `%WAILS_TEST_DATA%\MyApp\wv2`, want: `C:\Users\me\AppData\Local\MyApp\wv2`},
+		{name: "Empty variable", path: `%WAILS_TEST_EMPTY%MyApp`, want: `MyApp`},
+		{name: "Single percent sign", path: `C:\100%\wv2`, want: `C:\100%\wv2`},
+		{name: "Percent signs of a path", path: `C:\100%\%WAILS_TEST_DATA%`, want: `C:\100%\C:\Users\me\AppData\Local`},
+		{name: "Undefined variable", path: `%WAILS_TEST_UNDEFINED%\MyApp`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpandPath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExpandPath(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ExpandPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
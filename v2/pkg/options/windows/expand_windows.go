@@ -0,0 +1,26 @@
+//go:build windows
+
+package windows
+
+import (
+	winsys "golang.org/x/sys/windows"
+)
+
+func expandEnvironmentStrings(path string) (string, error) {
+	source, err := winsys.UTF16PtrFromString(path)
+	if err != nil {
+		return "", err
+	}
+	buffer := make([]uint16, len(path)+1)
+	for {
+		// The size includes the terminating null character, it is the required size if the buffer is too small
+		size, err := winsys.ExpandEnvironmentStrings(source, &buffer[0], uint32(len(buffer)))
+		if err != nil {
+			return "", err
+		}
+		if int(size) <= len(buffer) {
+			return winsys.UTF16ToString(buffer[:size]), nil
+		}
+		buffer = make([]uint16, size)
+	}
+}
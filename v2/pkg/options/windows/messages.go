@@ -0,0 +1,41 @@
+package windows
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// LoadMessages reads the Messages of a JSON object with the names of the fields as keys, EG a messages.fr.json shipped
+// with the app. The messages which are missing or empty are the ones of DefaultMessages, unknown keys are ignored.
+func LoadMessages(r io.Reader) (*Messages, error) {
+	var loaded Messages
+	if err := json.NewDecoder(r).Decode(&loaded); err != nil {
+		return nil, fmt.Errorf("unable to load the messages: %w", err)
+	}
+
+	result := DefaultMessages()
+	defaults := reflect.ValueOf(result).Elem()
+	values := reflect.ValueOf(loaded)
+	for index := 0; index < values.NumField(); index++ {
+		if value := values.Field(index).String(); value != "" {
+			defaults.Field(index).SetString(value)
+		}
+	}
+	return result, nil
+}
+
+// FormatMessage replaces the placeholders of the template, like {version} or {appName}, with the values of data.
+// Placeholders without a value are kept, so a message without placeholders is returned unchanged.
+func FormatMessage(template string, data map[string]string) string {
+	if len(data) == 0 || !strings.Contains(template, "{") {
+		return template
+	}
+	replacements := make([]string, 0, 2*len(data))
+	for key, value := range data {
+		replacements = append(replacements, "{"+key+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(template)
+}
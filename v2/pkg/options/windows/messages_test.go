@@ -0,0 +1,69 @@
+package windows
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadMessages(t *testing.T) {
+	defaults := DefaultMessages()
+
+	messages, err := LoadMessages(strings.NewReader(`{
+		"Error": "Erreur",
+		"ContactAdmin": "",
+		"Unknown": "ignored"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if messages.Error != "Erreur" {
+		t.Errorf("Error = %q, want %q", messages.Error, "Erreur")
+	}
+	// The missing and the empty messages are the defaults
+	if messages.ContactAdmin != defaults.ContactAdmin {
+		t.Errorf("ContactAdmin = %q, want the default %q", messages.ContactAdmin, defaults.ContactAdmin)
+	}
+	if messages.DownloadPage != defaults.DownloadPage {
+		t.Errorf("DownloadPage = %q, want the default %q", messages.DownloadPage, defaults.DownloadPage)
+	}
+
+	value := reflect.ValueOf(*messages)
+	for index := 0; index < value.NumField(); index++ {
+		if value.Field(index).String() == "" {
+			t.Errorf("%s is empty", value.Type().Field(index).Name)
+		}
+	}
+
+	for _, invalid := range []string{``, `{`, `[]`, `{"Error": 1}`} {
+		if _, err := LoadMessages(strings.NewReader(invalid)); err == nil {
+			t.Errorf("LoadMessages(%q) succeeded, want an error", invalid)
+		}
+	}
+}
+
+func TestFormatMessage(t *testing.T) {
+	data := map[string]string{"version": "94.0.992.31", "appName": "My App"}
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "No placeholders", template: "Press Ok to install.", want: "Press Ok to install."},
+		{name: "Placeholders", template: "{appName} requires version {version} of WebView2.", want: "My App requires version 94.0.992.31 of WebView2."},
+		{name: "Repeated placeholder", template: "{version}/{version}", want: "94.0.992.31/94.0.992.31"},
+		{name: "Unknown placeholder", template: "{name} {version}", want: "{name} 94.0.992.31"},
+		{name: "Braces", template: "{ version }", want: "{ version }"},
+		{name: "Empty", template: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatMessage(tt.template, data); got != tt.want {
+				t.Errorf("FormatMessage(%q) = %q, want %q", tt.template, got, tt.want)
+			}
+		})
+	}
+	if got := FormatMessage("{version}", nil); got != "{version}" {
+		t.Errorf("FormatMessage without data = %q, want the template", got)
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !windows
+
+package windows
+
+// GetSystemTheme returns Light on the platforms other than Windows
+func GetSystemTheme() Theme {
+	return Light
+}
+
+// IsHighContrastActive returns false on the platforms other than Windows
+func IsHighContrastActive() bool {
+	return false
+}
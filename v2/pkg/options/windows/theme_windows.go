@@ -0,0 +1,49 @@
+//go:build windows
+
+package windows
+
+import (
+	"unsafe"
+
+	winsys "golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+var procSystemParametersInfo = winsys.NewLazySystemDLL("user32.dll").NewProc("SystemParametersInfoW")
+
+const (
+	spiGetHighContrast = 0x0042
+	hcfHighContrastOn  = 0x00000001
+)
+
+// highContrast is the HIGHCONTRASTW structure
+type highContrast struct {
+	cbSize            uint32
+	dwFlags           uint32
+	lpszDefaultScheme *uint16
+}
+
+// GetSystemTheme returns the theme of the apps selected by the user, Dark or Light. It doesn't need a window, so it can
+// be called before the application is run, EG to choose a splash image. Light is returned if the version of Windows
+// has no dark theme.
+func GetSystemTheme() Theme {
+	key, err := registry.OpenKey(registry.CURRENT_USER, `SOFTWARE\Microsoft\Windows\CurrentVersion\Themes\Personalize`, registry.QUERY_VALUE)
+	if err != nil {
+		return Light
+	}
+	defer key.Close()
+
+	appsUseLightTheme, _, err := key.GetIntegerValue("AppsUseLightTheme")
+	if err != nil || appsUseLightTheme != 0 {
+		return Light
+	}
+	return Dark
+}
+
+// IsHighContrastActive returns true if a high contrast theme is active, the theme of the window is then ignored
+func IsHighContrastActive() bool {
+	result := highContrast{}
+	result.cbSize = uint32(unsafe.Sizeof(result))
+	ret, _, _ := procSystemParametersInfo.Call(spiGetHighContrast, uintptr(result.cbSize), uintptr(unsafe.Pointer(&result)), 0)
+	return ret != 0 && result.dwFlags&hcfHighContrastOn != 0
+}
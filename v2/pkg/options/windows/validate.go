@@ -0,0 +1,136 @@
+package windows
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// webviewExecutable is the executable of the WebView2 runtime, which is in the root of a Fixed Version runtime
+const webviewExecutable = "msedgewebview2.exe"
+
+// OptionError is a problem of an option found by ValidateOptions
+type OptionError struct {
+	// Option is the name of the option, EG "WebviewUserDataPath"
+	Option string
+	Err    error
+}
+
+func (e *OptionError) Error() string {
+	return e.Option + ": " + e.Err.Error()
+}
+
+func (e *OptionError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateOptions checks the options which are otherwise only checked when the application starts, where a problem is
+// shown in a messagebox before the app exits. It returns nil if no problem has been found, otherwise it returns the
+// joined OptionErrors of every problem, which can be retrieved with errors.As.
+func ValidateOptions(o *Options) error {
+	if o == nil {
+		return nil
+	}
+	var problems []error
+	addProblem := func(option string, err error) {
+		problems = append(problems, &OptionError{Option: option, Err: err})
+	}
+
+	if o.WebviewUserDataPath != "" {
+		if err := validateUserDataPath(o.WebviewUserDataPath); err != nil {
+			addProblem("WebviewUserDataPath", err)
+		}
+	}
+	if o.WebviewBrowserPath != "" {
+		if err := validateBrowserPath(o.WebviewBrowserPath); err != nil {
+			addProblem("WebviewBrowserPath", err)
+		}
+	}
+
+	if o.BackdropType < Auto || o.BackdropType > Tabbed {
+		addProblem("BackdropType", fmt.Errorf("unknown backdrop type %d", o.BackdropType))
+	} else if o.BackdropType != Auto && !o.WindowIsTranslucent {
+		addProblem("BackdropType", errors.New("the backdrop type is ignored unless WindowIsTranslucent is enabled"))
+	} else if o.BackdropType == None && o.WindowIsTranslucent {
+		addProblem("BackdropType", errors.New("WindowIsTranslucent is enabled but the backdrop type None disables the translucency"))
+	}
+
+	if o.AcrylicTintOpacity < 0 || o.AcrylicTintOpacity > 1 {
+		addProblem("AcrylicTintOpacity", fmt.Errorf("the opacity %v is out of range 0.0-1.0", o.AcrylicTintOpacity))
+	} else if o.AcrylicTintOpacity != 0 && (o.BackdropType != Acrylic || !o.WindowIsTranslucent) {
+		addProblem("AcrylicTintOpacity", errors.New("the tint opacity is ignored unless the translucent window has the Acrylic backdrop"))
+	}
+
+	if o.Theme < SystemDefault || o.Theme > Light {
+		addProblem("Theme", fmt.Errorf("unknown theme %d", o.Theme))
+	}
+	if o.CornerPreference < CornerDefault || o.CornerPreference > CornerSquare {
+		addProblem("CornerPreference", fmt.Errorf("unknown corner preference %d", o.CornerPreference))
+	}
+	if o.ZoomFactor < 0 {
+		addProblem("ZoomFactor", fmt.Errorf("the zoom factor %v is negative", o.ZoomFactor))
+	}
+	if o.Modal && o.ParentWindow == 0 {
+		addProblem("Modal", errors.New("a modal window requires a ParentWindow"))
+	}
+
+	return errors.Join(problems...)
+}
+
+// validateUserDataPath checks that the user data folder can be created by WebView2, which creates the missing folders
+// of the path
+func validateUserDataPath(path string) error {
+	path, err := ExpandPath(path)
+	if err != nil {
+		return err
+	}
+	absolute, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("can't resolve %q: %w", path, err)
+	}
+	// Find the closest folder which exists, it must be writable to create the rest of the path
+	existing := absolute
+	for {
+		info, err := os.Stat(existing)
+		if err == nil {
+			if !info.IsDir() {
+				return fmt.Errorf("%q is not a directory", existing)
+			}
+			break
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			return fmt.Errorf("no folder of %q exists", absolute)
+		}
+		existing = parent
+	}
+	file, err := os.CreateTemp(existing, ".wails-*")
+	if err != nil {
+		return fmt.Errorf("%q is not writable: %w", existing, err)
+	}
+	_ = file.Close()
+	return os.Remove(file.Name())
+}
+
+// validateBrowserPath checks that the folder contains a Fixed Version WebView2 runtime
+func validateBrowserPath(path string) error {
+	path, err := ExpandPath(path)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%q is not a directory", path)
+	}
+	if _, err := os.Stat(filepath.Join(path, webviewExecutable)); err != nil {
+		return fmt.Errorf("%q doesn't contain the WebView2 runtime, %s is missing", path, webviewExecutable)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package windows
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateOptions(t *testing.T) {
+	directory := t.TempDir()
+	t.Setenv("WAILS_TEST_DIRECTORY", directory)
+	file := filepath.Join(directory, "file")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	runtime := filepath.Join(directory, "runtime")
+	if err := os.Mkdir(runtime, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(runtime, webviewExecutable), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		options *Options
+		want    []string
+	}{
+		{name: "Nil", options: nil},
+		{name: "Defaults", options: &Options{}},
+		{name: "Missing user data folder", options: &Options{WebviewUserDataPath: filepath.Join(directory, "a", "b")}},
+		{name: "Relative user data folder", options: &Options{WebviewUserDataPath: "data"}},
+		{name: "User data file", options: &Options{WebviewUserDataPath: file}, want: []string{"WebviewUserDataPath"}},
+		{name: "User data below a file", options: &Options{WebviewUserDataPath: filepath.Join(file, "data")}, want: []string{"WebviewUserDataPath"}},
+		{name: "Expanded user data folder", options: &Options{WebviewUserDataPath: filepath.Join("%WAILS_TEST_DIRECTORY%", "data")}},
+		{name: "Undefined variable", options: &Options{WebviewUserDataPath: filepath.Join("%WAILS_TEST_UNDEFINED%", "data")}, want: []string{"WebviewUserDataPath"}},
+		{name: "Browser", options: &Options{WebviewBrowserPath: runtime}},
+		{name: "Expanded browser", options: &Options{WebviewBrowserPath: filepath.Join("%WAILS_TEST_DIRECTORY%", "runtime")}},
+		{name: "Browser without runtime", options: &Options{WebviewBrowserPath: directory}, want: []string{"WebviewBrowserPath"}},
+		{name: "Missing browser", options: &Options{WebviewBrowserPath: filepath.Join(directory, "missing")}, want: []string{"WebviewBrowserPath"}},
+		{name: "Translucent backdrop", options: &Options{WindowIsTranslucent: true, BackdropType: Mica}},
+		{name: "Backdrop without translucency", options: &Options{BackdropType: Acrylic}, want: []string{"BackdropType"}},
+		{name: "Translucent without backdrop", options: &Options{WindowIsTranslucent: true, BackdropType: None}, want: []string{"BackdropType"}},
+		{name: "Unknown backdrop", options: &Options{WindowIsTranslucent: true, BackdropType: 9}, want: []string{"BackdropType"}},
+		{name: "Acrylic tint", options: &Options{WindowIsTranslucent: true, BackdropType: Acrylic, AcrylicTintOpacity: 0.8}},
+		{name: "Tint out of range", options: &Options{WindowIsTranslucent: true, BackdropType: Acrylic, AcrylicTintOpacity: 1.5}, want: []string{"AcrylicTintOpacity"}},
+		{name: "Tint without acrylic", options: &Options{WindowIsTranslucent: true, BackdropType: Mica, AcrylicTintOpacity: 0.5}, want: []string{"AcrylicTintOpacity"}},
+		{name: "Square corners", options: &Options{CornerPreference: CornerSquare}},
+		{name: "Unknown corner preference", options: &Options{CornerPreference: 7}, want: []string{"CornerPreference"}},
+		{name: "Modal without parent", options: &Options{Modal: true}, want: []string{"Modal"}},
+		{
+			name:    "Every problem",
+			options: &Options{WebviewUserDataPath: file, WebviewBrowserPath: directory, Theme: 5, ZoomFactor: -1},
+			want:    []string{"WebviewUserDataPath", "WebviewBrowserPath", "Theme", "ZoomFactor"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOptions(tt.options)
+			var got []string
+			if err != nil {
+				for _, problem := range err.(interface{ Unwrap() []error }).Unwrap() {
+					var optionError *OptionError
+					if !errors.As(problem, &optionError) {
+						t.Fatalf("%v is not an OptionError", problem)
+					}
+					got = append(got, optionError.Option)
+				}
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ValidateOptions() = %v, want problems of %v", err, tt.want)
+			}
+			for index := range got {
+				if got[index] != tt.want[index] {
+					t.Errorf("problem %d is of %s, want %s", index, got[index], tt.want[index])
+				}
+			}
+		})
+	}
+
+	// No temporary file is left behind
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Errorf("the directory contains %d entries, want 2", len(entries))
+	}
+}
@@ -1,5 +1,13 @@
 package windows
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
 type Theme int
 
 type Messages struct {
@@ -33,6 +41,131 @@ const (
 	Mica    BackdropType = 2
 	Acrylic BackdropType = 3
 	Tabbed  BackdropType = 4
+
+	// MicaAlt is the name used by the design guidelines of Windows 11 for the Tabbed backdrop, DWMSBT_TABBEDWINDOW. It
+	// is the Mica Alt material with the stronger tint, there is no other flag selecting it.
+	MicaAlt = Tabbed
+)
+
+// SwipeGestures is a set of swipe gestures of the webview
+type SwipeGestures int
+
+const (
+	// SwipeHorizontal navigates back and forward with a horizontal swipe
+	SwipeHorizontal SwipeGestures = 1 << iota
+	// SwipeVertical shows the elastic overscroll of a vertical swipe past the end of the page
+	SwipeVertical
+	// SwipeNone disables the swipe gestures, as zero selects the gestures of EnableSwipeGestures
+	SwipeNone
+)
+
+// EnabledSwipeGestures returns the swipe gestures of SwipeGestures, or of the deprecated EnableSwipeGestures if it is
+// zero. EnableSwipeGestures only enables the swipe navigation, the overscroll is always enabled with it.
+// DisableWebviewHistoryNavigation removes the swipe navigation.
+func (o *Options) EnabledSwipeGestures() SwipeGestures {
+	var result SwipeGestures
+	switch {
+	case o.SwipeGestures&SwipeNone != 0:
+		return 0
+	case o.SwipeGestures != 0:
+		result = o.SwipeGestures
+	case o.EnableSwipeGestures:
+		result = SwipeHorizontal | SwipeVertical
+	default:
+		result = SwipeVertical
+	}
+	if o.DisableWebviewHistoryNavigation {
+		result &^= SwipeHorizontal
+	}
+	return result
+}
+
+// CornerPreference is the rounding of the corners of the window on Windows 11
+type CornerPreference int
+
+const (
+	// CornerDefault lets Windows decide whether the corners are rounded
+	CornerDefault CornerPreference = 0
+	// CornerRound rounds the corners
+	CornerRound CornerPreference = 1
+	// CornerRoundSmall rounds the corners with a small radius
+	CornerRoundSmall CornerPreference = 2
+	// CornerSquare never rounds the corners
+	CornerSquare CornerPreference = 3
+)
+
+// ScrollbarStyle is the style of the scrollbars of the webview
+type ScrollbarStyle int
+
+const (
+	// ScrollbarStyleDefault uses the classic scrollbars of WebView2, which are always visible and take up space
+	ScrollbarStyleDefault ScrollbarStyle = 0
+	// ScrollbarStyleOverlay uses the thin Fluent scrollbars of Windows 11, which overlay the content and are hidden
+	// while the page is not scrolled, like the scrollbars of WebKit on macOS and Linux
+	ScrollbarStyleOverlay ScrollbarStyle = 1
+)
+
+// TrackingPreventionLevel is the level of the tracking prevention of WebView2
+type TrackingPreventionLevel int
+
+const (
+	// TrackingPreventionDefault keeps the level of the profile, which is the default of Edge unless it has been set
+	TrackingPreventionDefault TrackingPreventionLevel = 0
+	// TrackingPreventionNone disables the tracking prevention
+	TrackingPreventionNone TrackingPreventionLevel = 1
+	// TrackingPreventionBasic blocks the trackers of harmful sites, EG cryptomining and fingerprinting
+	TrackingPreventionBasic TrackingPreventionLevel = 2
+	// TrackingPreventionBalanced also blocks the trackers of sites which haven't been visited
+	TrackingPreventionBalanced TrackingPreventionLevel = 3
+	// TrackingPreventionStrict blocks most trackers, which may break some sites
+	TrackingPreventionStrict TrackingPreventionLevel = 4
+)
+
+// PermissionKind is the kind of a permission requested by a page, the values are the COREWEBVIEW2_PERMISSION_KIND
+// values of WebView2
+type PermissionKind int
+
+const (
+	PermissionUnknown                     PermissionKind = 0
+	PermissionMicrophone                  PermissionKind = 1
+	PermissionCamera                      PermissionKind = 2
+	PermissionGeolocation                 PermissionKind = 3
+	PermissionNotifications               PermissionKind = 4
+	PermissionOtherSensors                PermissionKind = 5
+	PermissionClipboardRead               PermissionKind = 6
+	PermissionMultipleAutomaticDownloads  PermissionKind = 7
+	PermissionFileReadWrite               PermissionKind = 8
+	PermissionAutoplay                    PermissionKind = 9
+	PermissionLocalFonts                  PermissionKind = 10
+	PermissionMidiSystemExclusiveMessages PermissionKind = 11
+	PermissionWindowManagement            PermissionKind = 12
+)
+
+// PermissionDecision is the answer to a permission request
+type PermissionDecision int
+
+const (
+	// PermissionDefault lets WebView2 decide, which prompts the user unless the permission has already been granted
+	PermissionDefault PermissionDecision = 0
+	// PermissionAllow grants the permission without a prompt
+	PermissionAllow PermissionDecision = 1
+	// PermissionDeny denies the permission without a prompt
+	PermissionDeny PermissionDecision = 2
+)
+
+// NewWindowAction is the handling of a request of a page to open a new window, EG by a link with target="_blank" or
+// by window.open
+type NewWindowAction int
+
+const (
+	// AllowNewWindow lets WebView2 open the page in a popup window, which isn't controlled by the application
+	AllowNewWindow NewWindowAction = 0
+	// OpenInDefaultBrowser opens the URI in the default browser of the user instead
+	OpenInDefaultBrowser NewWindowAction = 1
+	// OpenInSameWindow navigates the webview of the application to the URI instead
+	OpenInSameWindow NewWindowAction = 2
+	// Block ignores the request
+	Block NewWindowAction = 3
 )
 
 func RGB(r, g, b uint8) int32 {
@@ -42,6 +175,28 @@ func RGB(r, g, b uint8) int32 {
 	return col
 }
 
+// RGBA returns the colour in the 0xAABBGGRR layout, the alpha is in the byte which is zero for RGB
+func RGBA(r, g, b, a uint8) int32 {
+	return int32(uint32(a)<<24 | uint32(RGB(r, g, b)))
+}
+
+// RGBHex returns the colour of a hex string like the ones of the design tools in the 0x00BBGGRR layout of RGB. The
+// string is "#RRGGBB", "RRGGBB" or "0xRRGGBB".
+func RGBHex(s string) (int32, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if hex == s {
+		hex = strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	}
+	if len(hex) != 6 {
+		return 0, fmt.Errorf("invalid hex colour %q: expected 6 hex digits in the form #RRGGBB, RRGGBB or 0xRRGGBB", s)
+	}
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hex colour %q: %q is not a hex number", s, hex)
+	}
+	return RGB(uint8(value>>16), uint8(value>>8), uint8(value)), nil
+}
+
 // ThemeSettings contains optional colours to use.
 // They may be set using the hex values: 0x00BBGGRR
 type ThemeSettings struct {
@@ -59,6 +214,45 @@ type ThemeSettings struct {
 	LightModeBorderInactive    int32
 }
 
+// Rect is a rectangle of the screen in physical pixels, in the coordinates of the virtual screen
+type Rect struct {
+	X      int
+	Y      int
+	Width  int
+	Height int
+}
+
+// ProxySettings is an explicit proxy of the webview, which replaces the system proxy
+type ProxySettings struct {
+	// Server is the proxy, EG "proxy.example.com:8080" or "socks5://proxy.example.com:1080", or a proxy per scheme, EG
+	// "http=proxy1:8080;https=proxy2:8080"
+	Server string
+	// PACURL is the URL of a proxy auto-config script, it is used if Server is empty
+	PACURL string
+	// BypassList are the hosts which are accessed without the proxy, EG "*.example.com" or "<local>"
+	BypassList []string
+	// Username and Password answer the authentication challenges of Server
+	Username string
+	Password string
+}
+
+// BrowserArguments returns the arguments of the browser process of WebView2 which set the proxy
+func (p *ProxySettings) BrowserArguments() []string {
+	var result []string
+	switch {
+	case p.Server != "":
+		result = append(result, "--proxy-server="+p.Server)
+	case p.PACURL != "":
+		result = append(result, "--proxy-pac-url="+p.PACURL)
+	default:
+		return nil
+	}
+	if len(p.BypassList) > 0 {
+		result = append(result, "--proxy-bypass-list="+strings.Join(p.BypassList, ";"))
+	}
+	return result
+}
+
 // Options are options specific to Windows
 type Options struct {
 	WebviewIsTransparent bool
@@ -67,6 +261,10 @@ type Options struct {
 
 	IsZoomControlEnabled bool
 	ZoomFactor           float64
+	// PersistZoomPerOrigin remembers the zoom of every origin, EG when it has been changed by the user with
+	// IsZoomControlEnabled, and restores it when the origin is loaded again, also after a restart of the app.
+	// ZoomFactor is used for origins without a remembered zoom.
+	PersistZoomPerOrigin bool
 
 	DisablePinchZoom bool
 
@@ -74,11 +272,48 @@ type Options struct {
 	// "Rounded Corners" are only available on Windows 11.
 	DisableFramelessWindowDecorations bool
 
+	// DisableMinimiseButton and DisableMaximiseButton disable the minimise and the maximise buttons of the caption.
+	// Windows grays out a disabled button, it hides both buttons if both are disabled.
+	DisableMinimiseButton bool
+	DisableMaximiseButton bool
+
+	// DisableCloseButton grays out the close button and the close item of the window menu, and ignores Alt+F4 unless
+	// AllowAltF4 is set. The window can still be closed with runtime.Quit.
+	DisableCloseButton bool
+	AllowAltF4         bool
+
+	// EnableDevTools enables the dev tools of the webview also in production builds, EG to diagnose an issue on the
+	// machine of a user. They are opened with Ctrl+Shift+F12 or runtime.WindowOpenDevTools.
+	EnableDevTools bool
+
+	// DisableWindowAnimations disables the animations of the window, EG when it is minimised and restored, which can
+	// lag on low-end hardware. The system setting isn't changed. It can be changed with runtime.WindowSetAnimationsEnabled.
+	DisableWindowAnimations bool
+
+	// SkipTaskbar hides the window from the taskbar and Alt+Tab, EG for a utility opened from the system tray. It can
+	// be changed with runtime.WindowSetSkipTaskbar.
+	SkipTaskbar bool
+
+	// CornerPreference selects the rounding of the corners of the window, EG CornerSquare to match a custom design.
+	// Requires Windows 11, it is ignored by Windows 10.
+	CornerPreference CornerPreference
+
+	// EnableContentProtection excludes the window from screenshots, screen recording and screen sharing. Requires
+	// Windows 10 2004, the window is captured black by the previous versions.
+	EnableContentProtection bool
+
+	// RestoreWindowState saves the position, the size and the maximised state of the window when the application is
+	// closed and restores them when it is started again. The window is moved onto a connected screen if its screen
+	// has been disconnected. The state is saved in the user data folder of the webview.
+	RestoreWindowState bool
+
 	// Path where the WebView2 stores the user data. If empty %APPDATA%\[BinaryName.exe] will be used.
 	// If the path is not valid, a messagebox will be displayed with the error and the app will exit with error code.
+	// ValidateOptions checks the path beforehand. Environment variables like %LOCALAPPDATA% are expanded.
 	WebviewUserDataPath string
 
 	// Path to the directory with WebView2 executables. If empty WebView2 installed in the system will be used.
+	// Environment variables are expanded like in WebviewUserDataPath.
 	WebviewBrowserPath string
 
 	// Dark/Light or System Default Theme
@@ -90,9 +325,47 @@ type Options struct {
 	// Select the type of translucent backdrop. Requires Windows 11 22621 or later.
 	BackdropType BackdropType
 
+	// AcrylicTintOpacity is the opacity of the tint of the Acrylic backdrop, from 0.0 to 1.0. Zero keeps the tint of
+	// the system backdrop, values outside of the range are clamped. The tint requires Windows 10 1803 or later, it is
+	// ignored by the older versions.
+	AcrylicTintOpacity float64
+
 	// User messages that can be customised
 	Messages *Messages
 
+	// OnWebView2Crash is called when a process of WebView2 has failed, with the kind of process as reason, EG
+	// "browser-process-exited", "render-process-exited" or "gpu-process-exited". Returning true recreates the webview
+	// after its browser process has exited, or reloads the app after its render process has exited or is unresponsive.
+	// Otherwise the WebView2ProcessCrash message is shown if the browser process has exited, and the app exits. It is
+	// called on the main thread.
+	OnWebView2Crash func(reason string) (restart bool)
+
+	// OnWebviewPermissionRequest is called when a page requests a permission, EG the camera or the clipboard, with the
+	// URI of the page. Without it every permission is allowed. It is called on the main thread, so it must not block.
+	OnWebviewPermissionRequest func(kind PermissionKind, uri string) PermissionDecision
+
+	// OnWebviewNewWindowRequest is called when a page requests to open the URI in a new window, EG with a link with
+	// target="_blank" or with window.open. It is called on the main thread, so it must not block.
+	OnWebviewNewWindowRequest func(uri string) NewWindowAction
+
+	// OnWebviewDownloadStarted is called when a download of the webview starts, with the URI of the download and the
+	// name of the file suggested by WebView2. Returning an absolute path saves the file there instead of the Downloads
+	// folder, returning cancel cancels the download. It is called on the main thread, so it must not block.
+	OnWebviewDownloadStarted func(uri, suggestedName string) (savePath string, cancel bool)
+
+	// OnWebviewDownloadProgress is called when bytes of a download of the webview have been received. totalBytes is -1
+	// if the size is unknown. It is called on the main thread, so it must not block.
+	OnWebviewDownloadProgress func(uri string, bytesReceived, totalBytes int64)
+
+	// OnWebView2DownloadProgress is called while the installer of the WebView2 runtime is downloaded, when the runtime
+	// is missing at startup. totalBytes is -1 if the size is unknown. The installer downloads the runtime itself, which
+	// isn't reported.
+	OnWebView2DownloadProgress func(bytesReceived, totalBytes int64)
+
+	// OnWebView2InstallComplete is called when the installer of the WebView2 runtime has finished, with nil if the
+	// runtime has been installed. It isn't called if the user declined the installation.
+	OnWebView2InstallComplete func(err error)
+
 	// ResizeDebounceMS is the amount of time to debounce redraws of webview2
 	// when resizing the window
 	ResizeDebounceMS uint16
@@ -103,9 +376,47 @@ type Options struct {
 	// OnResume is called when Windows resumes from low power mode
 	OnResume func()
 
+	// OnMinimise is called when the window is minimised
+	OnMinimise func()
+
+	// OnMaximise is called when the window is maximised
+	OnMaximise func()
+
+	// OnRestore is called when the window is restored after being minimised
+	OnRestore func()
+
+	// OnUnMaximise is called when the window leaves the maximised state for the normal state
+	OnUnMaximise func()
+
+	// OnDPIChanged is called with the old and the new DPI when the DPI of the window changes, EG when it is moved to a
+	// monitor with another scale. It is called before the window is resized to the suggested rectangle, which is the
+	// bounds Windows suggests for the window at the new DPI.
+	OnDPIChanged func(oldDPI, newDPI uint, suggested Rect)
+
+	// OnThemeChanged is called with the new mode when a change of the system theme changes the effective theme of the
+	// window. It isn't called when Theme forces the dark or the light theme.
+	OnThemeChanged func(isDarkMode bool)
+
 	// WebviewGpuIsDisabled is used to enable / disable GPU acceleration for the webview
 	WebviewGpuIsDisabled bool
 
+	// WebviewBrowserArguments are additional command line arguments of the browser process of WebView2, EG "--lang=de"
+	// or "--proxy-server=proxy.example.com:8080". The features of --enable-features and --disable-features are merged
+	// with the ones set by the other options. Unknown or malformed arguments are ignored by WebView2.
+	WebviewBrowserArguments []string
+
+	// WebviewProxy sets an explicit proxy of the webview, the system proxy is used if it is nil. The proxy applies to
+	// all webviews sharing the browser process, which are the webviews with the same WebviewUserDataPath.
+	WebviewProxy *ProxySettings
+
+	// WebviewScrollbarStyle selects the style of the scrollbars of the webview. The style applies to all webviews
+	// sharing the WebviewUserDataPath.
+	WebviewScrollbarStyle ScrollbarStyle
+
+	// TrackingPreventionLevel sets the tracking prevention of the webview. The level is stored in the profile, so it
+	// applies to all webviews sharing the WebviewUserDataPath. It requires WebView2 Runtime 1.0.1722.45 or later.
+	TrackingPreventionLevel TrackingPreventionLevel
+
 	// WebviewDisableRendererCodeIntegrity disables the `RendererCodeIntegrity` of WebView2. Some Security Endpoint
 	// Protection Software inject themself into the WebView2 with unsigned or wrongly signed dlls, which is not allowed
 	// and will stop the WebView2 processes. Those security software need an update to fix this issue or one can disable
@@ -116,11 +427,82 @@ type Options struct {
 	// !! Please keep in mind when disabling this feature, this also allows malicious software to inject into the WebView2 !!
 	WebviewDisableRendererCodeIntegrity bool
 
+	// DisableSmartScreen disables the SmartScreen reputation checks of WebView2, which warn about or block downloads
+	// and navigations. SmartScreen is only enabled by EnableFraudulentWebsiteDetection, this keeps it disabled on
+	// Windows while the fraudulent website warnings stay enabled on macOS.
+	DisableSmartScreen bool
+
 	// Configure whether swipe gestures should be enabled
+	//
+	// Deprecated: Use SwipeGestures, true is SwipeHorizontal | SwipeVertical. It is only used if SwipeGestures is zero.
 	EnableSwipeGestures bool
 
+	// SwipeGestures selects the swipe gestures handled by the webview, EG SwipeVertical to keep the overscroll while a
+	// horizontal swipe is handled by the page. Zero uses EnableSwipeGestures, SwipeNone disables all the gestures.
+	SwipeGestures SwipeGestures
+
+	// DisableWebviewHistoryNavigation stops the back and forward buttons of the mouse and the horizontal swipe from
+	// navigating the history of the webview, EG when the router of the page keeps its own state. The page still
+	// receives the mouse events of the buttons.
+	DisableWebviewHistoryNavigation bool
+
+	// DisableDefaultContextMenu hides the context menu of the webview, with its Reload and Save as items, even in
+	// development and in a debug build. It takes precedence over options.App.EnableDefaultContextMenu.
+	DisableDefaultContextMenu bool
+
+	// WebviewUserAgent replaces the user agent of the webview, which is used by the requests of the main frame and of
+	// the subresources and by navigator.userAgent. wails.io is appended to it as on macOS and Linux. The default user
+	// agent of Edge is kept if it is empty.
+	WebviewUserAgent string
+
+	// WebviewPreloadScripts are run in their order before the scripts of every document, including the documents of
+	// the frames, EG to expose a bridge or the feature flags on window. runtime.WebviewAddPreloadScript adds a script
+	// while the application runs.
+	WebviewPreloadScripts []string
+
 	// Class name for the window. If empty, 'wailsWindow' will be used.
 	WindowClassName string
+
+	// ParentWindow is the native handle (HWND) of a window which owns the application window, EG when the
+	// application is launched as a dialog of another application. The window then stays on top of its parent and is
+	// minimised with it.
+	ParentWindow uintptr
+
+	// Modal disables the ParentWindow while the application window is visible
+	Modal bool
+
+	// KeyboardHook captures key combinations before they are handled by the OS, EG for kiosk applications
+	KeyboardHook *KeyboardHook
+
+	// SuppressedKeys are key combinations which are swallowed while the webview has the focus, neither the page nor the
+	// application menu receive them, EG keys.Key("f5"). The browser accelerator keys, like F5 and Ctrl+R to reload or
+	// Ctrl+F to find, are always disabled. Only the key combinations with Ctrl or Alt and the keys which don't type a
+	// character can be suppressed. The modifiers must match exactly.
+	SuppressedKeys []*keys.Accelerator
+
+	// HideMenuBar removes the menu bar of the application menu from the window, EG to render the menu in a custom
+	// title bar with runtime.MenuGetStructure. The accelerators of the menu items still work.
+	HideMenuBar bool
+}
+
+// KeyboardHook configures a low-level keyboard hook which captures key combinations while the application window is
+// active, before they are handled by the OS. Ctrl+Alt+Del can't be captured.
+type KeyboardHook struct {
+	// BlockSystemKeys captures the key combinations handled by the OS: the Windows key, Alt+Tab, Alt+Esc, Ctrl+Esc
+	// and Alt+F4
+	BlockSystemKeys bool
+
+	// Passthrough are key combinations which are still handled by the OS when BlockSystemKeys is enabled,
+	// EG keys.OptionOrAlt("tab"). The modifiers must match exactly. The Windows key is passed through with
+	// keys.Key("win"), whatever the modifiers.
+	Passthrough []*keys.Accelerator
+
+	// Capture are additional key combinations which are captured, EG a secret combination to exit a kiosk.
+	// The modifiers must match exactly.
+	Capture []*keys.Accelerator
+
+	// OnKeyCaptured is called with the matching accelerator of Capture when the key combination has been pressed
+	OnKeyCaptured func(accelerator *keys.Accelerator)
 }
 
 func DefaultMessages() *Messages {
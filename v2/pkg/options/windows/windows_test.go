@@ -0,0 +1,91 @@
+package windows
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRGBHex(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    int32
+		wantErr bool
+	}{
+		{name: "Hash", input: "#1E1E1E", want: 0x001E1E1E},
+		{name: "No prefix", input: "FF8000", want: 0x000080FF},
+		{name: "0x prefix", input: "0x0000ff", want: 0x00FF0000},
+		{name: "Lower case", input: "#abcdef", want: RGB(0xAB, 0xCD, 0xEF)},
+		{name: "Too short", input: "#FFF", wantErr: true},
+		{name: "Too long", input: "#FF000000", wantErr: true},
+		{name: "Not hex", input: "#GG0000", wantErr: true},
+		{name: "Sign", input: "+FFFFF", wantErr: true},
+		{name: "Two prefixes", input: "#0xFFFFFF", wantErr: true},
+		{name: "Empty", input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RGBHex(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RGBHex(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("RGBHex(%q) = %#08x, want %#08x", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRGBA(t *testing.T) {
+	if got := RGBA(0x11, 0x22, 0x33, 0x00); got != RGB(0x11, 0x22, 0x33) {
+		t.Errorf("RGBA with a zero alpha = %#08x, want %#08x", got, RGB(0x11, 0x22, 0x33))
+	}
+	if got := uint32(RGBA(0x11, 0x22, 0x33, 0xFF)); got != 0xFF332211 {
+		t.Errorf("RGBA = %#08x, want 0xff332211", got)
+	}
+}
+
+func TestEnabledSwipeGestures(t *testing.T) {
+	tests := []struct {
+		name    string
+		options Options
+		want    SwipeGestures
+	}{
+		{name: "Default", options: Options{}, want: SwipeVertical},
+		{name: "Deprecated alias", options: Options{EnableSwipeGestures: true}, want: SwipeHorizontal | SwipeVertical},
+		{name: "Vertical", options: Options{SwipeGestures: SwipeVertical}, want: SwipeVertical},
+		{name: "Horizontal", options: Options{SwipeGestures: SwipeHorizontal}, want: SwipeHorizontal},
+		{name: "Mask overrides the alias", options: Options{EnableSwipeGestures: true, SwipeGestures: SwipeVertical}, want: SwipeVertical},
+		{name: "None", options: Options{EnableSwipeGestures: true, SwipeGestures: SwipeNone}, want: 0},
+		{name: "History navigation disabled", options: Options{SwipeGestures: SwipeHorizontal | SwipeVertical, DisableWebviewHistoryNavigation: true}, want: SwipeVertical},
+		{name: "History navigation disabled with the alias", options: Options{EnableSwipeGestures: true, DisableWebviewHistoryNavigation: true}, want: SwipeVertical},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.options.EnabledSwipeGestures(); got != tt.want {
+				t.Errorf("EnabledSwipeGestures() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProxyBrowserArguments(t *testing.T) {
+	tests := []struct {
+		name  string
+		proxy ProxySettings
+		want  []string
+	}{
+		{name: "Empty", proxy: ProxySettings{BypassList: []string{"<local>"}}, want: nil},
+		{name: "Server", proxy: ProxySettings{Server: "proxy:8080"}, want: []string{"--proxy-server=proxy:8080"}},
+		{name: "PAC", proxy: ProxySettings{PACURL: "http://example.com/proxy.pac"}, want: []string{"--proxy-pac-url=http://example.com/proxy.pac"}},
+		{name: "Server overrides the PAC", proxy: ProxySettings{Server: "proxy:8080", PACURL: "http://example.com/proxy.pac"}, want: []string{"--proxy-server=proxy:8080"}},
+		{name: "Bypass list", proxy: ProxySettings{Server: "proxy:8080", BypassList: []string{"<local>", "*.example.com"}}, want: []string{"--proxy-server=proxy:8080", "--proxy-bypass-list=<local>;*.example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.proxy.BrowserArguments(); !slices.Equal(got, tt.want) {
+				t.Errorf("BrowserArguments() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+type AccessibilitySettings = frontend.AccessibilitySettings
+
+// AccessibilityChangeEvent is emitted with the new AccessibilitySettings when the user changes the reduced motion or
+// the high contrast setting of the OS
+const AccessibilityChangeEvent = frontend.AccessibilityChangeEvent
+
+// SystemPrefersReducedMotion returns true if the user has asked the OS for less animations
+func SystemPrefersReducedMotion(ctx context.Context) bool {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.SystemPrefersReducedMotion()
+}
+
+// SystemHighContrastActive returns true if a high contrast theme of the OS is active
+func SystemHighContrastActive(ctx context.Context) bool {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.SystemHighContrastActive()
+}
@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"context"
+	"runtime/debug"
+)
+
+// These are set by the Wails build through ldflags
+var (
+	appName      string
+	appVersion   string
+	appBuildDate string
+	appGitCommit string
+)
+
+// AppInfo contains information about the application and its build
+type AppInfo struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	BuildDate string `json:"buildDate"`
+	GitCommit string `json:"gitCommit"`
+	BuildType string `json:"buildType"`
+}
+
+// GetAppInfo returns the name, version, build date, git commit and build type of the application.
+// The values are taken from the project config when building with the Wails CLI. Otherwise the build info embedded by
+// the Go toolchain is used where available.
+func GetAppInfo(ctx context.Context) AppInfo {
+	result := AppInfo{
+		Name:      appName,
+		Version:   appVersion,
+		BuildDate: appBuildDate,
+		GitCommit: appGitCommit,
+		BuildType: Environment(ctx).BuildType,
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		if result.Version == "" && info.Main.Version != "(devel)" {
+			result.Version = info.Main.Version
+		}
+		for _, setting := range info.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if result.GitCommit == "" {
+					result.GitCommit = setting.Value
+				}
+			case "vcs.time":
+				if result.BuildDate == "" {
+					result.BuildDate = setting.Value
+				}
+			}
+		}
+	}
+	return result
+}
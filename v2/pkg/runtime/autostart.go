@@ -0,0 +1,30 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/system/autostart"
+)
+
+// ErrLaunchAtLoginNotSupported is returned by the launch at login functions on unsupported platforms
+var ErrLaunchAtLoginNotSupported = autostart.ErrNotSupported
+
+// WasLaunchedAtLogin returns true if the application has been launched at login by the registration of
+// SetLaunchAtLogin. The marker argument of the registration is removed from os.Args before main is called.
+func WasLaunchedAtLogin(ctx context.Context) bool {
+	return autostart.LaunchedAtLogin()
+}
+
+// SetLaunchAtLogin registers the executable to be launched when the user logs in, or removes the registration.
+// This uses the Run key of the registry on Windows, a launch agent on macOS and an XDG autostart entry on Linux.
+func SetLaunchAtLogin(ctx context.Context, enabled bool) error {
+	if enabled {
+		return autostart.Enable()
+	}
+	return autostart.Disable()
+}
+
+// IsLaunchAtLoginEnabled returns true if the executable has been registered with SetLaunchAtLogin
+func IsLaunchAtLoginEnabled(ctx context.Context) (bool, error) {
+	return autostart.IsEnabled()
+}
@@ -0,0 +1,37 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// CacheKind selects the browsing data cleared by WebviewClearCache, the kinds can be combined
+type CacheKind = frontend.CacheKind
+
+const (
+	CacheCookies    = frontend.CacheCookies
+	CacheDiskCache  = frontend.CacheDiskCache
+	CacheDOMStorage = frontend.CacheDOMStorage
+	CacheIndexedDB  = frontend.CacheIndexedDB
+	CacheOther      = frontend.CacheOther
+	CacheAll        = frontend.CacheAll
+)
+
+// WebviewClearCache clears the browsing data of the webview, EG the cookies to sign out. It returns once the data has
+// been cleared. Not supported on macOS.
+func WebviewClearCache(ctx context.Context, kinds CacheKind) error {
+	return WebviewClearCacheInRange(ctx, kinds, time.Time{}, time.Time{})
+}
+
+// WebviewClearCacheInRange clears the browsing data of the webview which has been modified between start and end. A
+// zero start clears the data since the beginning and a zero end until now. Linux only supports a zero end. Not
+// supported on macOS.
+func WebviewClearCacheInRange(ctx context.Context, kinds CacheKind, start, end time.Time) error {
+	if err := frontend.ValidateClearCache(kinds, start, end); err != nil {
+		return err
+	}
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WebviewClearCache(kinds, start, end)
+}
@@ -0,0 +1,28 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// ImageFormat is the encoding of the images captured by WebviewCapturePreview and WindowCapture
+type ImageFormat = frontend.ImageFormat
+
+const (
+	ImagePNG  = frontend.ImagePNG
+	ImageJPEG = frontend.ImageJPEG
+)
+
+// WebviewCapturePreview captures the visible content of the webview as PNG or JPEG, at the DPI of the window, EG to
+// attach it to a bug report
+func WebviewCapturePreview(ctx context.Context, format ImageFormat) ([]byte, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WebviewCapturePreview(format)
+}
+
+// WindowCapture captures the whole window including its frame as PNG or JPEG. Windows only.
+func WindowCapture(ctx context.Context, format ImageFormat) ([]byte, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowCapture(format)
+}
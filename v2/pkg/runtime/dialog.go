@@ -3,6 +3,8 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/internal/fs"
@@ -29,6 +31,23 @@ const (
 // MessageDialogOptions contains the options for the Message dialogs, EG Info, Warning, etc runtime methods
 type MessageDialogOptions = frontend.MessageDialogOptions
 
+// DateTimePickerOptions contains the options for the DateTimePickerDialog runtime method
+type DateTimePickerOptions = frontend.DateTimePickerOptions
+
+type DateTimePickerMode = frontend.DateTimePickerMode
+
+// InputDialogOptions contains the options for the InputDialog runtime method
+type InputDialogOptions = frontend.InputDialogOptions
+
+// SelectDialogOptions contains the options for the SelectDialog runtime method
+type SelectDialogOptions = frontend.SelectDialogOptions
+
+const (
+	DateTimePickerDateTime = frontend.DateTimePickerDateTime
+	DateTimePickerDate     = frontend.DateTimePickerDate
+	DateTimePickerTime     = frontend.DateTimePickerTime
+)
+
 // OpenDirectoryDialog prompts the user to select a directory
 func OpenDirectoryDialog(ctx context.Context, dialogOptions OpenDialogOptions) (string, error) {
 	appFrontend := getFrontend(ctx)
@@ -37,7 +56,7 @@ func OpenDirectoryDialog(ctx context.Context, dialogOptions OpenDialogOptions) (
 			return "", fmt.Errorf("default directory '%s' does not exist", dialogOptions.DefaultDirectory)
 		}
 	}
-	return appFrontend.OpenDirectoryDialog(dialogOptions)
+	return canonicalDialogPath(appFrontend.OpenDirectoryDialog(dialogOptions))
 }
 
 // OpenFileDialog prompts the user to select a file
@@ -48,7 +67,7 @@ func OpenFileDialog(ctx context.Context, dialogOptions OpenDialogOptions) (strin
 			return "", fmt.Errorf("default directory '%s' does not exist", dialogOptions.DefaultDirectory)
 		}
 	}
-	return appFrontend.OpenFileDialog(dialogOptions)
+	return canonicalDialogPath(appFrontend.OpenFileDialog(dialogOptions))
 }
 
 // OpenMultipleFilesDialog prompts the user to select a file
@@ -59,7 +78,11 @@ func OpenMultipleFilesDialog(ctx context.Context, dialogOptions OpenDialogOption
 			return nil, fmt.Errorf("default directory '%s' does not exist", dialogOptions.DefaultDirectory)
 		}
 	}
-	return appFrontend.OpenMultipleFilesDialog(dialogOptions)
+	paths, err := appFrontend.OpenMultipleFilesDialog(dialogOptions)
+	for index := range paths {
+		paths[index], _ = canonicalDialogPath(paths[index], nil)
+	}
+	return paths, err
 }
 
 // SaveFileDialog prompts the user to select a file
@@ -70,7 +93,7 @@ func SaveFileDialog(ctx context.Context, dialogOptions SaveDialogOptions) (strin
 			return "", fmt.Errorf("default directory '%s' does not exist", dialogOptions.DefaultDirectory)
 		}
 	}
-	return appFrontend.SaveFileDialog(dialogOptions)
+	return canonicalDialogPath(appFrontend.SaveFileDialog(dialogOptions))
 }
 
 // MessageDialog show a message dialog to the user
@@ -78,3 +101,84 @@ func MessageDialog(ctx context.Context, dialogOptions MessageDialogOptions) (str
 	appFrontend := getFrontend(ctx)
 	return appFrontend.MessageDialog(dialogOptions)
 }
+
+// DateTimePickerDialog shows the native date and time picker. It returns the picked time in the local time zone and
+// false if the dialog was cancelled. In date mode the time is midnight, in time mode the date is the date of the default.
+func DateTimePickerDialog(ctx context.Context, dialogOptions DateTimePickerOptions) (time.Time, bool) {
+	appFrontend := getFrontend(ctx)
+	if dialogOptions.Mode == "" {
+		dialogOptions.Mode = DateTimePickerDateTime
+	}
+	if dialogOptions.Default.IsZero() {
+		dialogOptions.Default = time.Now()
+	}
+	dialogOptions.Default = clampTime(dialogOptions.Default.Local(), dialogOptions.Min, dialogOptions.Max)
+	value, ok := appFrontend.DateTimePickerDialog(dialogOptions)
+	if !ok {
+		return time.Time{}, false
+	}
+	if dialogOptions.Mode == DateTimePickerDate {
+		value = time.Date(value.Year(), value.Month(), value.Day(), 0, 0, 0, 0, time.Local)
+	}
+	return clampTime(value, dialogOptions.Min, dialogOptions.Max), true
+}
+
+// InputDialog prompts the user for a text, like the prompt function of JS which is disabled in the webviews. It returns
+// false if the dialog was cancelled.
+func InputDialog(ctx context.Context, dialogOptions InputDialogOptions) (string, bool) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.InputDialog(dialogOptions)
+}
+
+// SelectDialog lets the user select an item of a list, or any number of items when Multiple is set. It returns the
+// selected items in the order of the list, and false if the dialog was cancelled or there are no items. In single
+// selection mode the first item is selected by default.
+func SelectDialog(ctx context.Context, dialogOptions SelectDialogOptions) ([]string, bool) {
+	appFrontend := getFrontend(ctx)
+	if len(dialogOptions.Items) == 0 {
+		return nil, false
+	}
+	if !dialogOptions.Multiple {
+		dialogOptions.Default = []string{defaultSelection(dialogOptions.Items, dialogOptions.Default)}
+	}
+	return appFrontend.SelectDialog(dialogOptions)
+}
+
+// defaultSelection returns the first default which is an item, or the first item
+func defaultSelection(items []string, defaults []string) string {
+	for _, value := range defaults {
+		if slices.Contains(items, value) {
+			return value
+		}
+	}
+	return items[0]
+}
+
+// clampTime limits value to the range, a zero min or max leaves that end of the range open
+func clampTime(value, min, max time.Time) time.Time {
+	if !min.IsZero() && value.Before(min) {
+		return min.Local()
+	}
+	if !max.IsZero() && value.After(max) {
+		return max.Local()
+	}
+	return value
+}
+
+// CanonicalizePath returns the absolute path with all symlinks resolved. The paths returned by the dialogs are
+// already canonicalized, so they can be compared with paths canonicalized by this function.
+func CanonicalizePath(ctx context.Context, path string) (string, error) {
+	return fs.CanonicalPath(path)
+}
+
+// canonicalDialogPath canonicalizes the path returned by a dialog, which resolves symlinks on macOS but not on the other
+// platforms. The path is returned as is if it can't be canonicalized.
+func canonicalDialogPath(path string, err error) (string, error) {
+	if err != nil || path == "" {
+		return path, err
+	}
+	if canonical, err := fs.CanonicalPath(path); err == nil {
+		return canonical, nil
+	}
+	return path, nil
+}
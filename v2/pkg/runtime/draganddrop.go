@@ -3,15 +3,20 @@ package runtime
 import (
 	"context"
 	"fmt"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
 )
 
+// FileDropEvent is emitted with the position and the paths of the files dropped on the window
+const FileDropEvent = frontend.FileDropEvent
+
 // OnFileDrop returns a slice of file path strings when a drop is finished.
 func OnFileDrop(ctx context.Context, callback func(x, y int, paths []string)) {
 	if callback == nil {
 		LogError(ctx, "OnFileDrop called with a nil callback")
 		return
 	}
-	EventsOn(ctx, "wails:file-drop", func(optionalData ...interface{}) {
+	EventsOn(ctx, FileDropEvent, func(optionalData ...interface{}) {
 		if len(optionalData) != 3 {
 			callback(0, 0, nil)
 		}
@@ -33,5 +38,5 @@ func OnFileDrop(ctx context.Context, callback func(x, y int, paths []string)) {
 
 // OnFileDropOff removes the drag and drop listeners and handlers.
 func OnFileDropOff(ctx context.Context) {
-	EventsOff(ctx, "wails:file-drop")
+	EventsOff(ctx, FileDropEvent)
 }
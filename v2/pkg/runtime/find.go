@@ -0,0 +1,29 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// FindOptions contains the options for FindInPage
+type FindOptions = frontend.FindOptions
+
+// FindResult is emitted with the FindResultEvent after FindInPage has searched the page
+type FindResult = frontend.FindResult
+
+// FindResultEvent is emitted with a FindResult each time FindInPage has searched the page
+const FindResultEvent = frontend.FindResultEvent
+
+// FindInPage selects the next match of the text in the page, wrapping around at the end of the page. Calling it again
+// with the same text selects the following match. The number of matches is emitted with the FindResultEvent.
+func FindInPage(ctx context.Context, text string, options FindOptions) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.FindInPage(text, options)
+}
+
+// StopFind clears the matches of FindInPage
+func StopFind(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.StopFind()
+}
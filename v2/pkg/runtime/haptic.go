@@ -0,0 +1,22 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// Haptic feedback patterns for PerformHaptic
+const (
+	HapticGeneric     = frontend.HapticGeneric
+	HapticAlignment   = frontend.HapticAlignment
+	HapticLevelChange = frontend.HapticLevelChange
+)
+
+// PerformHaptic performs a haptic feedback pattern on the Force Touch trackpad. The pattern is one of
+// HapticGeneric, HapticAlignment or HapticLevelChange. This is only supported on macOS and does nothing on
+// other platforms.
+func PerformHaptic(ctx context.Context, pattern string) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.PerformHaptic(pattern)
+}
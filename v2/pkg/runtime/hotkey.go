@@ -0,0 +1,23 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/pkg/menu/keys"
+)
+
+// RegisterGlobalHotkey registers a system wide hotkey, which works when the application isn't focused, EG
+// RegisterGlobalHotkey(ctx, keys.Combo("space", keys.ControlKey, keys.OptionOrAltKey), toggleWindow). The handler is
+// called on its own goroutine when the hotkey is pressed, so it can call the runtime. It returns the id of the hotkey
+// for UnregisterGlobalHotkey, or an error if the hotkey is registered by another application. Windows only.
+func RegisterGlobalHotkey(ctx context.Context, accelerator *keys.Accelerator, handler func()) (int, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.RegisterGlobalHotkey(accelerator, handler)
+}
+
+// UnregisterGlobalHotkey removes a hotkey registered by RegisterGlobalHotkey, it does nothing if the id isn't
+// registered
+func UnregisterGlobalHotkey(ctx context.Context, id int) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.UnregisterGlobalHotkey(id)
+}
@@ -0,0 +1,28 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// IMEComposition is emitted with the IMECompositionEvent when an input method composition starts, changes or ends
+type IMEComposition = frontend.IMEComposition
+
+type IMECompositionPhase = frontend.IMECompositionPhase
+
+const (
+	IMECompositionStart  = frontend.IMECompositionStart
+	IMECompositionUpdate = frontend.IMECompositionUpdate
+	IMECompositionEnd    = frontend.IMECompositionEnd
+)
+
+// IMECompositionEvent is emitted with an IMEComposition when an input method composition starts, changes or ends
+const IMECompositionEvent = frontend.IMECompositionEvent
+
+// IMEIsComposing returns true while an input method composition is in progress, EG while Japanese or Chinese text is
+// being typed. Keyboard shortcuts such as Enter should not be handled during a composition.
+func IMEIsComposing(ctx context.Context) bool {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.IMEIsComposing()
+}
@@ -0,0 +1,11 @@
+package runtime
+
+import "github.com/wailsapp/wails/v2/internal/frontend"
+
+// LaunchData contains the URLs and the files the application has been asked to open. The data the application has
+// been launched with is available to the frontend as window.__wails_launch__ before its scripts run.
+type LaunchData = frontend.LaunchData
+
+// LaunchEvent is emitted with a LaunchData when the application is asked to open URLs or files while it runs, EG by
+// a deep link, a file association or a second instance
+const LaunchEvent = frontend.LaunchEvent
@@ -0,0 +1,19 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+type LocaleInfo = frontend.LocaleInfo
+
+// LocaleChangeEvent is emitted with the new LocaleInfo when the user changes the regional settings of the OS
+const LocaleChangeEvent = frontend.LocaleChangeEvent
+
+// GetLocaleInfo returns the regional settings of the OS, like the number separators and date formats.
+// This may differ from the formatting of `Intl` in the webview, especially if the settings have been customised.
+func GetLocaleInfo(ctx context.Context) (LocaleInfo, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.GetLocaleInfo()
+}
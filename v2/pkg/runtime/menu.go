@@ -3,15 +3,42 @@ package runtime
 import (
 	"context"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/menu"
 )
 
 func MenuSetApplicationMenu(ctx context.Context, menu *menu.Menu) {
-	frontend := getFrontend(ctx)
-	frontend.MenuSetApplicationMenu(menu)
+	appFrontend := getFrontend(ctx)
+	appFrontend.MenuSetApplicationMenu(menu)
+	frontend.MenuChanged(ctx, menu)
 }
 
 func MenuUpdateApplicationMenu(ctx context.Context) {
-	frontend := getFrontend(ctx)
-	frontend.MenuUpdateApplicationMenu()
+	appFrontend := getFrontend(ctx)
+	appFrontend.MenuUpdateApplicationMenu()
+	frontend.MenuChanged(ctx, appFrontend.MenuGetApplicationMenu())
+}
+
+// MenuNode is a node of the application menu tree
+type MenuNode = frontend.MenuNode
+
+// MenuChangeEvent is emitted with the []MenuNode of the application menu when it has changed
+const MenuChangeEvent = frontend.MenuChangeEvent
+
+// MenuGetStructure returns the tree of the visible items of the application menu, so it can be rendered in the
+// frontend, EG in a custom title bar
+func MenuGetStructure(ctx context.Context) []MenuNode {
+	appFrontend := getFrontend(ctx)
+	return frontend.MenuStructure(appFrontend.MenuGetApplicationMenu())
+}
+
+// MenuTrigger invokes the item of the application menu with the ID of a MenuNode as if it had been clicked, then
+// updates the native menu
+func MenuTrigger(ctx context.Context, id string) error {
+	appFrontend := getFrontend(ctx)
+	if err := frontend.MenuTrigger(appFrontend.MenuGetApplicationMenu(), id); err != nil {
+		return err
+	}
+	MenuUpdateApplicationMenu(ctx)
+	return nil
 }
@@ -0,0 +1,7 @@
+package runtime
+
+import "github.com/wailsapp/wails/v2/internal/frontend"
+
+// NavigationProgressEvent is emitted with the estimated progress of the page load, between 0 and 1. The progress is
+// 1 once the page has loaded.
+const NavigationProgressEvent = frontend.NavigationProgressEvent
@@ -0,0 +1,45 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+	"github.com/wailsapp/wails/v2/internal/system/network"
+)
+
+// NetworkStatus is the network status reported by the OS
+type NetworkStatus = network.Status
+
+type ConnectionType = network.ConnectionType
+
+const (
+	ConnectionNone     = network.ConnectionNone
+	ConnectionWifi     = network.ConnectionWifi
+	ConnectionEthernet = network.ConnectionEthernet
+	ConnectionCellular = network.ConnectionCellular
+	ConnectionUnknown  = network.ConnectionUnknown
+)
+
+// NetworkChangeEvent is emitted with the new NetworkStatus when the network status reported by the OS changes
+const NetworkChangeEvent = frontend.NetworkChangeEvent
+
+// GetNetworkStatus returns the network status reported by the OS, which unlike navigator.onLine is reliable in the
+// webview. The status is queried from NetworkManager on Linux, which must be running.
+func GetNetworkStatus(ctx context.Context) (NetworkStatus, error) {
+	return network.GetStatus()
+}
+
+// ProxyConfig is the system proxy configuration resolved for a URL
+type ProxyConfig = network.Proxy
+
+// GetSystemProxy returns the system proxy configuration for the URL, which is the configuration used by the webview.
+// The proxy auto-config script of the system is evaluated if there is one. The result can be used by the Proxy
+// function of an http.Transport, EG:
+//
+//	Proxy: func(request *http.Request) (*url.URL, error) {
+//		proxy, err := runtime.GetSystemProxy(ctx, request.URL.String())
+//		return proxy.URL(), err
+//	}
+func GetSystemProxy(ctx context.Context, forURL string) (*ProxyConfig, error) {
+	return network.ResolveProxy(forURL)
+}
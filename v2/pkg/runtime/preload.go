@@ -0,0 +1,17 @@
+package runtime
+
+import "context"
+
+// WebviewAddPreloadScript adds a script which is run before the scripts of every document loaded by the webview,
+// including the documents of the frames. The current document is not affected. It returns the id of the script for
+// WebviewRemovePreloadScript.
+func WebviewAddPreloadScript(ctx context.Context, js string) (string, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WebviewAddPreloadScript(js)
+}
+
+// WebviewRemovePreloadScript removes a script added by WebviewAddPreloadScript from the documents loaded afterwards
+func WebviewRemovePreloadScript(ctx context.Context, id string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WebviewRemovePreloadScript(id)
+}
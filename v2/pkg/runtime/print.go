@@ -0,0 +1,32 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+type PrintSettings = frontend.PrintSettings
+type PrintMargins = frontend.PrintMargins
+
+// PrintOrientation is the orientation of the pages printed by WebviewPrintToPDF
+type PrintOrientation = frontend.PrintOrientation
+
+const (
+	PrintPortrait  = frontend.PrintPortrait
+	PrintLandscape = frontend.PrintLandscape
+)
+
+// WebviewPrintToPDF prints the page to a PDF file at path without showing the print dialog. It returns once the file
+// has been written. Linux doesn't print a header and a footer. Not supported on macOS.
+func WebviewPrintToPDF(ctx context.Context, path string, settings PrintSettings) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WebviewPrintToPDF(path, settings)
+}
+
+// WebviewPrintToPDFData prints the page to a PDF without showing the print dialog and returns its content, EG to
+// upload it. Linux doesn't print a header and a footer. Not supported on macOS.
+func WebviewPrintToPDFData(ctx context.Context, settings PrintSettings) ([]byte, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WebviewPrintToPDFData(settings)
+}
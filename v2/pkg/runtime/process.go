@@ -0,0 +1,85 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/system/process"
+)
+
+type ProcessPriority = process.Priority
+
+const (
+	ProcessPriorityEfficiency = process.PriorityEfficiency
+	ProcessPriorityNormal     = process.PriorityNormal
+	ProcessPriorityHigh       = process.PriorityHigh
+)
+
+// SetProcessPriority sets the scheduling priority of the application process: "efficiency", "normal" or "high". The
+// high priority requires root privileges on macOS.
+func SetProcessPriority(ctx context.Context, priority ProcessPriority) error {
+	return process.SetPriority(priority)
+}
+
+// GetProcessPriority returns the scheduling priority of the application process
+func GetProcessPriority(ctx context.Context) (ProcessPriority, error) {
+	return process.GetPriority()
+}
+
+// ProcessOutputEvent is emitted with a ProcessOutput for every line written by a process started with SpawnProcess
+const ProcessOutputEvent = "wails:process:output"
+
+// ProcessExitEvent is emitted with a ProcessExit when a process started with SpawnProcess has exited
+const ProcessExitEvent = "wails:process:exit"
+
+// ProcessHandle is a process started with SpawnProcess
+type ProcessHandle = process.Process
+
+type SpawnOptions = process.SpawnOptions
+
+type ProcessStream = process.Stream
+
+const (
+	ProcessStdout = process.Stdout
+	ProcessStderr = process.Stderr
+)
+
+// ProcessOutput is a line written by a process
+type ProcessOutput struct {
+	PID    int           `json:"pid"`
+	Stream ProcessStream `json:"stream"`
+	Line   string        `json:"line"`
+}
+
+// ProcessExit is the result of a process
+type ProcessExit struct {
+	PID int `json:"pid"`
+	// Code is the exit code of the process, -1 if it has been terminated by a signal
+	Code  int    `json:"code"`
+	Error string `json:"error,omitempty"`
+}
+
+// SpawnProcess starts the command and emits its output with the ProcessOutputEvent and its exit with the
+// ProcessExitEvent. The process is terminated along with the processes it started when the application quits. It
+// is also terminated when the application dies on Windows, with a job object, and on Linux.
+func SpawnProcess(ctx context.Context, cmd string, args []string, opts SpawnOptions) (*ProcessHandle, error) {
+	events := getEvents(ctx)
+	var pid int
+	started := make(chan struct{})
+	result, err := process.Spawn(cmd, args, opts, func(stream process.Stream, line string) {
+		<-started
+		events.Emit(ProcessOutputEvent, ProcessOutput{PID: pid, Stream: stream, Line: line})
+	}, func(code int, err error) {
+		<-started
+		exit := ProcessExit{PID: pid, Code: code}
+		if err != nil {
+			exit.Error = err.Error()
+		}
+		events.Emit(ProcessExitEvent, exit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	pid = result.PID()
+	close(started)
+	return result, nil
+}
@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// ProgressEvent is emitted with a Progress when a task started with WithProgress reports progress or finishes
+const ProgressEvent = "wails:task:progress"
+
+// Progress is the state of a task started with WithProgress
+type Progress struct {
+	// ID identifies the task, it can be used to cancel the task
+	ID string `json:"id"`
+	// Value is the progress between 0 and 1
+	Value float64 `json:"value"`
+	// Done is set when the task has finished, Error contains the error of a failed or cancelled task
+	Done  bool   `json:"done"`
+	Error string `json:"error,omitempty"`
+}
+
+var (
+	progressID    atomic.Uint64
+	progressLock  sync.Mutex
+	progressTasks = map[string]context.CancelFunc{}
+)
+
+// WithProgress runs the task and emits its progress to the frontend with the ProgressEvent. The report function takes
+// the progress between 0 and 1, only changes of at least a percent are emitted. The context of the task is cancelled
+// when CancelProgress is called with the ID of the task, which can also be done in JS with `CancelProgress(id)`.
+// WithProgress blocks until the task has returned.
+func WithProgress(ctx context.Context, task func(ctx context.Context, report func(progress float64)) error) error {
+	events := getEvents(ctx)
+
+	id := "progress-" + strconv.FormatUint(progressID.Add(1), 10)
+	taskCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	progressLock.Lock()
+	progressTasks[id] = cancel
+	progressLock.Unlock()
+
+	defer func() {
+		progressLock.Lock()
+		delete(progressTasks, id)
+		progressLock.Unlock()
+	}()
+
+	var lock sync.Mutex
+	lastPercent := -1
+	var lastValue float64
+	report := func(value float64) {
+		value = math.Max(0, math.Min(1, value))
+		percent := int(value * 100)
+
+		lock.Lock()
+		defer lock.Unlock()
+		if percent == lastPercent || taskCtx.Err() != nil {
+			return
+		}
+		lastPercent = percent
+		lastValue = value
+		events.Emit(ProgressEvent, Progress{ID: id, Value: value})
+	}
+
+	report(0)
+	err := task(taskCtx, report)
+	if err == nil && taskCtx.Err() != nil {
+		err = taskCtx.Err()
+	}
+
+	result := Progress{ID: id, Done: true}
+	lock.Lock()
+	result.Value = lastValue
+	lock.Unlock()
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Value = 1
+	}
+	events.Emit(ProgressEvent, result)
+	return err
+}
+
+// CancelProgress cancels the context of the task with the given ID. Returns false if no such task is running.
+func CancelProgress(ctx context.Context, id string) bool {
+	progressLock.Lock()
+	cancel, ok := progressTasks[id]
+	progressLock.Unlock()
+
+	if ok {
+		cancel()
+	}
+	return ok
+}
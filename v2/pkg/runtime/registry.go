@@ -0,0 +1,63 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/system/registry"
+)
+
+type RegistryKey = registry.Key
+type RegistryValue = registry.Value
+
+const (
+	RegistryCurrentUser  = registry.CurrentUser
+	RegistryLocalMachine = registry.LocalMachine
+	RegistryClassesRoot  = registry.ClassesRoot
+
+	RegistryViewDefault = registry.ViewDefault
+	RegistryView32      = registry.View32
+	RegistryView64      = registry.View64
+
+	RegistryString       = registry.String
+	RegistryExpandString = registry.ExpandString
+	RegistryDWord        = registry.DWord
+	RegistryMultiString  = registry.MultiString
+)
+
+var (
+	// ErrRegistryNotSupported is returned by the Registry functions on platforms other than Windows
+	ErrRegistryNotSupported = registry.ErrNotSupported
+	// ErrRegistryNotExist is returned if the key or the value doesn't exist
+	ErrRegistryNotExist = registry.ErrNotExist
+	// ErrRegistryNotWritable is returned by RegistrySet and RegistryDelete for the keys which aren't allowed
+	ErrRegistryNotWritable = registry.ErrNotWritable
+)
+
+func init() {
+	_ = registry.AllowWrite(registry.AppKey(appName))
+}
+
+// RegistryAllowWrite allows RegistrySet and RegistryDelete to write to the keys and their subkeys. Only
+// HKCU\Software\<name> is allowed by default, the name is the name of the application in the project config, or the
+// name of the executable.
+func RegistryAllowWrite(ctx context.Context, keys ...RegistryKey) error {
+	return registry.AllowWrite(keys...)
+}
+
+// RegistryGet returns the named value of the registry key, an empty name returns the default value of the key.
+// Only supported on Windows.
+func RegistryGet(ctx context.Context, key RegistryKey, name string) (RegistryValue, error) {
+	return registry.Get(key, name)
+}
+
+// RegistrySet sets the named value of the registry key, the key is created if it doesn't exist. The key must have been
+// allowed with RegistryAllowWrite. Only supported on Windows.
+func RegistrySet(ctx context.Context, key RegistryKey, name string, value RegistryValue) error {
+	return registry.Set(key, name, value)
+}
+
+// RegistryDelete deletes the named value of the registry key. The key must have been allowed with RegistryAllowWrite.
+// Only supported on Windows.
+func RegistryDelete(ctx context.Context, key RegistryKey, name string) error {
+	return registry.Delete(key, name)
+}
@@ -0,0 +1,32 @@
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/wailsapp/wails/v2/internal/rpc"
+)
+
+// RegisterRPCHandler registers a handler for the given method which can be called from the frontend with
+// `rpc.call(method, params)`. The params are passed as raw JSON, the result is marshalled to JSON and a returned
+// error rejects the promise in the frontend. Registering a method again replaces the previous handler.
+func RegisterRPCHandler(method string, handler func(params json.RawMessage) (any, error)) {
+	if handler == nil {
+		rpc.Unregister(method)
+		return
+	}
+	rpc.Register(method, func(_ context.Context, params json.RawMessage) (any, error) {
+		return handler(params)
+	})
+}
+
+// RegisterRPCHandlerWithContext is like RegisterRPCHandler, but the handler receives a context which is cancelled
+// when the call is cancelled in the frontend.
+func RegisterRPCHandlerWithContext(method string, handler func(ctx context.Context, params json.RawMessage) (any, error)) {
+	rpc.Register(method, handler)
+}
+
+// UnregisterRPCHandler removes the handler for the given method
+func UnregisterRPCHandler(method string) {
+	rpc.Unregister(method)
+}
@@ -13,3 +13,10 @@ func ScreenGetAll(ctx context.Context) ([]Screen, error) {
 	appFrontend := getFrontend(ctx)
 	return appFrontend.ScreenGetAll()
 }
+
+// GetDisplayServer returns the display server the application is running on: "wayland" or "x11".
+// Linux only. An empty string is returned on other platforms.
+func GetDisplayServer(ctx context.Context) string {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.GetDisplayServer()
+}
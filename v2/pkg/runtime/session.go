@@ -0,0 +1,29 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// SessionChange is emitted with the SessionChangeEvent when the session of the user is locked, unlocked,
+// disconnected or reconnected
+type SessionChange = frontend.SessionChange
+
+const (
+	SessionLock       = frontend.SessionLock
+	SessionUnlock     = frontend.SessionUnlock
+	SessionDisconnect = frontend.SessionDisconnect
+	SessionReconnect  = frontend.SessionReconnect
+)
+
+// SessionChangeEvent is emitted with a SessionChange when the session of the user is locked, unlocked, disconnected
+// or reconnected
+const SessionChangeEvent = frontend.SessionChangeEvent
+
+// IsRemoteSession returns true if the application runs in a remote desktop session, where the rendering should be
+// kept light, EG by reducing the animations
+func IsRemoteSession(ctx context.Context) bool {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.IsRemoteSession()
+}
@@ -0,0 +1,33 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/settings"
+)
+
+// Settings are the values of a JSON settings file, which is saved atomically each time a value is set
+type Settings = settings.Settings
+
+// SettingsMigration upgrades the settings from the version of its index in the migrations to the next version
+type SettingsMigration = settings.Migration
+
+// ErrSettingNotExist is returned by Settings.Get if the setting doesn't exist
+var ErrSettingNotExist = settings.ErrNotExist
+
+// OpenSettings opens the settings file in the config directory of the user, which is
+// "%AppData%\<name>\settings.json" on Windows, "~/Library/Application Support/<name>/settings.json" on macOS and
+// "~/.config/<name>/settings.json" on Linux. The name is the name of the application in the project config, or the
+// name of the executable. The migrations the file hasn't been through are run when it is opened.
+func OpenSettings(ctx context.Context, migrations ...SettingsMigration) (*Settings, error) {
+	path, err := settings.DefaultPath(appName)
+	if err != nil {
+		return nil, err
+	}
+	return settings.Open(path, migrations...)
+}
+
+// OpenSettingsFile is like OpenSettings with the given path for the settings file
+func OpenSettingsFile(ctx context.Context, path string, migrations ...SettingsMigration) (*Settings, error) {
+	return settings.Open(path, migrations...)
+}
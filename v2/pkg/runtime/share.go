@@ -0,0 +1,28 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// ShareItems is the content offered by the share menu
+type ShareItems = frontend.ShareItems
+
+// ServiceInvocation describes the invocation of a macOS service provided by the application
+type ServiceInvocation = frontend.ServiceInvocation
+
+// ServiceInvokeEvent is emitted with a ServiceInvocation when a service provided by the application is invoked
+const ServiceInvokeEvent = frontend.ServiceInvokeEvent
+
+// ShowShareMenu shows the native share menu with the items. On macOS the menu is shown at the anchor, which is in
+// logical pixels relative to the top-left corner of the webview. On Windows the share UI of the system is shown, which
+// can't share files. Sharing is not supported on Linux.
+func ShowShareMenu(ctx context.Context, items ShareItems, anchor Rect) error {
+	if items.IsEmpty() {
+		return errors.New("there is nothing to share")
+	}
+	appFrontend := getFrontend(ctx)
+	return appFrontend.ShowShareMenu(items, anchor)
+}
@@ -0,0 +1,14 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/system/sidecar"
+)
+
+// GetSidecarPath returns the path of the sidecar executable with the name. The sidecar placed next to the executable
+// by the build is used if there is one, otherwise the sidecar of the Sidecars application option is extracted to the
+// user cache directory and made executable.
+func GetSidecarPath(ctx context.Context, name string) (string, error) {
+	return sidecar.Path(name)
+}
@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+type ProgressState = frontend.ProgressState
+type ThumbbarButton = frontend.ThumbbarButton
+
+// MaxThumbbarButtons is the maximum number of buttons of WindowSetThumbbarButtons
+const MaxThumbbarButtons = frontend.MaxThumbbarButtons
+
+// The states of the progress of WindowSetTaskbarProgress
+const (
+	ProgressStateNone          = frontend.ProgressStateNone
+	ProgressStateIndeterminate = frontend.ProgressStateIndeterminate
+	ProgressStateNormal        = frontend.ProgressStateNormal
+	ProgressStateError         = frontend.ProgressStateError
+	ProgressStatePaused        = frontend.ProgressStatePaused
+)
+
+// WindowSetTaskbarProgress shows the progress of a long running operation on the taskbar button of the window, EG
+// WindowSetTaskbarProgress(ctx, runtime.ProgressStateNormal, 30, 100). The values are ignored by the
+// ProgressStateIndeterminate and ProgressStateNone states, ProgressStateNone hides the progress. Windows only, it does
+// nothing if the taskbar isn't available.
+func WindowSetTaskbarProgress(ctx context.Context, state ProgressState, completed, total uint64) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetTaskbarProgress(state, completed, total)
+}
+
+// WindowSetThumbbarButtons replaces the buttons of the toolbar shown in the thumbnail of the taskbar button of the
+// window, EG the play and pause buttons of a media player. There are at most MaxThumbbarButtons buttons, an empty list
+// removes them. The OnClick callbacks are called on the main thread. Windows only, it returns an error on macOS and Linux
+// and does nothing if the taskbar isn't available.
+func WindowSetThumbbarButtons(ctx context.Context, buttons []ThumbbarButton) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetThumbbarButtons(buttons)
+}
+
+// WindowSetOverlayIcon shows a badge over the icon of the taskbar button of the window, EG the number of unread
+// messages. The icon is a PNG image or an ICO file, shown at the size of the small icons, and the description is read
+// by the screen readers. Windows only, it returns an error on macOS and Linux and does nothing if the taskbar
+// isn't available.
+func WindowSetOverlayIcon(ctx context.Context, icon []byte, description string) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetOverlayIcon(icon, description)
+}
+
+// WindowClearOverlayIcon removes the badge shown by WindowSetOverlayIcon. Windows only.
+func WindowClearOverlayIcon(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowClearOverlayIcon()
+}
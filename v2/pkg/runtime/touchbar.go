@@ -0,0 +1,25 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/wailsapp/wails/v2/internal/frontend"
+)
+
+// TouchBarItem is an item of the macOS Touch Bar
+type TouchBarItem = frontend.TouchBarItem
+
+type TouchBarItemType = frontend.TouchBarItemType
+
+const (
+	TouchBarButton   = frontend.TouchBarButton
+	TouchBarSlider   = frontend.TouchBarSlider
+	TouchBarScrubber = frontend.TouchBarScrubber
+)
+
+// TouchBarSet replaces the items of the Touch Bar of the window, call it again to update the items with the state of
+// the app. An empty list restores the default Touch Bar. This is a no-op on platforms other than macOS.
+func TouchBarSet(ctx context.Context, items []TouchBarItem) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.TouchBarSet(items)
+}
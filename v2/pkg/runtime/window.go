@@ -1,9 +1,21 @@
 package runtime
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"image/png"
 
+	"github.com/wailsapp/wails/v2/internal/frontend"
 	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/windows"
+)
+
+// FullscreenEnterEvent and FullscreenLeaveEvent are emitted when an element of the page, EG a video, enters or leaves
+// fullscreen with the HTML5 Fullscreen API
+const (
+	FullscreenEnterEvent = frontend.FullscreenEnterEvent
+	FullscreenLeaveEvent = frontend.FullscreenLeaveEvent
 )
 
 // WindowSetTitle sets the title of the window
@@ -24,10 +36,30 @@ func WindowUnfullscreen(ctx context.Context) {
 	appFrontend.WindowUnfullscreen()
 }
 
+// WindowSetBorderlessFullscreen makes the window cover the screen at the index of ScreenGetAll, without any window
+// chrome and above the taskbar, dock and menu bar. It is left with WindowUnfullscreen.
+func WindowSetBorderlessFullscreen(ctx context.Context, screen int) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetBorderlessFullscreen(screen)
+}
+
+// ErrWindowNotSupportedByWayland is wrapped by the errors of WindowSetLevel and WindowSetAlwaysOnBottom in a Linux
+// Wayland session, where the compositor doesn't allow clients to control the window. WindowCenter, WindowSetPosition
+// and WindowSetAlwaysOnTop log the error. Use the Linux.PreferXWayland option to run through XWayland instead.
+var ErrWindowNotSupportedByWayland = frontend.ErrNotSupportedByWayland
+
+// logWindowError logs the error of a window operation which has no result, EG if it isn't supported by a Wayland
+// compositor
+func logWindowError(ctx context.Context, err error) {
+	if err != nil {
+		LogWarning(ctx, err.Error())
+	}
+}
+
 // WindowCenter the window on the current screen
 func WindowCenter(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
-	appFrontend.WindowCenter()
+	logWindowError(ctx, appFrontend.WindowCenter())
 }
 
 // WindowReload will reload the window contents
@@ -57,12 +89,136 @@ func WindowSetDarkTheme(ctx context.Context) {
 	appFrontend.WindowSetDarkTheme()
 }
 
+// WindowSetTheme switches the theme of the running window, EG for a theme toggle of the application. On Windows the
+// title bar and the CustomTheme colours are updated immediately. windows.SystemDefault follows the theme of the OS
+// again.
+func WindowSetTheme(ctx context.Context, theme windows.Theme) {
+	switch theme {
+	case windows.Dark:
+		WindowSetDarkTheme(ctx)
+	case windows.Light:
+		WindowSetLightTheme(ctx)
+	default:
+		WindowSetSystemDefaultTheme(ctx)
+	}
+}
+
 // WindowShow shows the window if hidden
 func WindowShow(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.WindowShow()
 }
 
+// WindowFocus brings the window to the foreground and gives it the keyboard focus. The window is shown if it is
+// hidden and restored if it is minimised.
+func WindowFocus(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowFocus()
+}
+
+// WindowSetContentProtection excludes the window from screenshots, screen recording and screen sharing. Windows 10
+// versions before 2004 show the window black in the captures instead, an error is returned then. Windows only.
+func WindowSetContentProtection(ctx context.Context, enabled bool) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetContentProtection(enabled)
+}
+
+// WindowSetIgnoreMouseEvents lets the mouse events fall through the window to the windows beneath it, EG for a
+// transparent overlay. If forward is true the mouse moves over the window are still dispatched to the page as mousemove
+// events, so it can stop ignoring the mouse events when the mouse is over an interactive element. Windows only.
+func WindowSetIgnoreMouseEvents(ctx context.Context, ignore bool, forward bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetIgnoreMouseEvents(ignore, forward)
+}
+
+// WindowSetMinimiseButtonEnabled enables or disables the minimise button of the window. Windows only.
+func WindowSetMinimiseButtonEnabled(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetMinimiseButtonEnabled(enabled)
+}
+
+// WindowSetMaximiseButtonEnabled enables or disables the maximise button of the window. Windows only.
+func WindowSetMaximiseButtonEnabled(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetMaximiseButtonEnabled(enabled)
+}
+
+// WindowSetCloseButtonEnabled enables or disables the close button of the window, EG during a step of a wizard which
+// can't be cancelled. A disabled close button also disables the close item of the window menu and Alt+F4, unless the
+// AllowAltF4 Windows option is set. Windows only.
+func WindowSetCloseButtonEnabled(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetCloseButtonEnabled(enabled)
+}
+
+// WindowSetSkipTaskbar hides the window from the taskbar and Alt+Tab, EG for a utility opened from the system tray.
+// Not supported on macOS.
+func WindowSetSkipTaskbar(ctx context.Context, skip bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetSkipTaskbar(skip)
+}
+
+// WindowSetOpacity sets the opacity of the whole window, including the content, from 0.0 to 1.0 and returns the
+// previous opacity, EG to fade the window. The opacity is clamped to the range.
+func WindowSetOpacity(ctx context.Context, opacity float64) float64 {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetOpacity(opacity)
+}
+
+// WindowSetAnimationsEnabled enables or disables the animations of the window, EG when it is minimised and restored.
+// The system setting isn't changed. Windows only.
+func WindowSetAnimationsEnabled(ctx context.Context, enabled bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetAnimationsEnabled(enabled)
+}
+
+// WindowOpenDevTools opens the dev tools of the webview, EG during a support session. An error is returned if the
+// dev tools are disabled, they are enabled by a dev build, the devtools build flag or the EnableDevTools Windows
+// option. Not supported on macOS.
+func WindowOpenDevTools(ctx context.Context) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowOpenDevTools()
+}
+
+// WindowCloseDevTools closes the dev tools of the webview. An error is returned if the dev tools are disabled. Not
+// supported on macOS.
+func WindowCloseDevTools(ctx context.Context) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowCloseDevTools()
+}
+
+// WindowSetUserAgent sets the user agent of the webview, which is used by the following requests of the main frame
+// and of the subresources and by navigator.userAgent. wails.io is appended to it on all the platforms. An empty string
+// restores the default user agent.
+func WindowSetUserAgent(ctx context.Context, userAgent string) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetUserAgent(userAgent)
+}
+
+type WindowState = frontend.WindowState
+
+// WindowGetState returns the position, the size and the maximised state of the window. It has JSON tags, so it can be
+// saved to restore the window with WindowSetState when the application is started again.
+func WindowGetState(ctx context.Context) WindowState {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowGetState()
+}
+
+// WindowSetState restores the position, the size and the maximised state of the window returned by WindowGetState. On
+// Windows, a window which would be off-screen, EG because its screen has been disconnected, is centered on its screen or
+// on the primary screen, and its size is limited to the screen.
+func WindowSetState(ctx context.Context, state WindowState) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetState(state)
+}
+
+// WindowFlash flashes the taskbar button of the window to get the attention of the user, once or until the window is
+// activated if untilFocused is true. It does nothing if the window already has the focus. Windows only.
+func WindowFlash(ctx context.Context, untilFocused bool) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowFlash(untilFocused)
+}
+
 // WindowHide the window
 func WindowHide(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
@@ -75,33 +231,56 @@ func WindowSetSize(ctx context.Context, width int, height int) {
 	appFrontend.WindowSetSize(width, height)
 }
 
+// WindowSetSizeToContent resizes the window to the rendered size of the page, clamped to the work area of its monitor.
+// The page is measured at its max-content size, so the window also shrinks to smaller content. The window is resized
+// asynchronously once the page has been measured.
+func WindowSetSizeToContent(ctx context.Context) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetSizeToContent()
+}
+
 func WindowGetSize(ctx context.Context) (int, int) {
 	appFrontend := getFrontend(ctx)
 	return appFrontend.WindowGetSize()
 }
 
-// WindowSetMinSize sets the minimum size of the window
+// WindowSetMinSize sets the minimum size of the window in logical pixels, which are scaled to the DPI of the monitor
+// of the window on Windows. A size of 0 removes the constraint.
 func WindowSetMinSize(ctx context.Context, width int, height int) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.WindowSetMinSize(width, height)
 }
 
-// WindowSetMaxSize sets the maximum size of the window
+// WindowSetMaxSize sets the maximum size of the window in logical pixels, which are scaled to the DPI of the monitor
+// of the window on Windows. A size of 0 removes the constraint.
 func WindowSetMaxSize(ctx context.Context, width int, height int) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.WindowSetMaxSize(width, height)
 }
 
+// WindowSetLevel sets the z-order tier of the window. Use one of the options.WindowLevel constants.
+func WindowSetLevel(ctx context.Context, level options.WindowLevel) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetLevel(level)
+}
+
 // WindowSetAlwaysOnTop sets the window AlwaysOnTop or not on top
 func WindowSetAlwaysOnTop(ctx context.Context, b bool) {
 	appFrontend := getFrontend(ctx)
-	appFrontend.WindowSetAlwaysOnTop(b)
+	logWindowError(ctx, appFrontend.WindowSetAlwaysOnTop(b))
+}
+
+// WindowSetAlwaysOnBottom keeps the window below the normal windows, EG for a desktop widget. On Windows, the window
+// isn't activated when it is clicked and is hidden from the taskbar and Alt+Tab while it is always on bottom.
+func WindowSetAlwaysOnBottom(ctx context.Context, b bool) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetAlwaysOnBottom(b)
 }
 
 // WindowSetPosition sets the position of the window
 func WindowSetPosition(ctx context.Context, x int, y int) {
 	appFrontend := getFrontend(ctx)
-	appFrontend.WindowSetPosition(x, y)
+	logWindowError(ctx, appFrontend.WindowSetPosition(x, y))
 }
 
 func WindowGetPosition(ctx context.Context) (int, int) {
@@ -184,3 +363,107 @@ func WindowPrint(ctx context.Context) {
 	appFrontend := getFrontend(ctx)
 	appFrontend.WindowPrint()
 }
+
+// Cursors for WindowSetCursor
+const (
+	CursorDefault    = frontend.CursorDefault
+	CursorPointer    = frontend.CursorPointer
+	CursorText       = frontend.CursorText
+	CursorCrosshair  = frontend.CursorCrosshair
+	CursorWait       = frontend.CursorWait
+	CursorProgress   = frontend.CursorProgress
+	CursorMove       = frontend.CursorMove
+	CursorNotAllowed = frontend.CursorNotAllowed
+	CursorGrab       = frontend.CursorGrab
+	CursorGrabbing   = frontend.CursorGrabbing
+	CursorHelp       = frontend.CursorHelp
+	CursorResizeEW   = frontend.CursorResizeEW
+	CursorResizeNS   = frontend.CursorResizeNS
+	CursorResizeNESW = frontend.CursorResizeNESW
+	CursorResizeNWSE = frontend.CursorResizeNWSE
+)
+
+// WindowSetCursor overrides the cursor of the window and the page with one of the Cursor constants, which also applies
+// while the window is moved with WindowStartDrag. An empty cursor removes the override.
+func WindowSetCursor(ctx context.Context, cursor string) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetCursor(cursor)
+}
+
+// WindowSetCursorImage overrides the cursor of the window and the page with a PNG image. The hotspot is the position
+// of the click point in the image. Use WindowSetCursor with an empty cursor to remove the override.
+func WindowSetCursorImage(ctx context.Context, image []byte, hotspotX int, hotspotY int) error {
+	config, err := png.DecodeConfig(bytes.NewReader(image))
+	if err != nil {
+		return fmt.Errorf("invalid cursor image: %w", err)
+	}
+	if hotspotX < 0 || hotspotY < 0 || hotspotX >= config.Width || hotspotY >= config.Height {
+		return fmt.Errorf("cursor hotspot %d,%d is outside of the image", hotspotX, hotspotY)
+	}
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetCursorImage(image, hotspotX, hotspotY)
+	return nil
+}
+
+type Rect = frontend.Rect
+
+// WindowSetBlurRegion restricts the translucent backdrop of the window to the given regions.
+// Passing no regions applies the effect to the whole window again.
+// Windows only. Requires options.Windows.WindowIsTranslucent to be enabled.
+func WindowSetBlurRegion(ctx context.Context, rects []Rect) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetBlurRegion(rects)
+}
+
+// WindowSetBackdropType changes the translucent backdrop of the window, EG from windows.Mica to windows.Acrylic.
+// Windows only. Requires Windows 11 22621 or later and options.Windows.WindowIsTranslucent to be enabled, an error is
+// returned otherwise and the backdrop is left unchanged.
+func WindowSetBackdropType(ctx context.Context, backdrop windows.BackdropType) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetBackdropType(backdrop)
+}
+
+// WindowSetCustomTheme sets the colours of the title bar and the border of the window, overriding the CustomTheme of
+// options.Windows. The inactive colours are used while the window doesn't have the focus. Passing nil restores the
+// CustomTheme of the options. Windows only.
+func WindowSetCustomTheme(ctx context.Context, theme *windows.ThemeSettings) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetCustomTheme(theme)
+}
+
+// WindowSetZoomForOrigin sets the zoom factor of the pages of the origin, EG "https://intranet.example.com". It is
+// applied immediately if the origin is loaded and whenever it is loaded again. Windows only.
+func WindowSetZoomForOrigin(ctx context.Context, origin string, zoom float64) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowSetZoomForOrigin(origin, zoom)
+}
+
+// WindowSetZoomFactor sets the zoom factor of the webview, EG for a zoom menu. The factor is clamped to 0.25-5.0.
+// Windows only. Requires options.Windows.IsZoomControlEnabled to be enabled, an error is returned otherwise.
+func WindowSetZoomFactor(ctx context.Context, factor float64) error {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowSetZoomFactor(factor)
+}
+
+// WindowGetZoomFactor returns the zoom factor of the webview, including the zoom of the user. It is 1.0 on the
+// platforms other than Windows.
+func WindowGetZoomFactor(ctx context.Context) float64 {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowGetZoomFactor()
+}
+
+// WindowGetDPI returns the DPI of the window, 96 is a scale of 100%. Windows only.
+func WindowGetDPI(ctx context.Context) (uint, error) {
+	appFrontend := getFrontend(ctx)
+	return appFrontend.WindowGetDPI()
+}
+
+type DragConstraints = frontend.DragConstraints
+
+// WindowStartConstrainedDrag starts dragging the window with the mouse, like an element marked as draggable would,
+// while applying the given constraints until the drag ends. The primary mouse button must be pressed when calling this.
+// The constraints are currently only applied on Windows, other platforms perform an unconstrained drag.
+func WindowStartConstrainedDrag(ctx context.Context, constraints DragConstraints) {
+	appFrontend := getFrontend(ctx)
+	appFrontend.WindowStartConstrainedDrag(constraints)
+}